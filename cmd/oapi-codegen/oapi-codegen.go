@@ -23,6 +23,7 @@ import (
 	"runtime/debug"
 	"strings"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"gopkg.in/yaml.v2"
 
 	"github.com/deepmap/oapi-codegen/pkg/codegen"
@@ -36,6 +37,7 @@ func errExit(format string, args ...interface{}) {
 
 var (
 	flagOutputFile     string
+	flagOutputDir      string
 	flagConfigFile     string
 	flagOldConfigStyle bool
 	flagOutputConfig   bool
@@ -44,6 +46,11 @@ var (
 	flagPrintUsage     bool
 	flagGenerate       string
 	flagTemplatesDir   string
+	flagMerge          bool
+
+	flagIncludeOperations string
+	flagExcludeOperations string
+	flagTagFilter         string
 
 	// Deprecated: The options below will be removed in a future
 	// release. Please use the new config file format.
@@ -60,6 +67,10 @@ type configuration struct {
 
 	// OutputFile is the filename to output.
 	OutputFile string `yaml:"output,omitempty"`
+
+	// OutputDir is the directory to output to, with the generated code split
+	// into a file per component. It can't be combined with OutputFile.
+	OutputDir string `yaml:"output-dir,omitempty"`
 }
 
 // oldConfiguration is deprecated. Please add no more flags here. It is here
@@ -79,6 +90,7 @@ type oldConfiguration struct {
 
 func main() {
 	flag.StringVar(&flagOutputFile, "o", "", "Where to output generated code, stdout is default")
+	flag.StringVar(&flagOutputDir, "output-dir", "", "Directory to output generated code to, split into types.gen.go, client.gen.go, server.gen.go and spec.gen.go")
 	flag.BoolVar(&flagOldConfigStyle, "old-config-style", false, "whether to use the older style config file format")
 	flag.BoolVar(&flagOutputConfig, "output-config", false, "when true, outputs a configuration file for oapi-codegen using current settings")
 	flag.StringVar(&flagConfigFile, "config", "", "a YAML config file that controls oapi-codegen behavior")
@@ -90,10 +102,14 @@ func main() {
 	// All flags below are deprecated, and will be removed in a future release. Please do not
 	// update their behavior.
 	flag.StringVar(&flagGenerate, "generate", "types,client,server,spec",
-		`Comma-separated list of code to generate; valid options: "types", "client", "chi-server", "server", "gin", "gorilla", "spec", "skip-fmt", "skip-prune"`)
+		`Comma-separated list of code to generate; valid options: "types", "client", "chi-server", "server", "gin", "gorilla", "server-interface", "spec", "validation-middleware", "health-endpoints", "mock-server", "skip-fmt", "skip-prune"`)
 	flag.StringVar(&flagIncludeTags, "include-tags", "", "Only include operations with the given tags. Comma-separated list of tags.")
 	flag.StringVar(&flagExcludeTags, "exclude-tags", "", "Exclude operations that are tagged with the given tags. Comma-separated list of tags.")
+	flag.StringVar(&flagIncludeOperations, "include-operations", "", "Only include operations with the given operationIds. Comma-separated list of operationIds.")
+	flag.StringVar(&flagExcludeOperations, "exclude-operations", "", "Exclude operations with the given operationIds. Comma-separated list of operationIds.")
+	flag.StringVar(&flagTagFilter, "tag-filter", "", `Only include operations whose tags satisfy the given expression, such as "public AND NOT beta". Takes precedence over -include-tags and -exclude-tags.`)
 	flag.StringVar(&flagTemplatesDir, "templates", "", "Path to directory containing user templates")
+	flag.BoolVar(&flagMerge, "merge", false, "Merge all the spec files given, rather than only one, and generate code for them together")
 	flag.StringVar(&flagImportMapping, "import-mapping", "", "A dict from the external reference to golang package path")
 	flag.StringVar(&flagExcludeSchemas, "exclude-schemas", "", "A comma separated list of schemas which must be excluded from generation")
 	flag.StringVar(&flagResponseTypeSuffix, "response-type-suffix", "", "the suffix used for responses types")
@@ -119,8 +135,8 @@ func main() {
 
 	if flag.NArg() < 1 {
 		errExit("Please specify a path to a OpenAPI 3.0 spec file\n")
-	} else if flag.NArg() > 1 {
-		errExit("Only one OpenAPI 3.0 spec file is accepted and it must be the last CLI argument\n")
+	} else if flag.NArg() > 1 && !flagMerge {
+		errExit("Only one OpenAPI 3.0 spec file is accepted and it must be the last CLI argument, unless -merge is given\n")
 	}
 
 	// We will try to infer whether the user has an old-style config, or a new
@@ -256,9 +272,30 @@ func main() {
 		return
 	}
 
-	swagger, err := util.LoadSwagger(flag.Arg(0))
-	if err != nil {
-		errExit("error loading swagger spec in %s\n: %s", flag.Arg(0), err)
+	var (
+		swagger *openapi3.T
+		err     error
+	)
+	if flagMerge {
+		swagger, err = util.MergeSwaggers(flag.Args(), opts.ImportMapping)
+		if err != nil {
+			errExit("error merging swagger specs: %s\n", err)
+		}
+	} else {
+		swagger, err = util.LoadSwagger(flag.Arg(0))
+		if err != nil {
+			errExit("error loading swagger spec in %s\n: %s", flag.Arg(0), err)
+		}
+	}
+
+	if opts.OutputDir != "" {
+		if opts.OutputFile != "" {
+			errExit("only one of output and output-dir may be specified\n")
+		}
+		if err := writeFiles(swagger, opts); err != nil {
+			errExit("%s\n", err)
+		}
+		return
 	}
 
 	code, err := codegen.Generate(swagger, opts.Configuration)
@@ -276,6 +313,25 @@ func main() {
 	}
 }
 
+// writeFiles generates code split by component, writing each file to the
+// configured output directory.
+func writeFiles(swagger *openapi3.T, opts configuration) error {
+	files, err := codegen.GenerateFiles(swagger, opts.Configuration)
+	if err != nil {
+		return fmt.Errorf("error generating code: %w", err)
+	}
+
+	if err := os.MkdirAll(opts.OutputDir, 0755); err != nil {
+		return fmt.Errorf("error creating output directory: %w", err)
+	}
+	for name, code := range files {
+		if err := os.WriteFile(filepath.Join(opts.OutputDir, name), []byte(code), 0644); err != nil {
+			return fmt.Errorf("error writing generated code to file: %w", err)
+		}
+	}
+	return nil
+}
+
 func loadTemplateOverrides(templatesDir string) (map[string]string, error) {
 	var templates = make(map[string]string)
 
@@ -368,6 +424,15 @@ func updateConfigFromFlags(cfg *configuration) error {
 	if flagExcludeTags != "" {
 		cfg.OutputOptions.ExcludeTags = util.ParseCommandLineList(flagExcludeTags)
 	}
+	if flagIncludeOperations != "" {
+		cfg.OutputOptions.IncludeOperations = util.ParseCommandLineList(flagIncludeOperations)
+	}
+	if flagExcludeOperations != "" {
+		cfg.OutputOptions.ExcludeOperations = util.ParseCommandLineList(flagExcludeOperations)
+	}
+	if flagTagFilter != "" {
+		cfg.OutputOptions.TagFilterExpression = flagTagFilter
+	}
 	if flagTemplatesDir != "" {
 		templates, err := loadTemplateOverrides(flagTemplatesDir)
 		if err != nil {
@@ -395,6 +460,9 @@ func updateConfigFromFlags(cfg *configuration) error {
 	if cfg.OutputFile == "" {
 		cfg.OutputFile = flagOutputFile
 	}
+	if cfg.OutputDir == "" {
+		cfg.OutputDir = flagOutputDir
+	}
 
 	return nil
 }
@@ -449,12 +517,22 @@ func generationTargets(cfg *codegen.Configuration, targets []string) error {
 			opts.GorillaServer = true
 		case "strict-server":
 			opts.Strict = true
+		case "server-interface":
+			opts.ServerInterfaceOnly = true
 		case "client":
 			opts.Client = true
 		case "types", "models":
 			opts.Models = true
 		case "spec", "embedded-spec":
 			opts.EmbeddedSpec = true
+		case "validation-middleware":
+			opts.ValidationMiddleware = true
+		case "spec-handler":
+			opts.SpecHandler = true
+		case "health-endpoints":
+			opts.HealthEndpoints = true
+		case "mock-server":
+			opts.MockServer = true
 		case "skip-fmt":
 			cfg.OutputOptions.SkipFmt = true
 		case "skip-prune":
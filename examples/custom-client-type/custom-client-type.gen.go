@@ -5,12 +5,16 @@ package customclienttype
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
 
 // Client defines model for Client.
@@ -21,6 +25,22 @@ type Client struct {
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which is called with every response received, whatever its status.
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// operationIDContextKey is the key of the ID of the operation being called in
+// the contexts which the client passes to request and response editors.
+type operationIDContextKey struct{}
+
+// OperationID returns the ID of the operation being called, as generated from
+// its operationId, from the context passed to request and response editors, so
+// that they can act differently depending on the operation.
+func OperationID(ctx context.Context) (string, bool) {
+	operationID, ok := ctx.Value(operationIDContextKey{}).(string)
+	return operationID, ok
+}
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -43,6 +63,28 @@ type CustomClientType struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// A list of callbacks for inspecting or modifying responses, which are
+	// called as soon as they're received. If one returns an error, the
+	// response body is closed and the error is returned instead of the response.
+	ResponseEditors []ResponseEditorFn
+
+	// The policy for retrying failed requests, set by WithRetry.
+	retryPolicy *runtime.RetryPolicy
+
+	// The TLS configuration and proxy of the default http.Client, set by
+	// WithTLSConfig and WithProxy.
+	tlsConfig *tls.Config
+	proxy     func(*http.Request) (*url.URL, error)
+
+	// The transport of the default http.Client, set by WithRoundTripper, and
+	// the functions wrapping it, set by WithTransportMiddleware.
+	roundTripper         http.RoundTripper
+	transportMiddlewares []func(http.RoundTripper) http.RoundTripper
+
+	// Whether responses keep the request which was sent, set by
+	// WithCaptureRequest.
+	captureRequest bool
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -67,6 +109,37 @@ func NewClient(server string, opts ...ClientOption) (*CustomClientType, error) {
 	// create httpClient, if not already present
 	if client.Client == nil {
 		client.Client = &http.Client{}
+		if client.tlsConfig != nil || client.proxy != nil || client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+			var transport http.RoundTripper = http.DefaultTransport
+			if client.roundTripper != nil {
+				transport = client.roundTripper
+			}
+			if client.tlsConfig != nil || client.proxy != nil {
+				base, ok := transport.(*http.Transport)
+				if !ok {
+					return nil, errors.New("WithTLSConfig and WithProxy can only be combined with a WithRoundTripper of an *http.Transport")
+				}
+				t := base.Clone()
+				if client.tlsConfig != nil {
+					t.TLSClientConfig = client.tlsConfig
+				}
+				if client.proxy != nil {
+					t.Proxy = client.proxy
+				}
+				transport = t
+			}
+			for _, wrap := range client.transportMiddlewares {
+				transport = wrap(transport)
+			}
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.tlsConfig != nil || client.proxy != nil {
+		return nil, errors.New("WithTLSConfig and WithProxy only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	} else if client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+		return nil, errors.New("WithRoundTripper and WithTransportMiddleware only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	}
+	if client.retryPolicy != nil {
+		client.Client = runtime.NewRetryDoer(client.Client, *client.retryPolicy)
 	}
 	return &client, nil
 }
@@ -80,6 +153,77 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	}
 }
 
+// WithTLSConfig sets the TLS configuration, such as the certificates to
+// trust or present, of the http.Client which the client creates. It can't be
+// combined with WithHTTPClient, whose Doer should be configured instead.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *CustomClientType) error {
+		c.tlsConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function which chooses the proxy for each request made
+// by the http.Client which the client creates, such as http.ProxyURL. It
+// can't be combined with WithHTTPClient, whose Doer should be configured
+// instead.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *CustomClientType) error {
+		c.proxy = proxy
+		return nil
+	}
+}
+
+// WithRoundTripper sets the transport of the http.Client which the client
+// creates, in place of http.DefaultTransport. When it's an *http.Transport,
+// the TLS configuration and proxy set by WithTLSConfig and WithProxy apply to
+// a copy of it. It can't be combined with WithHTTPClient, whose Doer should
+// be configured instead.
+func WithRoundTripper(roundTripper http.RoundTripper) ClientOption {
+	return func(c *CustomClientType) error {
+		c.roundTripper = roundTripper
+		return nil
+	}
+}
+
+// WithTransportMiddleware wraps the transport of the http.Client which the
+// client creates, including any TLS configuration and proxy set by
+// WithTLSConfig and WithProxy, with the http.RoundTripper which wrap returns,
+// such as one which logs or traces requests. Each call wraps the transport of
+// the previous ones. It can't be combined with WithHTTPClient, whose Doer
+// should be configured instead.
+func WithTransportMiddleware(wrap func(next http.RoundTripper) http.RoundTripper) ClientOption {
+	return func(c *CustomClientType) error {
+		c.transportMiddlewares = append(c.transportMiddlewares, wrap)
+		return nil
+	}
+}
+
+// WithRetry makes the client retry requests which fail with a network error
+// or a retryable status code, with exponential backoff which honors any
+// Retry-After header. Unless the policy says otherwise, only GET, PUT, DELETE
+// and HEAD requests are retried, on 502, 503 and 504 responses. The retries
+// wrap whichever Doer the client ends up with, including one set by
+// WithHTTPClient.
+func WithRetry(policy runtime.RetryPolicy) ClientOption {
+	return func(c *CustomClientType) error {
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithCaptureRequest makes the client keep a copy of the request which each
+// response answers, after any redirects, as its Request, and so as the
+// HTTPRequest of the responses of the WithResponse methods, for debugging
+// retries. The copy has the URL and headers of the request, but not its body,
+// so that the body can be garbage collected.
+func WithCaptureRequest(capture bool) ClientOption {
+	return func(c *CustomClientType) error {
+		c.captureRequest = capture
+		return nil
+	}
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -89,6 +233,16 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called right after receiving a response, before it's parsed. This can be
+// used for metrics, or to turn error responses into errors.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *CustomClientType) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// GetClient request
@@ -100,11 +254,12 @@ func (c *CustomClientType) GetClient(ctx context.Context, reqEditors ...RequestE
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetClient")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 // NewGetClientRequest generates requests for GetClient
@@ -134,6 +289,40 @@ func NewGetClientRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// do sends the request, then calls the response editors.
+func (c *CustomClientType) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	rsp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.captureRequest {
+		rsp.Request = captureRequest(rsp, req)
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(ctx, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// capturedRequestContextKey marks the copies of requests which clients made
+// WithCaptureRequest keep in their responses.
+type capturedRequestContextKey struct{}
+
+// captureRequest returns a copy of the request which rsp answers, falling
+// back to req, without its body.
+func captureRequest(rsp *http.Response, req *http.Request) *http.Request {
+	if rsp.Request != nil {
+		req = rsp.Request
+	}
+	captured := req.Clone(context.WithValue(req.Context(), capturedRequestContextKey{}, true))
+	captured.Body = nil
+	captured.GetBody = nil
+	return captured
+}
+
 func (c *CustomClientType) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -181,9 +370,12 @@ type ClientWithResponsesInterface interface {
 	GetClientWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientResponse, error)
 }
 
+var _ ClientWithResponsesInterface = (*ClientWithResponses)(nil)
+
 type GetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *Client
 }
 
@@ -203,6 +395,25 @@ func (r GetClientResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetClientResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetClientExpectedStatusCodes returns the status codes which GetClient has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetClientExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetClientHasDefaultResponse is whether GetClient has a default response,
+// for status codes which aren't in GetClientExpectedStatusCodes.
+const GetClientHasDefaultResponse = false
+
 // GetClientWithResponse request returning *GetClientResponse
 func (c *ClientWithResponses) GetClientWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientResponse, error) {
 	rsp, err := c.GetClient(ctx, reqEditors...)
@@ -212,6 +423,15 @@ func (c *ClientWithResponses) GetClientWithResponse(ctx context.Context, reqEdit
 	return ParseGetClientResponse(rsp)
 }
 
+// capturedRequest returns the request kept in rsp by a client made
+// WithCaptureRequest(true), or nil.
+func capturedRequest(rsp *http.Response) *http.Request {
+	if rsp.Request == nil || rsp.Request.Context().Value(capturedRequestContextKey{}) == nil {
+		return nil
+	}
+	return rsp.Request
+}
+
 // ParseGetClientResponse parses an HTTP response from a GetClientWithResponse call
 func ParseGetClientResponse(rsp *http.Response) (*GetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -223,6 +443,7 @@ func ParseGetClientResponse(rsp *http.Response) (*GetClientResponse, error) {
 	response := &GetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
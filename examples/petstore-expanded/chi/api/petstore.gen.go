@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
@@ -29,7 +30,7 @@ type Error struct {
 
 // NewPet defines model for NewPet.
 type NewPet struct {
-	// Name Name of the pet
+	// Name of the pet
 	Name string `json:"name"`
 
 	// Tag Type of the pet
@@ -41,7 +42,7 @@ type Pet struct {
 	// Id Unique id of the pet
 	Id int64 `json:"id"`
 
-	// Name Name of the pet
+	// Name of the pet
 	Name string `json:"name"`
 
 	// Tag Type of the pet
@@ -421,3 +422,29 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+var (
+	swaggerOnce      sync.Once
+	cachedSwagger    *openapi3.T
+	cachedSwaggerErr error
+)
+
+// GetSwaggerCached is like GetSwagger, but only loads the specification the
+// first time it's called. Every caller gets the same *openapi3.T, which must
+// not be modified.
+func GetSwaggerCached() (*openapi3.T, error) {
+	swaggerOnce.Do(func() {
+		cachedSwagger, cachedSwaggerErr = GetSwagger()
+	})
+	return cachedSwagger, cachedSwaggerErr
+}
+
+// MustGetSwagger is like GetSwaggerCached, but panics if the specification
+// can't be loaded.
+func MustGetSwagger() *openapi3.T {
+	swagger, err := GetSwaggerCached()
+	if err != nil {
+		panic(fmt.Sprintf("error loading embedded swagger spec: %s", err))
+	}
+	return swagger
+}
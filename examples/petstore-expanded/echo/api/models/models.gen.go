@@ -14,7 +14,7 @@ type Error struct {
 
 // NewPet defines model for NewPet.
 type NewPet struct {
-	// Name Name of the pet
+	// Name of the pet
 	Name string `json:"name"`
 
 	// Tag Type of the pet
@@ -26,7 +26,7 @@ type Pet struct {
 	// Id Unique id of the pet
 	Id int64 `json:"id"`
 
-	// Name Name of the pet
+	// Name of the pet
 	Name string `json:"name"`
 
 	// Tag Type of the pet
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"sync"
 
 	. "github.com/deepmap/oapi-codegen/examples/petstore-expanded/echo/api/models"
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
@@ -245,3 +246,29 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+var (
+	swaggerOnce      sync.Once
+	cachedSwagger    *openapi3.T
+	cachedSwaggerErr error
+)
+
+// GetSwaggerCached is like GetSwagger, but only loads the specification the
+// first time it's called. Every caller gets the same *openapi3.T, which must
+// not be modified.
+func GetSwaggerCached() (*openapi3.T, error) {
+	swaggerOnce.Do(func() {
+		cachedSwagger, cachedSwaggerErr = GetSwagger()
+	})
+	return cachedSwagger, cachedSwaggerErr
+}
+
+// MustGetSwagger is like GetSwaggerCached, but panics if the specification
+// can't be loaded.
+func MustGetSwagger() *openapi3.T {
+	swagger, err := GetSwaggerCached()
+	if err != nil {
+		panic(fmt.Sprintf("error loading embedded swagger spec: %s", err))
+	}
+	return swagger
+}
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
@@ -29,7 +30,7 @@ type Error struct {
 
 // NewPet defines model for NewPet.
 type NewPet struct {
-	// Name Name of the pet
+	// Name of the pet
 	Name string `json:"name"`
 
 	// Tag Type of the pet
@@ -41,7 +42,7 @@ type Pet struct {
 	// Id Unique id of the pet
 	Id int64 `json:"id"`
 
-	// Name Name of the pet
+	// Name of the pet
 	Name string `json:"name"`
 
 	// Tag Type of the pet
@@ -263,10 +264,15 @@ func Handler(si ServerInterface) http.Handler {
 }
 
 type GorillaServerOptions struct {
-	BaseURL          string
-	BaseRouter       *mux.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	BaseURL     string
+	BaseRouter  *mux.Router
+	Middlewares []MiddlewareFunc
+	// OperationMiddlewares are applied only to the route of the operation
+	// whose ID they're keyed by, as used in the names of the generated types,
+	// and run before any of the Middlewares. The first middleware for an
+	// operation runs first.
+	OperationMiddlewares map[string][]mux.MiddlewareFunc
+	ErrorHandlerFunc     func(w http.ResponseWriter, r *http.Request, err error)
 }
 
 // HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
@@ -301,17 +307,26 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
-	r.HandleFunc(options.BaseURL+"/pets", wrapper.FindPets).Methods("GET")
+	r.Handle(options.BaseURL+"/pets", withOperationMiddlewares(http.HandlerFunc(wrapper.FindPets), options.OperationMiddlewares["FindPets"])).Methods("GET")
 
-	r.HandleFunc(options.BaseURL+"/pets", wrapper.AddPet).Methods("POST")
+	r.Handle(options.BaseURL+"/pets", withOperationMiddlewares(http.HandlerFunc(wrapper.AddPet), options.OperationMiddlewares["AddPet"])).Methods("POST")
 
-	r.HandleFunc(options.BaseURL+"/pets/{id}", wrapper.DeletePet).Methods("DELETE")
+	r.Handle(options.BaseURL+"/pets/{id}", withOperationMiddlewares(http.HandlerFunc(wrapper.DeletePet), options.OperationMiddlewares["DeletePet"])).Methods("DELETE")
 
-	r.HandleFunc(options.BaseURL+"/pets/{id}", wrapper.FindPetByID).Methods("GET")
+	r.Handle(options.BaseURL+"/pets/{id}", withOperationMiddlewares(http.HandlerFunc(wrapper.FindPetByID), options.OperationMiddlewares["FindPetByID"])).Methods("GET")
 
 	return r
 }
 
+// withOperationMiddlewares wraps an operation's handler in its middlewares,
+// such that the first middleware runs first.
+func withOperationMiddlewares(handler http.Handler, middlewares []mux.MiddlewareFunc) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
@@ -416,3 +431,29 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+var (
+	swaggerOnce      sync.Once
+	cachedSwagger    *openapi3.T
+	cachedSwaggerErr error
+)
+
+// GetSwaggerCached is like GetSwagger, but only loads the specification the
+// first time it's called. Every caller gets the same *openapi3.T, which must
+// not be modified.
+func GetSwaggerCached() (*openapi3.T, error) {
+	swaggerOnce.Do(func() {
+		cachedSwagger, cachedSwaggerErr = GetSwagger()
+	})
+	return cachedSwagger, cachedSwaggerErr
+}
+
+// MustGetSwagger is like GetSwaggerCached, but panics if the specification
+// can't be loaded.
+func MustGetSwagger() *openapi3.T {
+	swagger, err := GetSwaggerCached()
+	if err != nil {
+		panic(fmt.Sprintf("error loading embedded swagger spec: %s", err))
+	}
+	return swagger
+}
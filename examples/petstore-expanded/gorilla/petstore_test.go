@@ -165,3 +165,33 @@ func TestPetStore(t *testing.T) {
 		assert.Equal(t, 0, len(petList))
 	})
 }
+
+func TestOperationMiddlewares(t *testing.T) {
+	var calls []string
+	recordCall := func(name string) mux.MiddlewareFunc {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	store := api.NewPetStore()
+	r := mux.NewRouter()
+	api.HandlerWithOptions(store, api.GorillaServerOptions{
+		BaseRouter: r,
+		OperationMiddlewares: map[string][]mux.MiddlewareFunc{
+			"AddPet": {recordCall("first"), recordCall("second")},
+		},
+	})
+
+	// The middlewares don't run for other operations, even on the same path
+	rr := doGet(t, r, "/pets")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, calls)
+
+	rr = testutil.NewRequest().Post("/pets").WithJsonBody(api.NewPet{Name: "Spot"}).GoWithHTTPHandler(t, r).Recorder
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, []string{"first", "second"}, calls)
+}
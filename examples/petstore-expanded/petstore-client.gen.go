@@ -6,7 +6,9 @@ package petstore
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -27,7 +29,7 @@ type Error struct {
 
 // NewPet defines model for NewPet.
 type NewPet struct {
-	// Name Name of the pet
+	// Name of the pet
 	Name string `json:"name"`
 
 	// Tag Type of the pet
@@ -39,7 +41,7 @@ type Pet struct {
 	// Id Unique id of the pet
 	Id int64 `json:"id"`
 
-	// Name Name of the pet
+	// Name of the pet
 	Name string `json:"name"`
 
 	// Tag Type of the pet
@@ -61,6 +63,22 @@ type AddPetJSONRequestBody = NewPet
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which is called with every response received, whatever its status.
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// operationIDContextKey is the key of the ID of the operation being called in
+// the contexts which the client passes to request and response editors.
+type operationIDContextKey struct{}
+
+// OperationID returns the ID of the operation being called, as generated from
+// its operationId, from the context passed to request and response editors, so
+// that they can act differently depending on the operation.
+func OperationID(ctx context.Context) (string, bool) {
+	operationID, ok := ctx.Value(operationIDContextKey{}).(string)
+	return operationID, ok
+}
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -83,6 +101,28 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// A list of callbacks for inspecting or modifying responses, which are
+	// called as soon as they're received. If one returns an error, the
+	// response body is closed and the error is returned instead of the response.
+	ResponseEditors []ResponseEditorFn
+
+	// The policy for retrying failed requests, set by WithRetry.
+	retryPolicy *runtime.RetryPolicy
+
+	// The TLS configuration and proxy of the default http.Client, set by
+	// WithTLSConfig and WithProxy.
+	tlsConfig *tls.Config
+	proxy     func(*http.Request) (*url.URL, error)
+
+	// The transport of the default http.Client, set by WithRoundTripper, and
+	// the functions wrapping it, set by WithTransportMiddleware.
+	roundTripper         http.RoundTripper
+	transportMiddlewares []func(http.RoundTripper) http.RoundTripper
+
+	// Whether responses keep the request which was sent, set by
+	// WithCaptureRequest.
+	captureRequest bool
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -107,6 +147,37 @@ func NewClient(server string, opts ...ClientOption) (*Client, error) {
 	// create httpClient, if not already present
 	if client.Client == nil {
 		client.Client = &http.Client{}
+		if client.tlsConfig != nil || client.proxy != nil || client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+			var transport http.RoundTripper = http.DefaultTransport
+			if client.roundTripper != nil {
+				transport = client.roundTripper
+			}
+			if client.tlsConfig != nil || client.proxy != nil {
+				base, ok := transport.(*http.Transport)
+				if !ok {
+					return nil, errors.New("WithTLSConfig and WithProxy can only be combined with a WithRoundTripper of an *http.Transport")
+				}
+				t := base.Clone()
+				if client.tlsConfig != nil {
+					t.TLSClientConfig = client.tlsConfig
+				}
+				if client.proxy != nil {
+					t.Proxy = client.proxy
+				}
+				transport = t
+			}
+			for _, wrap := range client.transportMiddlewares {
+				transport = wrap(transport)
+			}
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.tlsConfig != nil || client.proxy != nil {
+		return nil, errors.New("WithTLSConfig and WithProxy only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	} else if client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+		return nil, errors.New("WithRoundTripper and WithTransportMiddleware only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	}
+	if client.retryPolicy != nil {
+		client.Client = runtime.NewRetryDoer(client.Client, *client.retryPolicy)
 	}
 	return &client, nil
 }
@@ -120,6 +191,77 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	}
 }
 
+// WithTLSConfig sets the TLS configuration, such as the certificates to
+// trust or present, of the http.Client which the client creates. It can't be
+// combined with WithHTTPClient, whose Doer should be configured instead.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		c.tlsConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function which chooses the proxy for each request made
+// by the http.Client which the client creates, such as http.ProxyURL. It
+// can't be combined with WithHTTPClient, whose Doer should be configured
+// instead.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *Client) error {
+		c.proxy = proxy
+		return nil
+	}
+}
+
+// WithRoundTripper sets the transport of the http.Client which the client
+// creates, in place of http.DefaultTransport. When it's an *http.Transport,
+// the TLS configuration and proxy set by WithTLSConfig and WithProxy apply to
+// a copy of it. It can't be combined with WithHTTPClient, whose Doer should
+// be configured instead.
+func WithRoundTripper(roundTripper http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		c.roundTripper = roundTripper
+		return nil
+	}
+}
+
+// WithTransportMiddleware wraps the transport of the http.Client which the
+// client creates, including any TLS configuration and proxy set by
+// WithTLSConfig and WithProxy, with the http.RoundTripper which wrap returns,
+// such as one which logs or traces requests. Each call wraps the transport of
+// the previous ones. It can't be combined with WithHTTPClient, whose Doer
+// should be configured instead.
+func WithTransportMiddleware(wrap func(next http.RoundTripper) http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		c.transportMiddlewares = append(c.transportMiddlewares, wrap)
+		return nil
+	}
+}
+
+// WithRetry makes the client retry requests which fail with a network error
+// or a retryable status code, with exponential backoff which honors any
+// Retry-After header. Unless the policy says otherwise, only GET, PUT, DELETE
+// and HEAD requests are retried, on 502, 503 and 504 responses. The retries
+// wrap whichever Doer the client ends up with, including one set by
+// WithHTTPClient.
+func WithRetry(policy runtime.RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithCaptureRequest makes the client keep a copy of the request which each
+// response answers, after any redirects, as its Request, and so as the
+// HTTPRequest of the responses of the WithResponse methods, for debugging
+// retries. The copy has the URL and headers of the request, but not its body,
+// so that the body can be garbage collected.
+func WithCaptureRequest(capture bool) ClientOption {
+	return func(c *Client) error {
+		c.captureRequest = capture
+		return nil
+	}
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -129,6 +271,16 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called right after receiving a response, before it's parsed. This can be
+// used for metrics, or to turn error responses into errors.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// FindPets request
@@ -151,11 +303,12 @@ func (c *Client) FindPets(ctx context.Context, params *FindPetsParams, reqEditor
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "FindPets")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -163,11 +316,12 @@ func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "AddPet")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -175,11 +329,12 @@ func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEdit
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "AddPet")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) DeletePet(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -187,11 +342,12 @@ func (c *Client) DeletePet(ctx context.Context, id int64, reqEditors ...RequestE
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "DeletePet")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) FindPetByID(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -199,11 +355,12 @@ func (c *Client) FindPetByID(ctx context.Context, id int64, reqEditors ...Reques
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "FindPetByID")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 // NewFindPetsRequest generates requests for FindPets
@@ -377,6 +534,40 @@ func NewFindPetByIDRequest(server string, id int64) (*http.Request, error) {
 	return req, nil
 }
 
+// do sends the request, then calls the response editors.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	rsp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.captureRequest {
+		rsp.Request = captureRequest(rsp, req)
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(ctx, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// capturedRequestContextKey marks the copies of requests which clients made
+// WithCaptureRequest keep in their responses.
+type capturedRequestContextKey struct{}
+
+// captureRequest returns a copy of the request which rsp answers, falling
+// back to req, without its body.
+func captureRequest(rsp *http.Response, req *http.Request) *http.Request {
+	if rsp.Request != nil {
+		req = rsp.Request
+	}
+	captured := req.Clone(context.WithValue(req.Context(), capturedRequestContextKey{}, true))
+	captured.Body = nil
+	captured.GetBody = nil
+	return captured
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -391,6 +582,30 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 	return nil
 }
 
+// ServerURL0 returns the URL of server 0 in the spec, "https://petstore.swagger.io/api".
+// The server has no variables, so vars must be empty.
+func ServerURL0(vars map[string]string) (string, error) {
+	return runtime.ServerURL("https://petstore.swagger.io/api", vars, nil)
+}
+
+// serverURLs holds the URLs of the servers in the spec, in order, with their
+// variables set to their defaults.
+var serverURLs = []string{
+	"https://petstore.swagger.io/api",
+}
+
+// WithBaseURLIndex sets the base URL to that of the i-th server in the spec,
+// with its variables set to their defaults.
+func WithBaseURLIndex(i int) ClientOption {
+	return func(c *Client) error {
+		if i < 0 || i >= len(serverURLs) {
+			return fmt.Errorf("server index %d out of range, the spec has %d servers", i, len(serverURLs))
+		}
+		c.Server = serverURLs[i]
+		return nil
+	}
+}
+
 // ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
@@ -435,9 +650,12 @@ type ClientWithResponsesInterface interface {
 	FindPetByIDWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*FindPetByIDResponse, error)
 }
 
+var _ ClientWithResponsesInterface = (*ClientWithResponses)(nil)
+
 type FindPetsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *[]Pet
 	JSONDefault  *Error
 }
@@ -458,9 +676,39 @@ func (r FindPetsResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r FindPetsResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	if r.JSONDefault != nil {
+		return fmt.Errorf("%s: %+v", r.HTTPResponse.Status, *r.JSONDefault)
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of FindPets, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r FindPetsResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200
+}
+
+// FindPetsExpectedStatusCodes returns the status codes which FindPets has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func FindPetsExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// FindPetsHasDefaultResponse is whether FindPets has a default response,
+// for status codes which aren't in FindPetsExpectedStatusCodes.
+const FindPetsHasDefaultResponse = true
+
 type AddPetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *Pet
 	JSONDefault  *Error
 }
@@ -481,9 +729,39 @@ func (r AddPetResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r AddPetResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	if r.JSONDefault != nil {
+		return fmt.Errorf("%s: %+v", r.HTTPResponse.Status, *r.JSONDefault)
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of AddPet, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r AddPetResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200
+}
+
+// AddPetExpectedStatusCodes returns the status codes which AddPet has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func AddPetExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// AddPetHasDefaultResponse is whether AddPet has a default response,
+// for status codes which aren't in AddPetExpectedStatusCodes.
+const AddPetHasDefaultResponse = true
+
 type DeletePetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSONDefault  *Error
 }
 
@@ -503,9 +781,39 @@ func (r DeletePetResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r DeletePetResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	if r.JSONDefault != nil {
+		return fmt.Errorf("%s: %+v", r.HTTPResponse.Status, *r.JSONDefault)
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of DeletePet, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r DeletePetResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 204
+}
+
+// DeletePetExpectedStatusCodes returns the status codes which DeletePet has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func DeletePetExpectedStatusCodes() []int {
+	return []int{204}
+}
+
+// DeletePetHasDefaultResponse is whether DeletePet has a default response,
+// for status codes which aren't in DeletePetExpectedStatusCodes.
+const DeletePetHasDefaultResponse = true
+
 type FindPetByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *Pet
 	JSONDefault  *Error
 }
@@ -526,6 +834,35 @@ func (r FindPetByIDResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r FindPetByIDResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	if r.JSONDefault != nil {
+		return fmt.Errorf("%s: %+v", r.HTTPResponse.Status, *r.JSONDefault)
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of FindPetByID, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r FindPetByIDResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200
+}
+
+// FindPetByIDExpectedStatusCodes returns the status codes which FindPetByID has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func FindPetByIDExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// FindPetByIDHasDefaultResponse is whether FindPetByID has a default response,
+// for status codes which aren't in FindPetByIDExpectedStatusCodes.
+const FindPetByIDHasDefaultResponse = true
+
 // FindPetsWithResponse request returning *FindPetsResponse
 func (c *ClientWithResponses) FindPetsWithResponse(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*FindPetsResponse, error) {
 	rsp, err := c.FindPets(ctx, params, reqEditors...)
@@ -570,6 +907,15 @@ func (c *ClientWithResponses) FindPetByIDWithResponse(ctx context.Context, id in
 	return ParseFindPetByIDResponse(rsp)
 }
 
+// capturedRequest returns the request kept in rsp by a client made
+// WithCaptureRequest(true), or nil.
+func capturedRequest(rsp *http.Response) *http.Request {
+	if rsp.Request == nil || rsp.Request.Context().Value(capturedRequestContextKey{}) == nil {
+		return nil
+	}
+	return rsp.Request
+}
+
 // ParseFindPetsResponse parses an HTTP response from a FindPetsWithResponse call
 func ParseFindPetsResponse(rsp *http.Response) (*FindPetsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -581,6 +927,7 @@ func ParseFindPetsResponse(rsp *http.Response) (*FindPetsResponse, error) {
 	response := &FindPetsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
@@ -591,7 +938,7 @@ func ParseFindPetsResponse(rsp *http.Response) (*FindPetsResponse, error) {
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode != 200:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -614,6 +961,7 @@ func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
 	response := &AddPetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
@@ -624,7 +972,7 @@ func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode != 200:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -647,10 +995,11 @@ func ParseDeletePetResponse(rsp *http.Response) (*DeletePetResponse, error) {
 	response := &DeletePetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode != 204:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -673,6 +1022,7 @@ func ParseFindPetByIDResponse(rsp *http.Response) (*FindPetByIDResponse, error)
 	response := &FindPetByIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
@@ -683,7 +1033,7 @@ func ParseFindPetByIDResponse(rsp *http.Response) (*FindPetByIDResponse, error)
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode != 200:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
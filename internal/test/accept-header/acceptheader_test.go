@@ -0,0 +1,64 @@
+package acceptheader
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptHeader(t *testing.T) {
+	var accept string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept = r.Header.Get("Accept")
+		switch accept {
+		case "application/xml":
+			w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+			_, _ = w.Write([]byte(`<Pet><Name>Rex</Name></Pet>`))
+		case "text/x-json":
+			w.Header().Set("Content-Type", accept)
+			_, _ = w.Write([]byte(`{"name": "Rex"}`))
+		case "application/vnd.pets.v2+json":
+			w.Header().Set("Content-Type", accept)
+			_, _ = w.Write([]byte(`{"fullName": "Rex the Dog"}`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"name": "Rex"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	// By default, any of the content types of the responses is accepted
+	rsp, err := client.GetPetWithResponse(context.Background(), "rex")
+	require.NoError(t, err)
+	assert.Equal(t, "application/json, application/vnd.pets.v2+json, application/xml, text/x-json, application/problem+json", accept)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, "Rex", *rsp.JSON200.Name)
+	assert.Nil(t, rsp.XML200)
+
+	rsp, err = client.GetPetWithResponse(context.Background(), "rex", AcceptEditor("application/xml"))
+	require.NoError(t, err)
+	assert.Equal(t, "application/xml", accept)
+	require.NotNil(t, rsp.XML200)
+	assert.Equal(t, "Rex", *rsp.XML200.Name)
+	assert.Nil(t, rsp.JSON200)
+
+	// JSON content types which share a field are each decoded into it
+	rsp, err = client.GetPetWithResponse(context.Background(), "rex", AcceptEditor("text/x-json"))
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, "Rex", *rsp.JSON200.Name)
+
+	// Content types without a field of their own are left in Body, rather
+	// than parsed as another JSON type
+	rsp, err = client.GetPetWithResponse(context.Background(), "rex", AcceptEditor("application/vnd.pets.v2+json"))
+	require.NoError(t, err)
+	assert.Nil(t, rsp.JSON200)
+	assert.JSONEq(t, `{"fullName": "Rex the Dog"}`, string(rsp.Body))
+}
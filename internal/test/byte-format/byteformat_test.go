@@ -0,0 +1,75 @@
+package byteformat
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// server records the parameters of the last request, and echoes its body
+type server struct {
+	key    []byte
+	params PutBlobParams
+}
+
+func (s *server) PutBlob(w http.ResponseWriter, r *http.Request, key []byte, params PutBlobParams) {
+	s.key, s.params = key, params
+	var blob Blob
+	if err := json.NewDecoder(r.Body).Decode(&blob); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(blob)
+}
+
+func TestByteFormat(t *testing.T) {
+	var s server
+	ts := httptest.NewServer(Handler(&s))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	// Bytes which encode to every character of base64 which needs escaping
+	key := []byte{0xfb, 0xff, 0xbf}
+	salt := []byte("salt")
+	// Optional bytes aren't pointers, since nil is absent. JSON parameters
+	// aren't base64 encoded either.
+	meta := json.RawMessage(`{"a":[1,2]}`)
+	params := &PutBlobParams{Salt: salt, XSignature: []byte{0, 1, 2}, Meta: meta, XMeta: meta}
+	rsp, err := client.PutBlobWithResponse(context.Background(), key, params, Blob{Data: []byte("hello")})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rsp.StatusCode())
+
+	assert.Equal(t, key, s.key)
+	assert.Equal(t, salt, s.params.Salt)
+	assert.Equal(t, []byte{0, 1, 2}, s.params.XSignature)
+	assert.Equal(t, meta, s.params.Meta)
+	assert.Equal(t, meta, s.params.XMeta)
+
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, []byte("hello"), rsp.JSON200.Data)
+	assert.Nil(t, rsp.JSON200.Checksum)
+	assert.JSONEq(t, `{"data":"aGVsbG8="}`, string(rsp.Body))
+}
+
+func TestAbsentByteFormatParameters(t *testing.T) {
+	var s server
+	ts := httptest.NewServer(Handler(&s))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.PutBlobWithResponse(context.Background(), []byte("key"), &PutBlobParams{XSignature: []byte{0}}, Blob{Data: []byte("hello")})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Nil(t, s.params.Salt)
+	assert.Nil(t, s.params.Meta)
+}
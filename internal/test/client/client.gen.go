@@ -6,18 +6,27 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
 
 const (
 	OpenIdScopes = "OpenId.Scopes"
 )
 
+// ErrorObject defines model for ErrorObject.
+type ErrorObject struct {
+	Message string `json:"message"`
+}
+
 // SchemaObject defines model for SchemaObject.
 type SchemaObject struct {
 	FirstName string `json:"firstName"`
@@ -30,15 +39,37 @@ type PostVendorJsonJSONBody = map[string]interface{}
 // PostBothJSONRequestBody defines body for PostBoth for application/json ContentType.
 type PostBothJSONRequestBody = SchemaObject
 
+// PostBothBinaryRequestBody defines body for PostBoth for application/octet-stream ContentType.
+type PostBothBinaryRequestBody = io.Reader
+
 // PostJsonJSONRequestBody defines body for PostJson for application/json ContentType.
 type PostJsonJSONRequestBody = SchemaObject
 
+// PostOtherBinaryRequestBody defines body for PostOther for application/octet-stream ContentType.
+type PostOtherBinaryRequestBody = io.Reader
+
 // PostVendorJsonJSONRequestBody defines body for PostVendorJson for application/vnd.api+json ContentType.
 type PostVendorJsonJSONRequestBody = PostVendorJsonJSONBody
 
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which is called with every response received, whatever its status.
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// operationIDContextKey is the key of the ID of the operation being called in
+// the contexts which the client passes to request and response editors.
+type operationIDContextKey struct{}
+
+// OperationID returns the ID of the operation being called, as generated from
+// its operationId, from the context passed to request and response editors, so
+// that they can act differently depending on the operation.
+func OperationID(ctx context.Context) (string, bool) {
+	operationID, ok := ctx.Value(operationIDContextKey{}).(string)
+	return operationID, ok
+}
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -61,6 +92,28 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// A list of callbacks for inspecting or modifying responses, which are
+	// called as soon as they're received. If one returns an error, the
+	// response body is closed and the error is returned instead of the response.
+	ResponseEditors []ResponseEditorFn
+
+	// The policy for retrying failed requests, set by WithRetry.
+	retryPolicy *runtime.RetryPolicy
+
+	// The TLS configuration and proxy of the default http.Client, set by
+	// WithTLSConfig and WithProxy.
+	tlsConfig *tls.Config
+	proxy     func(*http.Request) (*url.URL, error)
+
+	// The transport of the default http.Client, set by WithRoundTripper, and
+	// the functions wrapping it, set by WithTransportMiddleware.
+	roundTripper         http.RoundTripper
+	transportMiddlewares []func(http.RoundTripper) http.RoundTripper
+
+	// Whether responses keep the request which was sent, set by
+	// WithCaptureRequest.
+	captureRequest bool
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -85,6 +138,37 @@ func NewClient(server string, opts ...ClientOption) (*Client, error) {
 	// create httpClient, if not already present
 	if client.Client == nil {
 		client.Client = &http.Client{}
+		if client.tlsConfig != nil || client.proxy != nil || client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+			var transport http.RoundTripper = http.DefaultTransport
+			if client.roundTripper != nil {
+				transport = client.roundTripper
+			}
+			if client.tlsConfig != nil || client.proxy != nil {
+				base, ok := transport.(*http.Transport)
+				if !ok {
+					return nil, errors.New("WithTLSConfig and WithProxy can only be combined with a WithRoundTripper of an *http.Transport")
+				}
+				t := base.Clone()
+				if client.tlsConfig != nil {
+					t.TLSClientConfig = client.tlsConfig
+				}
+				if client.proxy != nil {
+					t.Proxy = client.proxy
+				}
+				transport = t
+			}
+			for _, wrap := range client.transportMiddlewares {
+				transport = wrap(transport)
+			}
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.tlsConfig != nil || client.proxy != nil {
+		return nil, errors.New("WithTLSConfig and WithProxy only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	} else if client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+		return nil, errors.New("WithRoundTripper and WithTransportMiddleware only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	}
+	if client.retryPolicy != nil {
+		client.Client = runtime.NewRetryDoer(client.Client, *client.retryPolicy)
 	}
 	return &client, nil
 }
@@ -98,6 +182,77 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	}
 }
 
+// WithTLSConfig sets the TLS configuration, such as the certificates to
+// trust or present, of the http.Client which the client creates. It can't be
+// combined with WithHTTPClient, whose Doer should be configured instead.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		c.tlsConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function which chooses the proxy for each request made
+// by the http.Client which the client creates, such as http.ProxyURL. It
+// can't be combined with WithHTTPClient, whose Doer should be configured
+// instead.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *Client) error {
+		c.proxy = proxy
+		return nil
+	}
+}
+
+// WithRoundTripper sets the transport of the http.Client which the client
+// creates, in place of http.DefaultTransport. When it's an *http.Transport,
+// the TLS configuration and proxy set by WithTLSConfig and WithProxy apply to
+// a copy of it. It can't be combined with WithHTTPClient, whose Doer should
+// be configured instead.
+func WithRoundTripper(roundTripper http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		c.roundTripper = roundTripper
+		return nil
+	}
+}
+
+// WithTransportMiddleware wraps the transport of the http.Client which the
+// client creates, including any TLS configuration and proxy set by
+// WithTLSConfig and WithProxy, with the http.RoundTripper which wrap returns,
+// such as one which logs or traces requests. Each call wraps the transport of
+// the previous ones. It can't be combined with WithHTTPClient, whose Doer
+// should be configured instead.
+func WithTransportMiddleware(wrap func(next http.RoundTripper) http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		c.transportMiddlewares = append(c.transportMiddlewares, wrap)
+		return nil
+	}
+}
+
+// WithRetry makes the client retry requests which fail with a network error
+// or a retryable status code, with exponential backoff which honors any
+// Retry-After header. Unless the policy says otherwise, only GET, PUT, DELETE
+// and HEAD requests are retried, on 502, 503 and 504 responses. The retries
+// wrap whichever Doer the client ends up with, including one set by
+// WithHTTPClient.
+func WithRetry(policy runtime.RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithCaptureRequest makes the client keep a copy of the request which each
+// response answers, after any redirects, as its Request, and so as the
+// HTTPRequest of the responses of the WithResponse methods, for debugging
+// retries. The copy has the URL and headers of the request, but not its body,
+// so that the body can be garbage collected.
+func WithCaptureRequest(capture bool) ClientOption {
+	return func(c *Client) error {
+		c.captureRequest = capture
+		return nil
+	}
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -107,6 +262,16 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called right after receiving a response, before it's parsed. This can be
+// used for metrics, or to turn error responses into errors.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// PostBoth request with any body
@@ -114,9 +279,14 @@ type ClientInterface interface {
 
 	PostBoth(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	PostBothWithBinaryBody(ctx context.Context, body PostBothBinaryRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetBoth request
 	GetBoth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetWithErrorResponse request
+	GetWithErrorResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostJson request with any body
 	PostJsonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -128,6 +298,8 @@ type ClientInterface interface {
 	// PostOther request with any body
 	PostOtherWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	PostOtherWithBinaryBody(ctx context.Context, body PostOtherBinaryRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetOther request
 	GetOther(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -145,11 +317,12 @@ func (c *Client) PostBothWithBody(ctx context.Context, contentType string, body
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "PostBoth")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) PostBoth(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -157,11 +330,25 @@ func (c *Client) PostBoth(ctx context.Context, body PostBothJSONRequestBody, req
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "PostBoth")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) PostBothWithBinaryBody(ctx context.Context, body PostBothBinaryRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBothRequestWithBinaryBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "PostBoth")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetBoth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -169,11 +356,25 @@ func (c *Client) GetBoth(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetBoth")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
+}
+
+func (c *Client) GetWithErrorResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWithErrorResponseRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetWithErrorResponse")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
 }
 
 func (c *Client) PostJsonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -181,11 +382,12 @@ func (c *Client) PostJsonWithBody(ctx context.Context, contentType string, body
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "PostJson")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) PostJson(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -193,11 +395,12 @@ func (c *Client) PostJson(ctx context.Context, body PostJsonJSONRequestBody, req
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "PostJson")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -205,11 +408,12 @@ func (c *Client) GetJson(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetJson")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) PostOtherWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -217,11 +421,25 @@ func (c *Client) PostOtherWithBody(ctx context.Context, contentType string, body
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "PostOther")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) PostOtherWithBinaryBody(ctx context.Context, body PostOtherBinaryRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOtherRequestWithBinaryBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "PostOther")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetOther(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -229,11 +447,12 @@ func (c *Client) GetOther(ctx context.Context, reqEditors ...RequestEditorFn) (*
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetOther")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetJsonWithTrailingSlash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -241,11 +460,12 @@ func (c *Client) GetJsonWithTrailingSlash(ctx context.Context, reqEditors ...Req
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetJsonWithTrailingSlash")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) PostVendorJsonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -253,11 +473,12 @@ func (c *Client) PostVendorJsonWithBody(ctx context.Context, contentType string,
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "PostVendorJson")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) PostVendorJson(ctx context.Context, body PostVendorJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -265,11 +486,12 @@ func (c *Client) PostVendorJson(ctx context.Context, body PostVendorJsonJSONRequ
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "PostVendorJson")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 // NewPostBothRequest calls the generic PostBoth builder with application/json body
@@ -283,6 +505,13 @@ func NewPostBothRequest(server string, body PostBothJSONRequestBody) (*http.Requ
 	return NewPostBothRequestWithBody(server, "application/json", bodyReader)
 }
 
+// NewPostBothRequestWithBinaryBody calls the generic PostBoth builder with application/octet-stream body
+func NewPostBothRequestWithBinaryBody(server string, body PostBothBinaryRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = body
+	return NewPostBothRequestWithBody(server, "application/octet-stream", bodyReader)
+}
+
 // NewPostBothRequestWithBody generates requests for PostBoth with any type of body
 func NewPostBothRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -339,6 +568,33 @@ func NewGetBothRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewGetWithErrorResponseRequest generates requests for GetWithErrorResponse
+func NewGetWithErrorResponseRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_error_response")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewPostJsonRequest calls the generic PostJson builder with application/json body
 func NewPostJsonRequest(server string, body PostJsonJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -406,6 +662,13 @@ func NewGetJsonRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewPostOtherRequestWithBinaryBody calls the generic PostOther builder with application/octet-stream body
+func NewPostOtherRequestWithBinaryBody(server string, body PostOtherBinaryRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = body
+	return NewPostOtherRequestWithBody(server, "application/octet-stream", bodyReader)
+}
+
 // NewPostOtherRequestWithBody generates requests for PostOther with any type of body
 func NewPostOtherRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -529,6 +792,40 @@ func NewPostVendorJsonRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
+// do sends the request, then calls the response editors.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	rsp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.captureRequest {
+		rsp.Request = captureRequest(rsp, req)
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(ctx, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// capturedRequestContextKey marks the copies of requests which clients made
+// WithCaptureRequest keep in their responses.
+type capturedRequestContextKey struct{}
+
+// captureRequest returns a copy of the request which rsp answers, falling
+// back to req, without its body.
+func captureRequest(rsp *http.Response, req *http.Request) *http.Request {
+	if rsp.Request != nil {
+		req = rsp.Request
+	}
+	captured := req.Clone(context.WithValue(req.Context(), capturedRequestContextKey{}, true))
+	captured.Body = nil
+	captured.GetBody = nil
+	return captured
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -577,9 +874,14 @@ type ClientWithResponsesInterface interface {
 
 	PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error)
 
+	PostBothWithBinaryBodyWithResponse(ctx context.Context, body PostBothBinaryRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error)
+
 	// GetBoth request
 	GetBothWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBothResponse, error)
 
+	// GetWithErrorResponse request
+	GetWithErrorResponseWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWithErrorResponseResponse, error)
+
 	// PostJson request with any body
 	PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error)
 
@@ -591,6 +893,8 @@ type ClientWithResponsesInterface interface {
 	// PostOther request with any body
 	PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOtherResponse, error)
 
+	PostOtherWithBinaryBodyWithResponse(ctx context.Context, body PostOtherBinaryRequestBody, reqEditors ...RequestEditorFn) (*PostOtherResponse, error)
+
 	// GetOther request
 	GetOtherWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error)
 
@@ -603,9 +907,12 @@ type ClientWithResponsesInterface interface {
 	PostVendorJsonWithResponse(ctx context.Context, body PostVendorJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVendorJsonResponse, error)
 }
 
+var _ ClientWithResponsesInterface = (*ClientWithResponses)(nil)
+
 type PostBothResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -624,9 +931,29 @@ func (r PostBothResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r PostBothResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// PostBothExpectedStatusCodes returns the status codes which PostBoth has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func PostBothExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// PostBothHasDefaultResponse is whether PostBoth has a default response,
+// for status codes which aren't in PostBothExpectedStatusCodes.
+const PostBothHasDefaultResponse = false
+
 type GetBothResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -645,9 +972,75 @@ func (r GetBothResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetBothResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetBothExpectedStatusCodes returns the status codes which GetBoth has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetBothExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetBothHasDefaultResponse is whether GetBoth has a default response,
+// for status codes which aren't in GetBothExpectedStatusCodes.
+const GetBothHasDefaultResponse = false
+
+type GetWithErrorResponseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+	JSON200      *SchemaObject
+	JSON404      *ErrorObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWithErrorResponseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWithErrorResponseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetWithErrorResponseResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	if r.JSON404 != nil {
+		return fmt.Errorf("%s: %+v", r.HTTPResponse.Status, *r.JSON404)
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetWithErrorResponseExpectedStatusCodes returns the status codes which GetWithErrorResponse has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetWithErrorResponseExpectedStatusCodes() []int {
+	return []int{200, 404}
+}
+
+// GetWithErrorResponseHasDefaultResponse is whether GetWithErrorResponse has a default response,
+// for status codes which aren't in GetWithErrorResponseExpectedStatusCodes.
+const GetWithErrorResponseHasDefaultResponse = false
+
 type PostJsonResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -666,9 +1059,29 @@ func (r PostJsonResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r PostJsonResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// PostJsonExpectedStatusCodes returns the status codes which PostJson has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func PostJsonExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// PostJsonHasDefaultResponse is whether PostJson has a default response,
+// for status codes which aren't in PostJsonExpectedStatusCodes.
+const PostJsonHasDefaultResponse = false
+
 type GetJsonResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -687,9 +1100,29 @@ func (r GetJsonResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetJsonResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetJsonExpectedStatusCodes returns the status codes which GetJson has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetJsonExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetJsonHasDefaultResponse is whether GetJson has a default response,
+// for status codes which aren't in GetJsonExpectedStatusCodes.
+const GetJsonHasDefaultResponse = false
+
 type PostOtherResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -708,9 +1141,29 @@ func (r PostOtherResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r PostOtherResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// PostOtherExpectedStatusCodes returns the status codes which PostOther has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func PostOtherExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// PostOtherHasDefaultResponse is whether PostOther has a default response,
+// for status codes which aren't in PostOtherExpectedStatusCodes.
+const PostOtherHasDefaultResponse = false
+
 type GetOtherResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -729,9 +1182,29 @@ func (r GetOtherResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetOtherResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetOtherExpectedStatusCodes returns the status codes which GetOther has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetOtherExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetOtherHasDefaultResponse is whether GetOther has a default response,
+// for status codes which aren't in GetOtherExpectedStatusCodes.
+const GetOtherHasDefaultResponse = false
+
 type GetJsonWithTrailingSlashResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -750,9 +1223,29 @@ func (r GetJsonWithTrailingSlashResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetJsonWithTrailingSlashResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetJsonWithTrailingSlashExpectedStatusCodes returns the status codes which GetJsonWithTrailingSlash has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetJsonWithTrailingSlashExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetJsonWithTrailingSlashHasDefaultResponse is whether GetJsonWithTrailingSlash has a default response,
+// for status codes which aren't in GetJsonWithTrailingSlashExpectedStatusCodes.
+const GetJsonWithTrailingSlashHasDefaultResponse = false
+
 type PostVendorJsonResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -771,6 +1264,25 @@ func (r PostVendorJsonResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r PostVendorJsonResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// PostVendorJsonExpectedStatusCodes returns the status codes which PostVendorJson has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func PostVendorJsonExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// PostVendorJsonHasDefaultResponse is whether PostVendorJson has a default response,
+// for status codes which aren't in PostVendorJsonExpectedStatusCodes.
+const PostVendorJsonHasDefaultResponse = false
+
 // PostBothWithBodyWithResponse request with arbitrary body returning *PostBothResponse
 func (c *ClientWithResponses) PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
 	rsp, err := c.PostBothWithBody(ctx, contentType, body, reqEditors...)
@@ -788,6 +1300,14 @@ func (c *ClientWithResponses) PostBothWithResponse(ctx context.Context, body Pos
 	return ParsePostBothResponse(rsp)
 }
 
+func (c *ClientWithResponses) PostBothWithBinaryBodyWithResponse(ctx context.Context, body PostBothBinaryRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	rsp, err := c.PostBothWithBinaryBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBothResponse(rsp)
+}
+
 // GetBothWithResponse request returning *GetBothResponse
 func (c *ClientWithResponses) GetBothWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBothResponse, error) {
 	rsp, err := c.GetBoth(ctx, reqEditors...)
@@ -797,6 +1317,15 @@ func (c *ClientWithResponses) GetBothWithResponse(ctx context.Context, reqEditor
 	return ParseGetBothResponse(rsp)
 }
 
+// GetWithErrorResponseWithResponse request returning *GetWithErrorResponseResponse
+func (c *ClientWithResponses) GetWithErrorResponseWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWithErrorResponseResponse, error) {
+	rsp, err := c.GetWithErrorResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWithErrorResponseResponse(rsp)
+}
+
 // PostJsonWithBodyWithResponse request with arbitrary body returning *PostJsonResponse
 func (c *ClientWithResponses) PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
 	rsp, err := c.PostJsonWithBody(ctx, contentType, body, reqEditors...)
@@ -832,6 +1361,14 @@ func (c *ClientWithResponses) PostOtherWithBodyWithResponse(ctx context.Context,
 	return ParsePostOtherResponse(rsp)
 }
 
+func (c *ClientWithResponses) PostOtherWithBinaryBodyWithResponse(ctx context.Context, body PostOtherBinaryRequestBody, reqEditors ...RequestEditorFn) (*PostOtherResponse, error) {
+	rsp, err := c.PostOtherWithBinaryBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOtherResponse(rsp)
+}
+
 // GetOtherWithResponse request returning *GetOtherResponse
 func (c *ClientWithResponses) GetOtherWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error) {
 	rsp, err := c.GetOther(ctx, reqEditors...)
@@ -867,6 +1404,15 @@ func (c *ClientWithResponses) PostVendorJsonWithResponse(ctx context.Context, bo
 	return ParsePostVendorJsonResponse(rsp)
 }
 
+// capturedRequest returns the request kept in rsp by a client made
+// WithCaptureRequest(true), or nil.
+func capturedRequest(rsp *http.Response) *http.Request {
+	if rsp.Request == nil || rsp.Request.Context().Value(capturedRequestContextKey{}) == nil {
+		return nil
+	}
+	return rsp.Request
+}
+
 // ParsePostBothResponse parses an HTTP response from a PostBothWithResponse call
 func ParsePostBothResponse(rsp *http.Response) (*PostBothResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -878,6 +1424,7 @@ func ParsePostBothResponse(rsp *http.Response) (*PostBothResponse, error) {
 	response := &PostBothResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -894,6 +1441,41 @@ func ParseGetBothResponse(rsp *http.Response) (*GetBothResponse, error) {
 	response := &GetBothResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	return response, nil
+}
+
+// ParseGetWithErrorResponseResponse parses an HTTP response from a GetWithErrorResponseWithResponse call
+func ParseGetWithErrorResponseResponse(rsp *http.Response) (*GetWithErrorResponseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWithErrorResponseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
@@ -910,6 +1492,7 @@ func ParsePostJsonResponse(rsp *http.Response) (*PostJsonResponse, error) {
 	response := &PostJsonResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -926,6 +1509,7 @@ func ParseGetJsonResponse(rsp *http.Response) (*GetJsonResponse, error) {
 	response := &GetJsonResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -942,6 +1526,7 @@ func ParsePostOtherResponse(rsp *http.Response) (*PostOtherResponse, error) {
 	response := &PostOtherResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -958,6 +1543,7 @@ func ParseGetOtherResponse(rsp *http.Response) (*GetOtherResponse, error) {
 	response := &GetOtherResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -974,6 +1560,7 @@ func ParseGetJsonWithTrailingSlashResponse(rsp *http.Response) (*GetJsonWithTrai
 	response := &GetJsonWithTrailingSlashResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -990,6 +1577,7 @@ func ParsePostVendorJsonResponse(rsp *http.Response) (*PostVendorJsonResponse, e
 	response := &PostVendorJsonResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
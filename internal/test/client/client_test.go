@@ -1,9 +1,23 @@
 package client
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTemp(t *testing.T) {
@@ -42,3 +56,358 @@ func TestTemp(t *testing.T) {
 	assert.Equal(t, expectedURL, client3.Server)
 	assert.Equal(t, expectedURL, client4.Server)
 }
+
+func TestResponseError(t *testing.T) {
+	newResponse := func(status int, body string) *http.Response {
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	rsp, err := ParseGetWithErrorResponseResponse(newResponse(http.StatusOK, `{"role":"admin","firstName":"Alex"}`))
+	require.NoError(t, err)
+	assert.NoError(t, rsp.Error())
+
+	rsp, err = ParseGetWithErrorResponseResponse(newResponse(http.StatusNotFound, `{"message":"no such object"}`))
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON404)
+	assert.EqualError(t, rsp.Error(), "404 Not Found: {Message:no such object}")
+
+	rsp, err = ParseGetWithErrorResponseResponse(newResponse(http.StatusInternalServerError, `oops`))
+	require.NoError(t, err)
+	assert.EqualError(t, rsp.Error(), "500 Internal Server Error")
+}
+
+func TestExpectedStatusCodes(t *testing.T) {
+	assert.Equal(t, []int{http.StatusOK, http.StatusNotFound}, GetWithErrorResponseExpectedStatusCodes())
+	assert.False(t, GetWithErrorResponseHasDefaultResponse)
+}
+
+// statusSequenceDoer responds with the given status codes in turn.
+type statusSequenceDoer struct {
+	statuses []int
+	calls    int
+}
+
+func (d *statusSequenceDoer) Do(req *http.Request) (*http.Response, error) {
+	status := d.statuses[d.calls]
+	d.calls++
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"role":"admin","firstName":"Alex"}`)),
+	}, nil
+}
+
+func TestWithRetry(t *testing.T) {
+	doer := &statusSequenceDoer{statuses: []int{http.StatusServiceUnavailable, http.StatusOK}}
+
+	// WithRetry wraps the Doer regardless of the order of the options
+	client, err := NewClientWithResponses("https://my-api.com",
+		WithRetry(runtime.RetryPolicy{InitialBackoff: time.Millisecond}),
+		WithHTTPClient(doer),
+	)
+	require.NoError(t, err)
+
+	rsp, err := client.GetWithErrorResponseWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Equal(t, 2, doer.calls)
+
+	// Without WithRetry, nothing is retried
+	doer = &statusSequenceDoer{statuses: []int{http.StatusServiceUnavailable, http.StatusOK}}
+	client, err = NewClientWithResponses("https://my-api.com", WithHTTPClient(doer))
+	require.NoError(t, err)
+
+	rsp, err = client.GetWithErrorResponseWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, rsp.StatusCode())
+	assert.Equal(t, 1, doer.calls)
+}
+
+func TestWithTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	client, err := NewClientWithResponses(server.URL, WithTLSConfig(&tls.Config{RootCAs: roots}))
+	require.NoError(t, err)
+
+	rsp, err := client.GetWithErrorResponseWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+
+	// The server's certificate isn't trusted by default
+	client, err = NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	_, err = client.GetWithErrorResponseWithResponse(context.Background())
+	assert.Error(t, err)
+}
+
+func TestWithProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+	client, err := NewClientWithResponses("http://my-api.invalid", WithProxy(http.ProxyURL(proxyURL)))
+	require.NoError(t, err)
+
+	rsp, err := client.GetWithErrorResponseWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Equal(t, "http://my-api.invalid/with_error_response", proxied)
+
+	// A custom Doer would silently ignore the proxy
+	_, err = NewClientWithResponses("http://my-api.invalid", WithProxy(http.ProxyURL(proxyURL)), WithHTTPClient(&http.Client{}))
+	assert.EqualError(t, err, "WithTLSConfig and WithProxy only apply to the default http.Client, so can't be combined with WithHTTPClient")
+}
+
+// roundTripperFunc is an http.RoundTripper calling the function it is
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWithTransportMiddleware(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "outer", r.Header.Get("X-Outer"))
+		assert.Equal(t, "inner", r.Header.Get("X-Inner"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var calls []string
+	withHeader := func(name, value string) func(http.RoundTripper) http.RoundTripper {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, value)
+				req = req.Clone(req.Context())
+				req.Header.Set(name, value)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	// The wrapped transport keeps the TLS configuration
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	client, err := NewClientWithResponses(server.URL,
+		WithTransportMiddleware(withHeader("X-Inner", "inner")),
+		WithTLSConfig(&tls.Config{RootCAs: roots}),
+		WithTransportMiddleware(withHeader("X-Outer", "outer")))
+	require.NoError(t, err)
+
+	rsp, err := client.GetWithErrorResponseWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Equal(t, []string{"outer", "inner"}, calls)
+
+	// A custom Doer would silently ignore the transport
+	_, err = NewClientWithResponses(server.URL, WithTransportMiddleware(withHeader("X-Outer", "outer")), WithHTTPClient(&http.Client{}))
+	assert.EqualError(t, err, "WithRoundTripper and WithTransportMiddleware only apply to the default http.Client, so can't be combined with WithHTTPClient")
+}
+
+func TestWithRoundTripper(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+
+	// The TLS configuration applies to a copy of the transport
+	transport := &http.Transport{}
+	client, err := NewClientWithResponses(server.URL,
+		WithRoundTripper(transport),
+		WithTLSConfig(&tls.Config{RootCAs: roots}))
+	require.NoError(t, err)
+	rsp, err := client.GetWithErrorResponseWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	// Cloning sets up HTTP/2 with a TLS configuration of its own, without the roots
+	assert.True(t, transport.TLSClientConfig == nil || transport.TLSClientConfig.RootCAs == nil)
+
+	// Other round trippers are used as they are, under any middleware
+	var calls []string
+	client, err = NewClientWithResponses(server.URL,
+		WithRoundTripper(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			calls = append(calls, "transport "+req.Header.Get("X-Outer"))
+			return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Request: req}, nil
+		})),
+		WithTransportMiddleware(func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				req = req.Clone(req.Context())
+				req.Header.Set("X-Outer", "outer")
+				return next.RoundTrip(req)
+			})
+		}))
+	require.NoError(t, err)
+	rsp, err = client.GetWithErrorResponseWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rsp.StatusCode())
+	assert.Equal(t, []string{"transport outer"}, calls)
+
+	// but can't have a TLS configuration or proxy set
+	_, err = NewClientWithResponses(server.URL,
+		WithRoundTripper(roundTripperFunc(http.DefaultTransport.RoundTrip)),
+		WithProxy(http.ProxyFromEnvironment))
+	assert.EqualError(t, err, "WithTLSConfig and WithProxy can only be combined with a WithRoundTripper of an *http.Transport")
+
+	_, err = NewClientWithResponses(server.URL, WithRoundTripper(transport), WithHTTPClient(&http.Client{}))
+	assert.EqualError(t, err, "WithRoundTripper and WithTransportMiddleware only apply to the default http.Client, so can't be combined with WithHTTPClient")
+}
+
+func TestWithResponseEditorFn(t *testing.T) {
+	var statuses []int
+	recordStatus := func(ctx context.Context, rsp *http.Response) error {
+		statuses = append(statuses, rsp.StatusCode)
+		return nil
+	}
+	errNotFound := errors.New("not found")
+	translateNotFound := func(ctx context.Context, rsp *http.Response) error {
+		if rsp.StatusCode == http.StatusNotFound {
+			return errNotFound
+		}
+		return nil
+	}
+
+	doer := &statusSequenceDoer{statuses: []int{http.StatusOK, http.StatusInternalServerError, http.StatusNotFound}}
+	client, err := NewClientWithResponses("https://my-api.com",
+		WithHTTPClient(doer),
+		WithResponseEditorFn(recordStatus),
+		WithResponseEditorFn(translateNotFound),
+	)
+	require.NoError(t, err)
+
+	rsp, err := client.GetWithErrorResponseWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+
+	// Editors see responses of any status
+	rsp, err = client.GetWithErrorResponseWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, rsp.StatusCode())
+
+	// An error from an editor is returned instead of parsing the response
+	rsp, err = client.GetWithErrorResponseWithResponse(context.Background())
+	assert.ErrorIs(t, err, errNotFound)
+	assert.Nil(t, rsp)
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusInternalServerError, http.StatusNotFound}, statuses)
+}
+
+func TestOperationID(t *testing.T) {
+	var operationIDs []string
+	recordOperationID := func(ctx context.Context, req *http.Request) error {
+		operationID, ok := OperationID(ctx)
+		require.True(t, ok)
+		// The request's context carries the operation ID too
+		fromRequest, _ := OperationID(req.Context())
+		assert.Equal(t, operationID, fromRequest)
+		operationIDs = append(operationIDs, operationID)
+		return nil
+	}
+
+	doer := &statusSequenceDoer{statuses: []int{http.StatusOK, http.StatusOK, http.StatusOK}}
+	client, err := NewClientWithResponses("https://my-api.com",
+		WithHTTPClient(doer),
+		WithRequestEditorFn(recordOperationID),
+	)
+	require.NoError(t, err)
+
+	_, err = client.GetWithErrorResponseWithResponse(context.Background())
+	require.NoError(t, err)
+	_, err = client.GetBothWithResponse(context.Background())
+	require.NoError(t, err)
+	// Per-call editors see it as well
+	_, err = client.GetBothWithResponse(context.Background(), func(ctx context.Context, req *http.Request) error {
+		operationID, _ := OperationID(ctx)
+		assert.Equal(t, "GetBoth", operationID)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GetWithErrorResponse", "GetBoth", "GetBoth"}, operationIDs)
+
+	_, ok := OperationID(context.Background())
+	assert.False(t, ok)
+}
+
+// echoDoer responds with the body of the request, with its content type.
+type echoDoer struct{}
+
+func (echoDoer) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{req.Header.Get("Content-Type")}},
+		Body:       req.Body,
+	}, nil
+}
+
+func TestBinaryBody(t *testing.T) {
+	client, err := NewClientWithResponses("https://my-api.com", WithHTTPClient(echoDoer{}))
+	require.NoError(t, err)
+
+	data := []byte{0x00, 0x7b, 0xff}
+	rsp, err := client.PostOtherWithBinaryBodyWithResponse(context.Background(), bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, "application/octet-stream", rsp.HTTPResponse.Header.Get("Content-Type"))
+	// The response body is left as it is
+	assert.Equal(t, data, rsp.Body)
+}
+
+func TestWithCaptureRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/with_json_response" {
+			http.Redirect(w, r, "/moved", http.StatusFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	addHeader := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-Trace", "abc")
+		return nil
+	}
+	client, err := NewClientWithResponses(server.URL, WithCaptureRequest(true), WithRequestEditorFn(addHeader))
+	require.NoError(t, err)
+
+	// The request kept is the last one, after redirects
+	rsp, err := client.GetJsonWithResponse(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, rsp.HTTPRequest)
+	assert.Equal(t, "/moved", rsp.HTTPRequest.URL.Path)
+	assert.Equal(t, "abc", rsp.HTTPRequest.Header.Get("X-Trace"))
+
+	// Without its body
+	postRsp, err := client.PostJsonWithResponse(context.Background(), PostJsonJSONRequestBody{})
+	require.NoError(t, err)
+	require.NotNil(t, postRsp.HTTPRequest)
+	assert.Equal(t, http.MethodPost, postRsp.HTTPRequest.Method)
+	assert.Nil(t, postRsp.HTTPRequest.Body)
+	assert.Nil(t, postRsp.HTTPRequest.GetBody)
+
+	// Requests aren't kept by default
+	client, err = NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	rsp, err = client.GetJsonWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, rsp.HTTPRequest)
+}
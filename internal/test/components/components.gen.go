@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
@@ -18,6 +19,21 @@ const (
 	Enum1Two   Enum1 = "Two"
 )
 
+// String returns the Enum1 as a string.
+func (e Enum1) String() string {
+	return string(e)
+}
+
+// ParseEnum1 parses s as a Enum1, returning an error if it
+// isn't one of the known values.
+func ParseEnum1(s string) (Enum1, error) {
+	switch e := Enum1(s); e {
+	case Enum1One, Enum1Three, Enum1Two:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid Enum1 value %q", s)
+}
+
 // Defines values for Enum2.
 const (
 	Enum2Four  Enum2 = "Four"
@@ -25,6 +41,21 @@ const (
 	Enum2Two   Enum2 = "Two"
 )
 
+// String returns the Enum2 as a string.
+func (e Enum2) String() string {
+	return string(e)
+}
+
+// ParseEnum2 parses s as a Enum2, returning an error if it
+// isn't one of the known values.
+func ParseEnum2(s string) (Enum2, error) {
+	switch e := Enum2(s); e {
+	case Enum2Four, Enum2Three, Enum2Two:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid Enum2 value %q", s)
+}
+
 // Defines values for Enum3.
 const (
 	Enum3Bar      Enum3 = "Bar"
@@ -32,6 +63,21 @@ const (
 	Enum3Foo      Enum3 = "Foo"
 )
 
+// String returns the Enum3 as a string.
+func (e Enum3) String() string {
+	return string(e)
+}
+
+// ParseEnum3 parses s as a Enum3, returning an error if it
+// isn't one of the known values.
+func ParseEnum3(s string) (Enum3, error) {
+	switch e := Enum3(s); e {
+	case Enum3Bar, Enum3Enum1One, Enum3Foo:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid Enum3 value %q", s)
+}
+
 // Defines values for Enum4.
 const (
 	Cat   Enum4 = "Cat"
@@ -39,6 +85,21 @@ const (
 	Mouse Enum4 = "Mouse"
 )
 
+// String returns the Enum4 as a string.
+func (e Enum4) String() string {
+	return string(e)
+}
+
+// ParseEnum4 parses s as a Enum4, returning an error if it
+// isn't one of the known values.
+func ParseEnum4(s string) (Enum4, error) {
+	switch e := Enum4(s); e {
+	case Cat, Dog, Mouse:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid Enum4 value %q", s)
+}
+
 // Defines values for Enum5.
 const (
 	Enum5N5 Enum5 = 5
@@ -46,6 +107,38 @@ const (
 	Enum5N7 Enum5 = 7
 )
 
+// enum5Names maps each Enum5 value to the name of its constant.
+var enum5Names = map[Enum5]string{
+	Enum5N5: "Enum5N5",
+	Enum5N6: "Enum5N6",
+	Enum5N7: "Enum5N7",
+}
+
+// String returns the name of the Enum5's constant, or its number if
+// it isn't one of the known values.
+func (e Enum5) String() string {
+	if name, ok := enum5Names[e]; ok {
+		return name
+	}
+	return fmt.Sprintf("Enum5(%d)", e)
+}
+
+// ParseEnum5 parses s, either the name of one of the Enum5
+// constants or its number, returning an error if it isn't one of the known values.
+func ParseEnum5(s string) (Enum5, error) {
+	for e, name := range enum5Names {
+		if name == s {
+			return e, nil
+		}
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if _, ok := enum5Names[Enum5(i)]; ok {
+			return Enum5(i), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid Enum5 value %q", s)
+}
+
 // Defines values for EnumUnion.
 const (
 	EnumUnionFour  EnumUnion = "Four"
@@ -54,6 +147,21 @@ const (
 	EnumUnionTwo   EnumUnion = "Two"
 )
 
+// String returns the EnumUnion as a string.
+func (eu EnumUnion) String() string {
+	return string(eu)
+}
+
+// ParseEnumUnion parses s as a EnumUnion, returning an error if it
+// isn't one of the known values.
+func ParseEnumUnion(s string) (EnumUnion, error) {
+	switch e := EnumUnion(s); e {
+	case EnumUnionFour, EnumUnionOne, EnumUnionThree, EnumUnionTwo:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid EnumUnion value %q", s)
+}
+
 // Defines values for EnumUnion2.
 const (
 	EnumUnion2One   EnumUnion2 = "One"
@@ -62,6 +170,21 @@ const (
 	EnumUnion2Two   EnumUnion2 = "Two"
 )
 
+// String returns the EnumUnion2 as a string.
+func (eu EnumUnion2) String() string {
+	return string(eu)
+}
+
+// ParseEnumUnion2 parses s as a EnumUnion2, returning an error if it
+// isn't one of the known values.
+func ParseEnumUnion2(s string) (EnumUnion2, error) {
+	switch e := EnumUnion2(s); e {
+	case EnumUnion2One, EnumUnion2Seven, EnumUnion2Three, EnumUnion2Two:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid EnumUnion2 value %q", s)
+}
+
 // Defines values for FunnyValues.
 const (
 	FunnyValuesAnd      FunnyValues = "&"
@@ -71,6 +194,21 @@ const (
 	FunnyValuesPercent  FunnyValues = "%"
 )
 
+// String returns the FunnyValues as a string.
+func (fv FunnyValues) String() string {
+	return string(fv)
+}
+
+// ParseFunnyValues parses s as a FunnyValues, returning an error if it
+// isn't one of the known values.
+func ParseFunnyValues(s string) (FunnyValues, error) {
+	switch e := FunnyValues(s); e {
+	case FunnyValuesAnd, FunnyValuesAsterisk, FunnyValuesEmpty, FunnyValuesN5, FunnyValuesPercent:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid FunnyValues value %q", s)
+}
+
 // Defines values for EnumParam1.
 const (
 	EnumParam1Both EnumParam1 = "both"
@@ -78,6 +216,21 @@ const (
 	EnumParam1On   EnumParam1 = "on"
 )
 
+// String returns the EnumParam1 as a string.
+func (ep EnumParam1) String() string {
+	return string(ep)
+}
+
+// ParseEnumParam1 parses s as a EnumParam1, returning an error if it
+// isn't one of the known values.
+func ParseEnumParam1(s string) (EnumParam1, error) {
+	switch e := EnumParam1(s); e {
+	case EnumParam1Both, EnumParam1Off, EnumParam1On:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid EnumParam1 value %q", s)
+}
+
 // Defines values for EnumParam2.
 const (
 	EnumParam2Both EnumParam2 = "both"
@@ -85,6 +238,21 @@ const (
 	EnumParam2On   EnumParam2 = "on"
 )
 
+// String returns the EnumParam2 as a string.
+func (ep EnumParam2) String() string {
+	return string(ep)
+}
+
+// ParseEnumParam2 parses s as a EnumParam2, returning an error if it
+// isn't one of the known values.
+func ParseEnumParam2(s string) (EnumParam2, error) {
+	switch e := EnumParam2(s); e {
+	case EnumParam2Both, EnumParam2Off, EnumParam2On:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid EnumParam2 value %q", s)
+}
+
 // Defines values for EnumParam3.
 const (
 	Alice EnumParam3 = "alice"
@@ -92,6 +260,21 @@ const (
 	Eve   EnumParam3 = "eve"
 )
 
+// String returns the EnumParam3 as a string.
+func (ep EnumParam3) String() string {
+	return string(ep)
+}
+
+// ParseEnumParam3 parses s as a EnumParam3, returning an error if it
+// isn't one of the known values.
+func ParseEnumParam3(s string) (EnumParam3, error) {
+	switch e := EnumParam3(s); e {
+	case Alice, Bob, Eve:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid EnumParam3 value %q", s)
+}
+
 // AdditionalPropertiesObject1 Has additional properties of type int
 type AdditionalPropertiesObject1 struct {
 	Id                   int            `json:"id"`
@@ -224,6 +407,11 @@ type OneOfObject13 struct {
 	union                json.RawMessage
 }
 
+// OneOfObject14 oneOf with discriminator that the variants don't declare
+type OneOfObject14 struct {
+	union json.RawMessage
+}
+
 // OneOfObject2 oneOf with inline elements
 type OneOfObject2 struct {
 	union json.RawMessage
@@ -276,6 +464,11 @@ type OneOfObject62 struct {
 	union json.RawMessage
 }
 
+// OneOfObject63 oneOf with discriminator and several values mapping to one schema
+type OneOfObject63 struct {
+	union json.RawMessage
+}
+
 // OneOfObject7 array of oneOf
 type OneOfObject7 = []OneOfObject7_Item
 
@@ -409,27 +602,27 @@ type EnsureEverythingIsReferencedJSONRequestBody EnsureEverythingIsReferencedJSO
 type EnsureEverythingIsReferencedTextRequestBody = EnsureEverythingIsReferencedTextBody
 
 // BodyWithAddPropsJSONRequestBody defines body for BodyWithAddProps for application/json ContentType.
-type BodyWithAddPropsJSONRequestBody BodyWithAddPropsJSONBody
+type BodyWithAddPropsJSONRequestBody = BodyWithAddPropsJSONBody
 
 // Getter for additional properties for BodyWithAddPropsJSONBody. Returns the specified
 // element and whether it was found
-func (a BodyWithAddPropsJSONBody) Get(fieldName string) (value interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
+func (bwapjb BodyWithAddPropsJSONBody) Get(fieldName string) (value interface{}, found bool) {
+	if bwapjb.AdditionalProperties != nil {
+		value, found = bwapjb.AdditionalProperties[fieldName]
 	}
 	return
 }
 
 // Setter for additional properties for BodyWithAddPropsJSONBody
-func (a *BodyWithAddPropsJSONBody) Set(fieldName string, value interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]interface{})
+func (bwapjb *BodyWithAddPropsJSONBody) Set(fieldName string, value interface{}) {
+	if bwapjb.AdditionalProperties == nil {
+		bwapjb.AdditionalProperties = make(map[string]interface{})
 	}
-	a.AdditionalProperties[fieldName] = value
+	bwapjb.AdditionalProperties[fieldName] = value
 }
 
 // Override default JSON handling for BodyWithAddPropsJSONBody to handle AdditionalProperties
-func (a *BodyWithAddPropsJSONBody) UnmarshalJSON(b []byte) error {
+func (bwapjb *BodyWithAddPropsJSONBody) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -437,7 +630,7 @@ func (a *BodyWithAddPropsJSONBody) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["inner"]; found {
-		err = json.Unmarshal(raw, &a.Inner)
+		err = json.Unmarshal(raw, &bwapjb.Inner)
 		if err != nil {
 			return fmt.Errorf("error reading 'inner': %w", err)
 		}
@@ -445,7 +638,7 @@ func (a *BodyWithAddPropsJSONBody) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+		err = json.Unmarshal(raw, &bwapjb.Name)
 		if err != nil {
 			return fmt.Errorf("error reading 'name': %w", err)
 		}
@@ -453,62 +646,60 @@ func (a *BodyWithAddPropsJSONBody) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]interface{})
+		bwapjb.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
 			var fieldVal interface{}
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshalling field %s: %w", fieldName, err)
 			}
-			a.AdditionalProperties[fieldName] = fieldVal
+			bwapjb.AdditionalProperties[fieldName] = fieldVal
 		}
 	}
 	return nil
 }
 
-// Override default JSON handling for BodyWithAddPropsJSONBody to handle AdditionalProperties
-func (a BodyWithAddPropsJSONBody) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
+// Override default JSON handling for BodyWithAddPropsJSONBody to handle AdditionalProperties,
+// writing the properties in order, followed by the additional properties
+// sorted by name, so that the output is always the same
+func (bwapjb BodyWithAddPropsJSONBody) MarshalJSON() ([]byte, error) {
+	var object runtime.JSONObject
 
-	object["inner"], err = json.Marshal(a.Inner)
-	if err != nil {
+	if err := object.Set("inner", bwapjb.Inner); err != nil {
 		return nil, fmt.Errorf("error marshaling 'inner': %w", err)
 	}
 
-	object["name"], err = json.Marshal(a.Name)
-	if err != nil {
+	if err := object.Set("name", bwapjb.Name); err != nil {
 		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
+	for _, fieldName := range runtime.SortedKeys(bwapjb.AdditionalProperties) {
+		if err := object.Set(fieldName, bwapjb.AdditionalProperties[fieldName]); err != nil {
 			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
 		}
 	}
-	return json.Marshal(object)
+	return object.MarshalJSON()
 }
 
 // Getter for additional properties for AdditionalPropertiesObject1. Returns the specified
 // element and whether it was found
-func (a AdditionalPropertiesObject1) Get(fieldName string) (value int, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
+func (apo AdditionalPropertiesObject1) Get(fieldName string) (value int, found bool) {
+	if apo.AdditionalProperties != nil {
+		value, found = apo.AdditionalProperties[fieldName]
 	}
 	return
 }
 
 // Setter for additional properties for AdditionalPropertiesObject1
-func (a *AdditionalPropertiesObject1) Set(fieldName string, value int) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]int)
+func (apo *AdditionalPropertiesObject1) Set(fieldName string, value int) {
+	if apo.AdditionalProperties == nil {
+		apo.AdditionalProperties = make(map[string]int)
 	}
-	a.AdditionalProperties[fieldName] = value
+	apo.AdditionalProperties[fieldName] = value
 }
 
 // Override default JSON handling for AdditionalPropertiesObject1 to handle AdditionalProperties
-func (a *AdditionalPropertiesObject1) UnmarshalJSON(b []byte) error {
+func (apo *AdditionalPropertiesObject1) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -516,7 +707,7 @@ func (a *AdditionalPropertiesObject1) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["id"]; found {
-		err = json.Unmarshal(raw, &a.Id)
+		err = json.Unmarshal(raw, &apo.Id)
 		if err != nil {
 			return fmt.Errorf("error reading 'id': %w", err)
 		}
@@ -524,7 +715,7 @@ func (a *AdditionalPropertiesObject1) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+		err = json.Unmarshal(raw, &apo.Name)
 		if err != nil {
 			return fmt.Errorf("error reading 'name': %w", err)
 		}
@@ -532,7 +723,7 @@ func (a *AdditionalPropertiesObject1) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["optional"]; found {
-		err = json.Unmarshal(raw, &a.Optional)
+		err = json.Unmarshal(raw, &apo.Optional)
 		if err != nil {
 			return fmt.Errorf("error reading 'optional': %w", err)
 		}
@@ -540,69 +731,66 @@ func (a *AdditionalPropertiesObject1) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]int)
+		apo.AdditionalProperties = make(map[string]int)
 		for fieldName, fieldBuf := range object {
 			var fieldVal int
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshalling field %s: %w", fieldName, err)
 			}
-			a.AdditionalProperties[fieldName] = fieldVal
+			apo.AdditionalProperties[fieldName] = fieldVal
 		}
 	}
 	return nil
 }
 
-// Override default JSON handling for AdditionalPropertiesObject1 to handle AdditionalProperties
-func (a AdditionalPropertiesObject1) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
+// Override default JSON handling for AdditionalPropertiesObject1 to handle AdditionalProperties,
+// writing the properties in order, followed by the additional properties
+// sorted by name, so that the output is always the same
+func (apo AdditionalPropertiesObject1) MarshalJSON() ([]byte, error) {
+	var object runtime.JSONObject
 
-	object["id"], err = json.Marshal(a.Id)
-	if err != nil {
+	if err := object.Set("id", apo.Id); err != nil {
 		return nil, fmt.Errorf("error marshaling 'id': %w", err)
 	}
 
-	object["name"], err = json.Marshal(a.Name)
-	if err != nil {
+	if err := object.Set("name", apo.Name); err != nil {
 		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	if a.Optional != nil {
-		object["optional"], err = json.Marshal(a.Optional)
-		if err != nil {
+	if apo.Optional != nil {
+		if err := object.Set("optional", apo.Optional); err != nil {
 			return nil, fmt.Errorf("error marshaling 'optional': %w", err)
 		}
 	}
 
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
+	for _, fieldName := range runtime.SortedKeys(apo.AdditionalProperties) {
+		if err := object.Set(fieldName, apo.AdditionalProperties[fieldName]); err != nil {
 			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
 		}
 	}
-	return json.Marshal(object)
+	return object.MarshalJSON()
 }
 
 // Getter for additional properties for AdditionalPropertiesObject3. Returns the specified
 // element and whether it was found
-func (a AdditionalPropertiesObject3) Get(fieldName string) (value interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
+func (apo AdditionalPropertiesObject3) Get(fieldName string) (value interface{}, found bool) {
+	if apo.AdditionalProperties != nil {
+		value, found = apo.AdditionalProperties[fieldName]
 	}
 	return
 }
 
 // Setter for additional properties for AdditionalPropertiesObject3
-func (a *AdditionalPropertiesObject3) Set(fieldName string, value interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]interface{})
+func (apo *AdditionalPropertiesObject3) Set(fieldName string, value interface{}) {
+	if apo.AdditionalProperties == nil {
+		apo.AdditionalProperties = make(map[string]interface{})
 	}
-	a.AdditionalProperties[fieldName] = value
+	apo.AdditionalProperties[fieldName] = value
 }
 
 // Override default JSON handling for AdditionalPropertiesObject3 to handle AdditionalProperties
-func (a *AdditionalPropertiesObject3) UnmarshalJSON(b []byte) error {
+func (apo *AdditionalPropertiesObject3) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -610,7 +798,7 @@ func (a *AdditionalPropertiesObject3) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+		err = json.Unmarshal(raw, &apo.Name)
 		if err != nil {
 			return fmt.Errorf("error reading 'name': %w", err)
 		}
@@ -618,57 +806,56 @@ func (a *AdditionalPropertiesObject3) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]interface{})
+		apo.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
 			var fieldVal interface{}
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshalling field %s: %w", fieldName, err)
 			}
-			a.AdditionalProperties[fieldName] = fieldVal
+			apo.AdditionalProperties[fieldName] = fieldVal
 		}
 	}
 	return nil
 }
 
-// Override default JSON handling for AdditionalPropertiesObject3 to handle AdditionalProperties
-func (a AdditionalPropertiesObject3) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
+// Override default JSON handling for AdditionalPropertiesObject3 to handle AdditionalProperties,
+// writing the properties in order, followed by the additional properties
+// sorted by name, so that the output is always the same
+func (apo AdditionalPropertiesObject3) MarshalJSON() ([]byte, error) {
+	var object runtime.JSONObject
 
-	object["name"], err = json.Marshal(a.Name)
-	if err != nil {
+	if err := object.Set("name", apo.Name); err != nil {
 		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
+	for _, fieldName := range runtime.SortedKeys(apo.AdditionalProperties) {
+		if err := object.Set(fieldName, apo.AdditionalProperties[fieldName]); err != nil {
 			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
 		}
 	}
-	return json.Marshal(object)
+	return object.MarshalJSON()
 }
 
 // Getter for additional properties for AdditionalPropertiesObject4. Returns the specified
 // element and whether it was found
-func (a AdditionalPropertiesObject4) Get(fieldName string) (value interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
+func (apo AdditionalPropertiesObject4) Get(fieldName string) (value interface{}, found bool) {
+	if apo.AdditionalProperties != nil {
+		value, found = apo.AdditionalProperties[fieldName]
 	}
 	return
 }
 
 // Setter for additional properties for AdditionalPropertiesObject4
-func (a *AdditionalPropertiesObject4) Set(fieldName string, value interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]interface{})
+func (apo *AdditionalPropertiesObject4) Set(fieldName string, value interface{}) {
+	if apo.AdditionalProperties == nil {
+		apo.AdditionalProperties = make(map[string]interface{})
 	}
-	a.AdditionalProperties[fieldName] = value
+	apo.AdditionalProperties[fieldName] = value
 }
 
 // Override default JSON handling for AdditionalPropertiesObject4 to handle AdditionalProperties
-func (a *AdditionalPropertiesObject4) UnmarshalJSON(b []byte) error {
+func (apo *AdditionalPropertiesObject4) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -676,7 +863,7 @@ func (a *AdditionalPropertiesObject4) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["inner"]; found {
-		err = json.Unmarshal(raw, &a.Inner)
+		err = json.Unmarshal(raw, &apo.Inner)
 		if err != nil {
 			return fmt.Errorf("error reading 'inner': %w", err)
 		}
@@ -684,7 +871,7 @@ func (a *AdditionalPropertiesObject4) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+		err = json.Unmarshal(raw, &apo.Name)
 		if err != nil {
 			return fmt.Errorf("error reading 'name': %w", err)
 		}
@@ -692,62 +879,60 @@ func (a *AdditionalPropertiesObject4) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]interface{})
+		apo.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
 			var fieldVal interface{}
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshalling field %s: %w", fieldName, err)
 			}
-			a.AdditionalProperties[fieldName] = fieldVal
+			apo.AdditionalProperties[fieldName] = fieldVal
 		}
 	}
 	return nil
 }
 
-// Override default JSON handling for AdditionalPropertiesObject4 to handle AdditionalProperties
-func (a AdditionalPropertiesObject4) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
+// Override default JSON handling for AdditionalPropertiesObject4 to handle AdditionalProperties,
+// writing the properties in order, followed by the additional properties
+// sorted by name, so that the output is always the same
+func (apo AdditionalPropertiesObject4) MarshalJSON() ([]byte, error) {
+	var object runtime.JSONObject
 
-	object["inner"], err = json.Marshal(a.Inner)
-	if err != nil {
+	if err := object.Set("inner", apo.Inner); err != nil {
 		return nil, fmt.Errorf("error marshaling 'inner': %w", err)
 	}
 
-	object["name"], err = json.Marshal(a.Name)
-	if err != nil {
+	if err := object.Set("name", apo.Name); err != nil {
 		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
+	for _, fieldName := range runtime.SortedKeys(apo.AdditionalProperties) {
+		if err := object.Set(fieldName, apo.AdditionalProperties[fieldName]); err != nil {
 			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
 		}
 	}
-	return json.Marshal(object)
+	return object.MarshalJSON()
 }
 
 // Getter for additional properties for AdditionalPropertiesObject4_Inner. Returns the specified
 // element and whether it was found
-func (a AdditionalPropertiesObject4_Inner) Get(fieldName string) (value interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
+func (apoi AdditionalPropertiesObject4_Inner) Get(fieldName string) (value interface{}, found bool) {
+	if apoi.AdditionalProperties != nil {
+		value, found = apoi.AdditionalProperties[fieldName]
 	}
 	return
 }
 
 // Setter for additional properties for AdditionalPropertiesObject4_Inner
-func (a *AdditionalPropertiesObject4_Inner) Set(fieldName string, value interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]interface{})
+func (apoi *AdditionalPropertiesObject4_Inner) Set(fieldName string, value interface{}) {
+	if apoi.AdditionalProperties == nil {
+		apoi.AdditionalProperties = make(map[string]interface{})
 	}
-	a.AdditionalProperties[fieldName] = value
+	apoi.AdditionalProperties[fieldName] = value
 }
 
 // Override default JSON handling for AdditionalPropertiesObject4_Inner to handle AdditionalProperties
-func (a *AdditionalPropertiesObject4_Inner) UnmarshalJSON(b []byte) error {
+func (apoi *AdditionalPropertiesObject4_Inner) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -755,7 +940,7 @@ func (a *AdditionalPropertiesObject4_Inner) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+		err = json.Unmarshal(raw, &apoi.Name)
 		if err != nil {
 			return fmt.Errorf("error reading 'name': %w", err)
 		}
@@ -763,286 +948,315 @@ func (a *AdditionalPropertiesObject4_Inner) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]interface{})
+		apoi.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
 			var fieldVal interface{}
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshalling field %s: %w", fieldName, err)
 			}
-			a.AdditionalProperties[fieldName] = fieldVal
+			apoi.AdditionalProperties[fieldName] = fieldVal
 		}
 	}
 	return nil
 }
 
-// Override default JSON handling for AdditionalPropertiesObject4_Inner to handle AdditionalProperties
-func (a AdditionalPropertiesObject4_Inner) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
+// Override default JSON handling for AdditionalPropertiesObject4_Inner to handle AdditionalProperties,
+// writing the properties in order, followed by the additional properties
+// sorted by name, so that the output is always the same
+func (apoi AdditionalPropertiesObject4_Inner) MarshalJSON() ([]byte, error) {
+	var object runtime.JSONObject
 
-	object["name"], err = json.Marshal(a.Name)
-	if err != nil {
+	if err := object.Set("name", apoi.Name); err != nil {
 		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
+	for _, fieldName := range runtime.SortedKeys(apoi.AdditionalProperties) {
+		if err := object.Set(fieldName, apoi.AdditionalProperties[fieldName]); err != nil {
 			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
 		}
 	}
-	return json.Marshal(object)
+	return object.MarshalJSON()
 }
 
 // Getter for additional properties for OneOfObject13. Returns the specified
 // element and whether it was found
-func (a OneOfObject13) Get(fieldName string) (value interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
+func (ooo OneOfObject13) Get(fieldName string) (value interface{}, found bool) {
+	if ooo.AdditionalProperties != nil {
+		value, found = ooo.AdditionalProperties[fieldName]
 	}
 	return
 }
 
 // Setter for additional properties for OneOfObject13
-func (a *OneOfObject13) Set(fieldName string, value interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]interface{})
+func (ooo *OneOfObject13) Set(fieldName string, value interface{}) {
+	if ooo.AdditionalProperties == nil {
+		ooo.AdditionalProperties = make(map[string]interface{})
+	}
+	ooo.AdditionalProperties[fieldName] = value
+}
+
+// Getter for additional properties for AdditionalPropertiesObject5. Returns the specified
+// element and whether it was found
+func (apo AdditionalPropertiesObject5) Get(fieldName string) (value SchemaObject, found bool) {
+	value, found = apo[fieldName]
+	return
+}
+
+// Setter for additional properties for AdditionalPropertiesObject5
+func (apo *AdditionalPropertiesObject5) Set(fieldName string, value SchemaObject) {
+	if *apo == nil {
+		*apo = make(AdditionalPropertiesObject5)
+	}
+	(*apo)[fieldName] = value
+}
+
+// Getter for additional properties for OneOfObject11. Returns the specified
+// element and whether it was found
+func (ooo OneOfObject11) Get(fieldName string) (value OneOfObject11_AdditionalProperties, found bool) {
+	value, found = ooo[fieldName]
+	return
+}
+
+// Setter for additional properties for OneOfObject11
+func (ooo *OneOfObject11) Set(fieldName string, value OneOfObject11_AdditionalProperties) {
+	if *ooo == nil {
+		*ooo = make(OneOfObject11)
 	}
-	a.AdditionalProperties[fieldName] = value
+	(*ooo)[fieldName] = value
 }
 
 // AsOneOfVariant4 returns the union data inside the AnyOfObject1 as a OneOfVariant4
-func (t AnyOfObject1) AsOneOfVariant4() (OneOfVariant4, error) {
+func (aoo AnyOfObject1) AsOneOfVariant4() (OneOfVariant4, error) {
 	var body OneOfVariant4
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(aoo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant4 overwrites any union data inside the AnyOfObject1 as the provided OneOfVariant4
-func (t *AnyOfObject1) FromOneOfVariant4(v OneOfVariant4) error {
+func (aoo *AnyOfObject1) FromOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	aoo.union = b
 	return err
 }
 
 // MergeOneOfVariant4 performs a merge with any union data inside the AnyOfObject1, using the provided OneOfVariant4
-func (t *AnyOfObject1) MergeOneOfVariant4(v OneOfVariant4) error {
+func (aoo *AnyOfObject1) MergeOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(aoo.union, b)
+	aoo.union = merged
 	return err
 }
 
 // AsOneOfVariant5 returns the union data inside the AnyOfObject1 as a OneOfVariant5
-func (t AnyOfObject1) AsOneOfVariant5() (OneOfVariant5, error) {
+func (aoo AnyOfObject1) AsOneOfVariant5() (OneOfVariant5, error) {
 	var body OneOfVariant5
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(aoo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant5 overwrites any union data inside the AnyOfObject1 as the provided OneOfVariant5
-func (t *AnyOfObject1) FromOneOfVariant5(v OneOfVariant5) error {
+func (aoo *AnyOfObject1) FromOneOfVariant5(v OneOfVariant5) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	aoo.union = b
 	return err
 }
 
 // MergeOneOfVariant5 performs a merge with any union data inside the AnyOfObject1, using the provided OneOfVariant5
-func (t *AnyOfObject1) MergeOneOfVariant5(v OneOfVariant5) error {
+func (aoo *AnyOfObject1) MergeOneOfVariant5(v OneOfVariant5) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(aoo.union, b)
+	aoo.union = merged
 	return err
 }
 
-func (t AnyOfObject1) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (aoo AnyOfObject1) MarshalJSON() ([]byte, error) {
+	b, err := aoo.union.MarshalJSON()
 	return b, err
 }
 
-func (t *AnyOfObject1) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (aoo *AnyOfObject1) UnmarshalJSON(b []byte) error {
+	err := aoo.union.UnmarshalJSON(b)
 	return err
 }
 
 // AsOneOfVariant1 returns the union data inside the OneOfObject1 as a OneOfVariant1
-func (t OneOfObject1) AsOneOfVariant1() (OneOfVariant1, error) {
+func (ooo OneOfObject1) AsOneOfVariant1() (OneOfVariant1, error) {
 	var body OneOfVariant1
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant1 overwrites any union data inside the OneOfObject1 as the provided OneOfVariant1
-func (t *OneOfObject1) FromOneOfVariant1(v OneOfVariant1) error {
+func (ooo *OneOfObject1) FromOneOfVariant1(v OneOfVariant1) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject1, using the provided OneOfVariant1
-func (t *OneOfObject1) MergeOneOfVariant1(v OneOfVariant1) error {
+func (ooo *OneOfObject1) MergeOneOfVariant1(v OneOfVariant1) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant2 returns the union data inside the OneOfObject1 as a OneOfVariant2
-func (t OneOfObject1) AsOneOfVariant2() (OneOfVariant2, error) {
+func (ooo OneOfObject1) AsOneOfVariant2() (OneOfVariant2, error) {
 	var body OneOfVariant2
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant2 overwrites any union data inside the OneOfObject1 as the provided OneOfVariant2
-func (t *OneOfObject1) FromOneOfVariant2(v OneOfVariant2) error {
+func (ooo *OneOfObject1) FromOneOfVariant2(v OneOfVariant2) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant2 performs a merge with any union data inside the OneOfObject1, using the provided OneOfVariant2
-func (t *OneOfObject1) MergeOneOfVariant2(v OneOfVariant2) error {
+func (ooo *OneOfObject1) MergeOneOfVariant2(v OneOfVariant2) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant3 returns the union data inside the OneOfObject1 as a OneOfVariant3
-func (t OneOfObject1) AsOneOfVariant3() (OneOfVariant3, error) {
+func (ooo OneOfObject1) AsOneOfVariant3() (OneOfVariant3, error) {
 	var body OneOfVariant3
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant3 overwrites any union data inside the OneOfObject1 as the provided OneOfVariant3
-func (t *OneOfObject1) FromOneOfVariant3(v OneOfVariant3) error {
+func (ooo *OneOfObject1) FromOneOfVariant3(v OneOfVariant3) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant3 performs a merge with any union data inside the OneOfObject1, using the provided OneOfVariant3
-func (t *OneOfObject1) MergeOneOfVariant3(v OneOfVariant3) error {
+func (ooo *OneOfObject1) MergeOneOfVariant3(v OneOfVariant3) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject1) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooo OneOfObject1) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
 	return b, err
 }
 
-func (t *OneOfObject1) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooo *OneOfObject1) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	return err
 }
 
 // AsOneOfObject100 returns the union data inside the OneOfObject10 as a OneOfObject100
-func (t OneOfObject10) AsOneOfObject100() (OneOfObject100, error) {
+func (ooo OneOfObject10) AsOneOfObject100() (OneOfObject100, error) {
 	var body OneOfObject100
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfObject100 overwrites any union data inside the OneOfObject10 as the provided OneOfObject100
-func (t *OneOfObject10) FromOneOfObject100(v OneOfObject100) error {
+func (ooo *OneOfObject10) FromOneOfObject100(v OneOfObject100) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfObject100 performs a merge with any union data inside the OneOfObject10, using the provided OneOfObject100
-func (t *OneOfObject10) MergeOneOfObject100(v OneOfObject100) error {
+func (ooo *OneOfObject10) MergeOneOfObject100(v OneOfObject100) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfObject101 returns the union data inside the OneOfObject10 as a OneOfObject101
-func (t OneOfObject10) AsOneOfObject101() (OneOfObject101, error) {
+func (ooo OneOfObject10) AsOneOfObject101() (OneOfObject101, error) {
 	var body OneOfObject101
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfObject101 overwrites any union data inside the OneOfObject10 as the provided OneOfObject101
-func (t *OneOfObject10) FromOneOfObject101(v OneOfObject101) error {
+func (ooo *OneOfObject10) FromOneOfObject101(v OneOfObject101) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfObject101 performs a merge with any union data inside the OneOfObject10, using the provided OneOfObject101
-func (t *OneOfObject10) MergeOneOfObject101(v OneOfObject101) error {
+func (ooo *OneOfObject10) MergeOneOfObject101(v OneOfObject101) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject10) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooo OneOfObject10) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
 	if err != nil {
 		return nil, err
 	}
 	object := make(map[string]json.RawMessage)
-	if t.union != nil {
+	if ooo.union != nil {
 		err = json.Unmarshal(b, &object)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if t.One != nil {
-		object["one"], err = json.Marshal(t.One)
+	if ooo.One != nil {
+		object["one"], err = json.Marshal(ooo.One)
 		if err != nil {
 			return nil, fmt.Errorf("error marshaling 'one': %w", err)
 		}
 	}
 
-	if t.Three != nil {
-		object["three"], err = json.Marshal(t.Three)
+	if ooo.Three != nil {
+		object["three"], err = json.Marshal(ooo.Three)
 		if err != nil {
 			return nil, fmt.Errorf("error marshaling 'three': %w", err)
 		}
 	}
 
-	if t.Two != nil {
-		object["two"], err = json.Marshal(t.Two)
+	if ooo.Two != nil {
+		object["two"], err = json.Marshal(ooo.Two)
 		if err != nil {
 			return nil, fmt.Errorf("error marshaling 'two': %w", err)
 		}
@@ -1051,8 +1265,8 @@ func (t OneOfObject10) MarshalJSON() ([]byte, error) {
 	return b, err
 }
 
-func (t *OneOfObject10) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooo *OneOfObject10) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	if err != nil {
 		return err
 	}
@@ -1063,21 +1277,21 @@ func (t *OneOfObject10) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["one"]; found {
-		err = json.Unmarshal(raw, &t.One)
+		err = json.Unmarshal(raw, &ooo.One)
 		if err != nil {
 			return fmt.Errorf("error reading 'one': %w", err)
 		}
 	}
 
 	if raw, found := object["three"]; found {
-		err = json.Unmarshal(raw, &t.Three)
+		err = json.Unmarshal(raw, &ooo.Three)
 		if err != nil {
 			return fmt.Errorf("error reading 'three': %w", err)
 		}
 	}
 
 	if raw, found := object["two"]; found {
-		err = json.Unmarshal(raw, &t.Two)
+		err = json.Unmarshal(raw, &ooo.Two)
 		if err != nil {
 			return fmt.Errorf("error reading 'two': %w", err)
 		}
@@ -1087,559 +1301,656 @@ func (t *OneOfObject10) UnmarshalJSON(b []byte) error {
 }
 
 // AsOneOfObject110 returns the union data inside the OneOfObject11_AdditionalProperties as a OneOfObject110
-func (t OneOfObject11_AdditionalProperties) AsOneOfObject110() (OneOfObject110, error) {
+func (oooap OneOfObject11_AdditionalProperties) AsOneOfObject110() (OneOfObject110, error) {
 	var body OneOfObject110
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(oooap.union, &body)
 	return body, err
 }
 
 // FromOneOfObject110 overwrites any union data inside the OneOfObject11_AdditionalProperties as the provided OneOfObject110
-func (t *OneOfObject11_AdditionalProperties) FromOneOfObject110(v OneOfObject110) error {
+func (oooap *OneOfObject11_AdditionalProperties) FromOneOfObject110(v OneOfObject110) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	oooap.union = b
 	return err
 }
 
 // MergeOneOfObject110 performs a merge with any union data inside the OneOfObject11_AdditionalProperties, using the provided OneOfObject110
-func (t *OneOfObject11_AdditionalProperties) MergeOneOfObject110(v OneOfObject110) error {
+func (oooap *OneOfObject11_AdditionalProperties) MergeOneOfObject110(v OneOfObject110) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(oooap.union, b)
+	oooap.union = merged
 	return err
 }
 
 // AsOneOfObject111 returns the union data inside the OneOfObject11_AdditionalProperties as a OneOfObject111
-func (t OneOfObject11_AdditionalProperties) AsOneOfObject111() (OneOfObject111, error) {
+func (oooap OneOfObject11_AdditionalProperties) AsOneOfObject111() (OneOfObject111, error) {
 	var body OneOfObject111
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(oooap.union, &body)
 	return body, err
 }
 
 // FromOneOfObject111 overwrites any union data inside the OneOfObject11_AdditionalProperties as the provided OneOfObject111
-func (t *OneOfObject11_AdditionalProperties) FromOneOfObject111(v OneOfObject111) error {
+func (oooap *OneOfObject11_AdditionalProperties) FromOneOfObject111(v OneOfObject111) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	oooap.union = b
 	return err
 }
 
 // MergeOneOfObject111 performs a merge with any union data inside the OneOfObject11_AdditionalProperties, using the provided OneOfObject111
-func (t *OneOfObject11_AdditionalProperties) MergeOneOfObject111(v OneOfObject111) error {
+func (oooap *OneOfObject11_AdditionalProperties) MergeOneOfObject111(v OneOfObject111) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(oooap.union, b)
+	oooap.union = merged
 	return err
 }
 
 // AsOneOfObject112 returns the union data inside the OneOfObject11_AdditionalProperties as a OneOfObject112
-func (t OneOfObject11_AdditionalProperties) AsOneOfObject112() (OneOfObject112, error) {
+func (oooap OneOfObject11_AdditionalProperties) AsOneOfObject112() (OneOfObject112, error) {
 	var body OneOfObject112
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(oooap.union, &body)
 	return body, err
 }
 
 // FromOneOfObject112 overwrites any union data inside the OneOfObject11_AdditionalProperties as the provided OneOfObject112
-func (t *OneOfObject11_AdditionalProperties) FromOneOfObject112(v OneOfObject112) error {
+func (oooap *OneOfObject11_AdditionalProperties) FromOneOfObject112(v OneOfObject112) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	oooap.union = b
 	return err
 }
 
 // MergeOneOfObject112 performs a merge with any union data inside the OneOfObject11_AdditionalProperties, using the provided OneOfObject112
-func (t *OneOfObject11_AdditionalProperties) MergeOneOfObject112(v OneOfObject112) error {
+func (oooap *OneOfObject11_AdditionalProperties) MergeOneOfObject112(v OneOfObject112) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(oooap.union, b)
+	oooap.union = merged
 	return err
 }
 
-func (t OneOfObject11_AdditionalProperties) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (oooap OneOfObject11_AdditionalProperties) MarshalJSON() ([]byte, error) {
+	b, err := oooap.union.MarshalJSON()
 	return b, err
 }
 
-func (t *OneOfObject11_AdditionalProperties) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (oooap *OneOfObject11_AdditionalProperties) UnmarshalJSON(b []byte) error {
+	err := oooap.union.UnmarshalJSON(b)
 	return err
 }
 
 // AsOneOfObject120 returns the union data inside the OneOfObject12 as a OneOfObject120
-func (t OneOfObject12) AsOneOfObject120() (OneOfObject120, error) {
+func (ooo OneOfObject12) AsOneOfObject120() (OneOfObject120, error) {
 	var body OneOfObject120
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfObject120 overwrites any union data inside the OneOfObject12 as the provided OneOfObject120
-func (t *OneOfObject12) FromOneOfObject120(v OneOfObject120) error {
+func (ooo *OneOfObject12) FromOneOfObject120(v OneOfObject120) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfObject120 performs a merge with any union data inside the OneOfObject12, using the provided OneOfObject120
-func (t *OneOfObject12) MergeOneOfObject120(v OneOfObject120) error {
+func (ooo *OneOfObject12) MergeOneOfObject120(v OneOfObject120) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfObject121 returns the union data inside the OneOfObject12 as a OneOfObject121
-func (t OneOfObject12) AsOneOfObject121() (OneOfObject121, error) {
+func (ooo OneOfObject12) AsOneOfObject121() (OneOfObject121, error) {
 	var body OneOfObject121
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfObject121 overwrites any union data inside the OneOfObject12 as the provided OneOfObject121
-func (t *OneOfObject12) FromOneOfObject121(v OneOfObject121) error {
+func (ooo *OneOfObject12) FromOneOfObject121(v OneOfObject121) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfObject121 performs a merge with any union data inside the OneOfObject12, using the provided OneOfObject121
-func (t *OneOfObject12) MergeOneOfObject121(v OneOfObject121) error {
+func (ooo *OneOfObject12) MergeOneOfObject121(v OneOfObject121) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant3 returns the union data inside the OneOfObject12 as a OneOfVariant3
-func (t OneOfObject12) AsOneOfVariant3() (OneOfVariant3, error) {
+func (ooo OneOfObject12) AsOneOfVariant3() (OneOfVariant3, error) {
 	var body OneOfVariant3
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant3 overwrites any union data inside the OneOfObject12 as the provided OneOfVariant3
-func (t *OneOfObject12) FromOneOfVariant3(v OneOfVariant3) error {
+func (ooo *OneOfObject12) FromOneOfVariant3(v OneOfVariant3) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant3 performs a merge with any union data inside the OneOfObject12, using the provided OneOfVariant3
-func (t *OneOfObject12) MergeOneOfVariant3(v OneOfVariant3) error {
+func (ooo *OneOfObject12) MergeOneOfVariant3(v OneOfVariant3) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant4 returns the union data inside the OneOfObject12 as a OneOfVariant4
-func (t OneOfObject12) AsOneOfVariant4() (OneOfVariant4, error) {
+func (ooo OneOfObject12) AsOneOfVariant4() (OneOfVariant4, error) {
 	var body OneOfVariant4
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant4 overwrites any union data inside the OneOfObject12 as the provided OneOfVariant4
-func (t *OneOfObject12) FromOneOfVariant4(v OneOfVariant4) error {
+func (ooo *OneOfObject12) FromOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject12, using the provided OneOfVariant4
-func (t *OneOfObject12) MergeOneOfVariant4(v OneOfVariant4) error {
+func (ooo *OneOfObject12) MergeOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject12) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooo OneOfObject12) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
 	return b, err
 }
 
-func (t *OneOfObject12) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooo *OneOfObject12) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	return err
 }
 
 // AsOneOfVariant1 returns the union data inside the OneOfObject13 as a OneOfVariant1
-func (t OneOfObject13) AsOneOfVariant1() (OneOfVariant1, error) {
+func (ooo OneOfObject13) AsOneOfVariant1() (OneOfVariant1, error) {
 	var body OneOfVariant1
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant1 overwrites any union data inside the OneOfObject13 as the provided OneOfVariant1
-func (t *OneOfObject13) FromOneOfVariant1(v OneOfVariant1) error {
-	t.Type = "v1"
-
+func (ooo *OneOfObject13) FromOneOfVariant1(v OneOfVariant1) error {
+	ooo.Type = "v1"
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject13, using the provided OneOfVariant1
-func (t *OneOfObject13) MergeOneOfVariant1(v OneOfVariant1) error {
-	t.Type = "v1"
-
+func (ooo *OneOfObject13) MergeOneOfVariant1(v OneOfVariant1) error {
+	ooo.Type = "v1"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant6 returns the union data inside the OneOfObject13 as a OneOfVariant6
-func (t OneOfObject13) AsOneOfVariant6() (OneOfVariant6, error) {
+func (ooo OneOfObject13) AsOneOfVariant6() (OneOfVariant6, error) {
 	var body OneOfVariant6
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant6 overwrites any union data inside the OneOfObject13 as the provided OneOfVariant6
-func (t *OneOfObject13) FromOneOfVariant6(v OneOfVariant6) error {
-	t.Type = "v6"
-
+func (ooo *OneOfObject13) FromOneOfVariant6(v OneOfVariant6) error {
+	ooo.Type = "v6"
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant6 performs a merge with any union data inside the OneOfObject13, using the provided OneOfVariant6
-func (t *OneOfObject13) MergeOneOfVariant6(v OneOfVariant6) error {
-	t.Type = "v6"
-
+func (ooo *OneOfObject13) MergeOneOfVariant6(v OneOfVariant6) error {
+	ooo.Type = "v6"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject13) Discriminator() (string, error) {
+func (ooo OneOfObject13) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"type"`
 	}
-	err := json.Unmarshal(t.union, &discriminator)
+	err := json.Unmarshal(ooo.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (ooo OneOfObject13) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := ooo.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "v1":
+		return ooo.AsOneOfVariant1()
+	case "v6":
+		return ooo.AsOneOfVariant6()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// AsOneOfVariant1 returns the union data inside the OneOfObject14 as a OneOfVariant1
+func (ooo OneOfObject14) AsOneOfVariant1() (OneOfVariant1, error) {
+	var body OneOfVariant1
+	err := json.Unmarshal(ooo.union, &body)
+	return body, err
+}
+
+// FromOneOfVariant1 overwrites any union data inside the OneOfObject14 as the provided OneOfVariant1
+func (ooo *OneOfObject14) FromOneOfVariant1(v OneOfVariant1) error {
+	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant1 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"kind\":\"v1\"}"))
+	}
+	ooo.union = b
+	return err
+}
+
+// MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject14, using the provided OneOfVariant1
+func (ooo *OneOfObject14) MergeOneOfVariant1(v OneOfVariant1) error {
+	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant1 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"kind\":\"v1\"}"))
+	}
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
+	return err
+}
+
+// AsOneOfVariant6 returns the union data inside the OneOfObject14 as a OneOfVariant6
+func (ooo OneOfObject14) AsOneOfVariant6() (OneOfVariant6, error) {
+	var body OneOfVariant6
+	err := json.Unmarshal(ooo.union, &body)
+	return body, err
+}
+
+// FromOneOfVariant6 overwrites any union data inside the OneOfObject14 as the provided OneOfVariant6
+func (ooo *OneOfObject14) FromOneOfVariant6(v OneOfVariant6) error {
+	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant6 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"kind\":\"v6\"}"))
+	}
+	ooo.union = b
+	return err
+}
+
+// MergeOneOfVariant6 performs a merge with any union data inside the OneOfObject14, using the provided OneOfVariant6
+func (ooo *OneOfObject14) MergeOneOfVariant6(v OneOfVariant6) error {
+	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant6 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"kind\":\"v6\"}"))
+	}
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
+	return err
+}
+
+func (ooo OneOfObject14) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"kind"`
+	}
+	err := json.Unmarshal(ooo.union, &discriminator)
 	return discriminator.Discriminator, err
 }
 
-func (t OneOfObject13) ValueByDiscriminator() (interface{}, error) {
-	discriminator, err := t.Discriminator()
+func (ooo OneOfObject14) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := ooo.Discriminator()
 	if err != nil {
 		return nil, err
 	}
 	switch discriminator {
 	case "v1":
-		return t.AsOneOfVariant1()
+		return ooo.AsOneOfVariant1()
 	case "v6":
-		return t.AsOneOfVariant6()
+		return ooo.AsOneOfVariant6()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
 }
 
+func (ooo OneOfObject14) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
+	return b, err
+}
+
+func (ooo *OneOfObject14) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsOneOfObject20 returns the union data inside the OneOfObject2 as a OneOfObject20
-func (t OneOfObject2) AsOneOfObject20() (OneOfObject20, error) {
+func (ooo OneOfObject2) AsOneOfObject20() (OneOfObject20, error) {
 	var body OneOfObject20
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfObject20 overwrites any union data inside the OneOfObject2 as the provided OneOfObject20
-func (t *OneOfObject2) FromOneOfObject20(v OneOfObject20) error {
+func (ooo *OneOfObject2) FromOneOfObject20(v OneOfObject20) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfObject20 performs a merge with any union data inside the OneOfObject2, using the provided OneOfObject20
-func (t *OneOfObject2) MergeOneOfObject20(v OneOfObject20) error {
+func (ooo *OneOfObject2) MergeOneOfObject20(v OneOfObject20) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfObject21 returns the union data inside the OneOfObject2 as a OneOfObject21
-func (t OneOfObject2) AsOneOfObject21() (OneOfObject21, error) {
+func (ooo OneOfObject2) AsOneOfObject21() (OneOfObject21, error) {
 	var body OneOfObject21
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfObject21 overwrites any union data inside the OneOfObject2 as the provided OneOfObject21
-func (t *OneOfObject2) FromOneOfObject21(v OneOfObject21) error {
+func (ooo *OneOfObject2) FromOneOfObject21(v OneOfObject21) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfObject21 performs a merge with any union data inside the OneOfObject2, using the provided OneOfObject21
-func (t *OneOfObject2) MergeOneOfObject21(v OneOfObject21) error {
+func (ooo *OneOfObject2) MergeOneOfObject21(v OneOfObject21) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfObject22 returns the union data inside the OneOfObject2 as a OneOfObject22
-func (t OneOfObject2) AsOneOfObject22() (OneOfObject22, error) {
+func (ooo OneOfObject2) AsOneOfObject22() (OneOfObject22, error) {
 	var body OneOfObject22
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfObject22 overwrites any union data inside the OneOfObject2 as the provided OneOfObject22
-func (t *OneOfObject2) FromOneOfObject22(v OneOfObject22) error {
+func (ooo *OneOfObject2) FromOneOfObject22(v OneOfObject22) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfObject22 performs a merge with any union data inside the OneOfObject2, using the provided OneOfObject22
-func (t *OneOfObject2) MergeOneOfObject22(v OneOfObject22) error {
+func (ooo *OneOfObject2) MergeOneOfObject22(v OneOfObject22) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject2) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooo OneOfObject2) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
 	return b, err
 }
 
-func (t *OneOfObject2) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooo *OneOfObject2) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	return err
 }
 
 // AsOneOfVariant1 returns the union data inside the OneOfObject3_Union as a OneOfVariant1
-func (t OneOfObject3_Union) AsOneOfVariant1() (OneOfVariant1, error) {
+func (ooou OneOfObject3_Union) AsOneOfVariant1() (OneOfVariant1, error) {
 	var body OneOfVariant1
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooou.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant1 overwrites any union data inside the OneOfObject3_Union as the provided OneOfVariant1
-func (t *OneOfObject3_Union) FromOneOfVariant1(v OneOfVariant1) error {
+func (ooou *OneOfObject3_Union) FromOneOfVariant1(v OneOfVariant1) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooou.union = b
 	return err
 }
 
 // MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject3_Union, using the provided OneOfVariant1
-func (t *OneOfObject3_Union) MergeOneOfVariant1(v OneOfVariant1) error {
+func (ooou *OneOfObject3_Union) MergeOneOfVariant1(v OneOfVariant1) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooou.union, b)
+	ooou.union = merged
 	return err
 }
 
 // AsOneOfVariant2 returns the union data inside the OneOfObject3_Union as a OneOfVariant2
-func (t OneOfObject3_Union) AsOneOfVariant2() (OneOfVariant2, error) {
+func (ooou OneOfObject3_Union) AsOneOfVariant2() (OneOfVariant2, error) {
 	var body OneOfVariant2
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooou.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant2 overwrites any union data inside the OneOfObject3_Union as the provided OneOfVariant2
-func (t *OneOfObject3_Union) FromOneOfVariant2(v OneOfVariant2) error {
+func (ooou *OneOfObject3_Union) FromOneOfVariant2(v OneOfVariant2) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooou.union = b
 	return err
 }
 
 // MergeOneOfVariant2 performs a merge with any union data inside the OneOfObject3_Union, using the provided OneOfVariant2
-func (t *OneOfObject3_Union) MergeOneOfVariant2(v OneOfVariant2) error {
+func (ooou *OneOfObject3_Union) MergeOneOfVariant2(v OneOfVariant2) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooou.union, b)
+	ooou.union = merged
 	return err
 }
 
 // AsOneOfVariant3 returns the union data inside the OneOfObject3_Union as a OneOfVariant3
-func (t OneOfObject3_Union) AsOneOfVariant3() (OneOfVariant3, error) {
+func (ooou OneOfObject3_Union) AsOneOfVariant3() (OneOfVariant3, error) {
 	var body OneOfVariant3
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooou.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant3 overwrites any union data inside the OneOfObject3_Union as the provided OneOfVariant3
-func (t *OneOfObject3_Union) FromOneOfVariant3(v OneOfVariant3) error {
+func (ooou *OneOfObject3_Union) FromOneOfVariant3(v OneOfVariant3) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooou.union = b
 	return err
 }
 
 // MergeOneOfVariant3 performs a merge with any union data inside the OneOfObject3_Union, using the provided OneOfVariant3
-func (t *OneOfObject3_Union) MergeOneOfVariant3(v OneOfVariant3) error {
+func (ooou *OneOfObject3_Union) MergeOneOfVariant3(v OneOfVariant3) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooou.union, b)
+	ooou.union = merged
 	return err
 }
 
-func (t OneOfObject3_Union) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooou OneOfObject3_Union) MarshalJSON() ([]byte, error) {
+	b, err := ooou.union.MarshalJSON()
 	return b, err
 }
 
-func (t *OneOfObject3_Union) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooou *OneOfObject3_Union) UnmarshalJSON(b []byte) error {
+	err := ooou.union.UnmarshalJSON(b)
 	return err
 }
 
 // AsOneOfVariant1 returns the union data inside the OneOfObject4 as a OneOfVariant1
-func (t OneOfObject4) AsOneOfVariant1() (OneOfVariant1, error) {
+func (ooo OneOfObject4) AsOneOfVariant1() (OneOfVariant1, error) {
 	var body OneOfVariant1
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant1 overwrites any union data inside the OneOfObject4 as the provided OneOfVariant1
-func (t *OneOfObject4) FromOneOfVariant1(v OneOfVariant1) error {
+func (ooo *OneOfObject4) FromOneOfVariant1(v OneOfVariant1) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject4, using the provided OneOfVariant1
-func (t *OneOfObject4) MergeOneOfVariant1(v OneOfVariant1) error {
+func (ooo *OneOfObject4) MergeOneOfVariant1(v OneOfVariant1) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant2 returns the union data inside the OneOfObject4 as a OneOfVariant2
-func (t OneOfObject4) AsOneOfVariant2() (OneOfVariant2, error) {
+func (ooo OneOfObject4) AsOneOfVariant2() (OneOfVariant2, error) {
 	var body OneOfVariant2
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant2 overwrites any union data inside the OneOfObject4 as the provided OneOfVariant2
-func (t *OneOfObject4) FromOneOfVariant2(v OneOfVariant2) error {
+func (ooo *OneOfObject4) FromOneOfVariant2(v OneOfVariant2) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant2 performs a merge with any union data inside the OneOfObject4, using the provided OneOfVariant2
-func (t *OneOfObject4) MergeOneOfVariant2(v OneOfVariant2) error {
+func (ooo *OneOfObject4) MergeOneOfVariant2(v OneOfVariant2) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant3 returns the union data inside the OneOfObject4 as a OneOfVariant3
-func (t OneOfObject4) AsOneOfVariant3() (OneOfVariant3, error) {
+func (ooo OneOfObject4) AsOneOfVariant3() (OneOfVariant3, error) {
 	var body OneOfVariant3
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant3 overwrites any union data inside the OneOfObject4 as the provided OneOfVariant3
-func (t *OneOfObject4) FromOneOfVariant3(v OneOfVariant3) error {
+func (ooo *OneOfObject4) FromOneOfVariant3(v OneOfVariant3) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant3 performs a merge with any union data inside the OneOfObject4, using the provided OneOfVariant3
-func (t *OneOfObject4) MergeOneOfVariant3(v OneOfVariant3) error {
+func (ooo *OneOfObject4) MergeOneOfVariant3(v OneOfVariant3) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject4) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooo OneOfObject4) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
 	if err != nil {
 		return nil, err
 	}
 	object := make(map[string]json.RawMessage)
-	if t.union != nil {
+	if ooo.union != nil {
 		err = json.Unmarshal(b, &object)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if t.FixedProperty != nil {
-		object["fixedProperty"], err = json.Marshal(t.FixedProperty)
+	if ooo.FixedProperty != nil {
+		object["fixedProperty"], err = json.Marshal(ooo.FixedProperty)
 		if err != nil {
 			return nil, fmt.Errorf("error marshaling 'fixedProperty': %w", err)
 		}
@@ -1648,8 +1959,8 @@ func (t OneOfObject4) MarshalJSON() ([]byte, error) {
 	return b, err
 }
 
-func (t *OneOfObject4) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooo *OneOfObject4) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	if err != nil {
 		return err
 	}
@@ -1660,7 +1971,7 @@ func (t *OneOfObject4) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["fixedProperty"]; found {
-		err = json.Unmarshal(raw, &t.FixedProperty)
+		err = json.Unmarshal(raw, &ooo.FixedProperty)
 		if err != nil {
 			return fmt.Errorf("error reading 'fixedProperty': %w", err)
 		}
@@ -1670,490 +1981,641 @@ func (t *OneOfObject4) UnmarshalJSON(b []byte) error {
 }
 
 // AsOneOfVariant4 returns the union data inside the OneOfObject5 as a OneOfVariant4
-func (t OneOfObject5) AsOneOfVariant4() (OneOfVariant4, error) {
+func (ooo OneOfObject5) AsOneOfVariant4() (OneOfVariant4, error) {
 	var body OneOfVariant4
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant4 overwrites any union data inside the OneOfObject5 as the provided OneOfVariant4
-func (t *OneOfObject5) FromOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "OneOfVariant4"
+func (ooo *OneOfObject5) FromOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant4 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"OneOfVariant4\"}"))
+	}
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject5, using the provided OneOfVariant4
-func (t *OneOfObject5) MergeOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "OneOfVariant4"
+func (ooo *OneOfObject5) MergeOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant4 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"OneOfVariant4\"}"))
+	}
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant5 returns the union data inside the OneOfObject5 as a OneOfVariant5
-func (t OneOfObject5) AsOneOfVariant5() (OneOfVariant5, error) {
+func (ooo OneOfObject5) AsOneOfVariant5() (OneOfVariant5, error) {
 	var body OneOfVariant5
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant5 overwrites any union data inside the OneOfObject5 as the provided OneOfVariant5
-func (t *OneOfObject5) FromOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "OneOfVariant5"
+func (ooo *OneOfObject5) FromOneOfVariant5(v OneOfVariant5) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant5 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"OneOfVariant5\"}"))
+	}
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant5 performs a merge with any union data inside the OneOfObject5, using the provided OneOfVariant5
-func (t *OneOfObject5) MergeOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "OneOfVariant5"
+func (ooo *OneOfObject5) MergeOneOfVariant5(v OneOfVariant5) error {
 	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant5 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"OneOfVariant5\"}"))
+	}
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject5) Discriminator() (string, error) {
+func (ooo OneOfObject5) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"discriminator"`
 	}
-	err := json.Unmarshal(t.union, &discriminator)
+	err := json.Unmarshal(ooo.union, &discriminator)
 	return discriminator.Discriminator, err
 }
 
-func (t OneOfObject5) ValueByDiscriminator() (interface{}, error) {
-	discriminator, err := t.Discriminator()
+func (ooo OneOfObject5) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := ooo.Discriminator()
 	if err != nil {
 		return nil, err
 	}
 	switch discriminator {
 	case "OneOfVariant4":
-		return t.AsOneOfVariant4()
+		return ooo.AsOneOfVariant4()
 	case "OneOfVariant5":
-		return t.AsOneOfVariant5()
+		return ooo.AsOneOfVariant5()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
 }
 
-func (t OneOfObject5) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooo OneOfObject5) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
 	return b, err
 }
 
-func (t *OneOfObject5) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooo *OneOfObject5) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	return err
 }
 
 // AsOneOfVariant4 returns the union data inside the OneOfObject6 as a OneOfVariant4
-func (t OneOfObject6) AsOneOfVariant4() (OneOfVariant4, error) {
+func (ooo OneOfObject6) AsOneOfVariant4() (OneOfVariant4, error) {
 	var body OneOfVariant4
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant4 overwrites any union data inside the OneOfObject6 as the provided OneOfVariant4
-func (t *OneOfObject6) FromOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "v4"
+func (ooo *OneOfObject6) FromOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant4 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"v4\"}"))
+	}
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject6, using the provided OneOfVariant4
-func (t *OneOfObject6) MergeOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "v4"
+func (ooo *OneOfObject6) MergeOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant4 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"v4\"}"))
+	}
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant5 returns the union data inside the OneOfObject6 as a OneOfVariant5
-func (t OneOfObject6) AsOneOfVariant5() (OneOfVariant5, error) {
+func (ooo OneOfObject6) AsOneOfVariant5() (OneOfVariant5, error) {
 	var body OneOfVariant5
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant5 overwrites any union data inside the OneOfObject6 as the provided OneOfVariant5
-func (t *OneOfObject6) FromOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "v5"
+func (ooo *OneOfObject6) FromOneOfVariant5(v OneOfVariant5) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant5 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"v5\"}"))
+	}
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant5 performs a merge with any union data inside the OneOfObject6, using the provided OneOfVariant5
-func (t *OneOfObject6) MergeOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "v5"
+func (ooo *OneOfObject6) MergeOneOfVariant5(v OneOfVariant5) error {
 	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant5 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"v5\"}"))
+	}
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject6) Discriminator() (string, error) {
+func (ooo OneOfObject6) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"discriminator"`
 	}
-	err := json.Unmarshal(t.union, &discriminator)
+	err := json.Unmarshal(ooo.union, &discriminator)
 	return discriminator.Discriminator, err
 }
 
-func (t OneOfObject6) ValueByDiscriminator() (interface{}, error) {
-	discriminator, err := t.Discriminator()
+func (ooo OneOfObject6) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := ooo.Discriminator()
 	if err != nil {
 		return nil, err
 	}
 	switch discriminator {
 	case "v4":
-		return t.AsOneOfVariant4()
+		return ooo.AsOneOfVariant4()
 	case "v5":
-		return t.AsOneOfVariant5()
+		return ooo.AsOneOfVariant5()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
 }
 
-func (t OneOfObject6) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooo OneOfObject6) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
 	return b, err
 }
 
-func (t *OneOfObject6) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooo *OneOfObject6) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	return err
 }
 
 // AsOneOfVariant4 returns the union data inside the OneOfObject61 as a OneOfVariant4
-func (t OneOfObject61) AsOneOfVariant4() (OneOfVariant4, error) {
+func (ooo OneOfObject61) AsOneOfVariant4() (OneOfVariant4, error) {
 	var body OneOfVariant4
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant4 overwrites any union data inside the OneOfObject61 as the provided OneOfVariant4
-func (t *OneOfObject61) FromOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "v4"
+func (ooo *OneOfObject61) FromOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant4 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"v4\"}"))
+	}
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject61, using the provided OneOfVariant4
-func (t *OneOfObject61) MergeOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "v4"
+func (ooo *OneOfObject61) MergeOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant4 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"v4\"}"))
+	}
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant5 returns the union data inside the OneOfObject61 as a OneOfVariant5
-func (t OneOfObject61) AsOneOfVariant5() (OneOfVariant5, error) {
+func (ooo OneOfObject61) AsOneOfVariant5() (OneOfVariant5, error) {
 	var body OneOfVariant5
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant5 overwrites any union data inside the OneOfObject61 as the provided OneOfVariant5
-func (t *OneOfObject61) FromOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "OneOfVariant5"
+func (ooo *OneOfObject61) FromOneOfVariant5(v OneOfVariant5) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant5 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"OneOfVariant5\"}"))
+	}
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant5 performs a merge with any union data inside the OneOfObject61, using the provided OneOfVariant5
-func (t *OneOfObject61) MergeOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "OneOfVariant5"
+func (ooo *OneOfObject61) MergeOneOfVariant5(v OneOfVariant5) error {
 	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant5 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"OneOfVariant5\"}"))
+	}
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject61) Discriminator() (string, error) {
+func (ooo OneOfObject61) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"discriminator"`
 	}
-	err := json.Unmarshal(t.union, &discriminator)
+	err := json.Unmarshal(ooo.union, &discriminator)
 	return discriminator.Discriminator, err
 }
 
-func (t OneOfObject61) ValueByDiscriminator() (interface{}, error) {
-	discriminator, err := t.Discriminator()
+func (ooo OneOfObject61) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := ooo.Discriminator()
 	if err != nil {
 		return nil, err
 	}
 	switch discriminator {
 	case "OneOfVariant5":
-		return t.AsOneOfVariant5()
+		return ooo.AsOneOfVariant5()
 	case "v4":
-		return t.AsOneOfVariant4()
+		return ooo.AsOneOfVariant4()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
 }
 
-func (t OneOfObject61) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooo OneOfObject61) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
 	return b, err
 }
 
-func (t *OneOfObject61) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooo *OneOfObject61) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	return err
 }
 
 // AsOneOfVariant4 returns the union data inside the OneOfObject62 as a OneOfVariant4
-func (t OneOfObject62) AsOneOfVariant4() (OneOfVariant4, error) {
+func (ooo OneOfObject62) AsOneOfVariant4() (OneOfVariant4, error) {
 	var body OneOfVariant4
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant4 overwrites any union data inside the OneOfObject62 as the provided OneOfVariant4
-func (t *OneOfObject62) FromOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "variant_four"
+func (ooo *OneOfObject62) FromOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant4 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"variant_four\"}"))
+	}
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject62, using the provided OneOfVariant4
-func (t *OneOfObject62) MergeOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "variant_four"
+func (ooo *OneOfObject62) MergeOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant4 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"variant_four\"}"))
+	}
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant51 returns the union data inside the OneOfObject62 as a OneOfVariant51
-func (t OneOfObject62) AsOneOfVariant51() (OneOfVariant51, error) {
+func (ooo OneOfObject62) AsOneOfVariant51() (OneOfVariant51, error) {
 	var body OneOfVariant51
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant51 overwrites any union data inside the OneOfObject62 as the provided OneOfVariant51
-func (t *OneOfObject62) FromOneOfVariant51(v OneOfVariant51) error {
-	v.Discriminator = "one_of_variant51"
+func (ooo *OneOfObject62) FromOneOfVariant51(v OneOfVariant51) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant51 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"one_of_variant51\"}"))
+	}
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant51 performs a merge with any union data inside the OneOfObject62, using the provided OneOfVariant51
-func (t *OneOfObject62) MergeOneOfVariant51(v OneOfVariant51) error {
-	v.Discriminator = "one_of_variant51"
+func (ooo *OneOfObject62) MergeOneOfVariant51(v OneOfVariant51) error {
 	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant51 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"one_of_variant51\"}"))
+	}
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject62) Discriminator() (string, error) {
+func (ooo OneOfObject62) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"discriminator"`
 	}
-	err := json.Unmarshal(t.union, &discriminator)
+	err := json.Unmarshal(ooo.union, &discriminator)
 	return discriminator.Discriminator, err
 }
 
-func (t OneOfObject62) ValueByDiscriminator() (interface{}, error) {
-	discriminator, err := t.Discriminator()
+func (ooo OneOfObject62) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := ooo.Discriminator()
 	if err != nil {
 		return nil, err
 	}
 	switch discriminator {
 	case "one_of_variant51":
-		return t.AsOneOfVariant51()
+		return ooo.AsOneOfVariant51()
 	case "variant_four":
-		return t.AsOneOfVariant4()
+		return ooo.AsOneOfVariant4()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (ooo OneOfObject62) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
+	return b, err
+}
+
+func (ooo *OneOfObject62) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOneOfVariant4 returns the union data inside the OneOfObject63 as a OneOfVariant4
+func (ooo OneOfObject63) AsOneOfVariant4() (OneOfVariant4, error) {
+	var body OneOfVariant4
+	err := json.Unmarshal(ooo.union, &body)
+	return body, err
+}
+
+// FromOneOfVariant4 overwrites any union data inside the OneOfObject63 as the provided OneOfVariant4
+func (ooo *OneOfObject63) FromOneOfVariant4(v OneOfVariant4) error {
+	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant4 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"v4\"}"))
+	}
+	ooo.union = b
+	return err
+}
+
+// MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject63, using the provided OneOfVariant4
+func (ooo *OneOfObject63) MergeOneOfVariant4(v OneOfVariant4) error {
+	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant4 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"v4\"}"))
+	}
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
+	return err
+}
+
+// AsOneOfVariant5 returns the union data inside the OneOfObject63 as a OneOfVariant5
+func (ooo OneOfObject63) AsOneOfVariant5() (OneOfVariant5, error) {
+	var body OneOfVariant5
+	err := json.Unmarshal(ooo.union, &body)
+	return body, err
+}
+
+// FromOneOfVariant5 overwrites any union data inside the OneOfObject63 as the provided OneOfVariant5
+func (ooo *OneOfObject63) FromOneOfVariant5(v OneOfVariant5) error {
+	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant5 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"v5\"}"))
+	}
+	ooo.union = b
+	return err
+}
+
+// MergeOneOfVariant5 performs a merge with any union data inside the OneOfObject63, using the provided OneOfVariant5
+func (ooo *OneOfObject63) MergeOneOfVariant5(v OneOfVariant5) error {
+	b, err := json.Marshal(v)
+	if err == nil {
+		// Set the discriminator, whether or not OneOfVariant5 has a field for it
+		b, err = runtime.JsonMerge(b, []byte("{\"discriminator\":\"v5\"}"))
+	}
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
+	return err
+}
+
+func (ooo OneOfObject63) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"discriminator"`
+	}
+	err := json.Unmarshal(ooo.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (ooo OneOfObject63) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := ooo.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "v4":
+		return ooo.AsOneOfVariant4()
+	case "v5":
+		return ooo.AsOneOfVariant5()
+	case "variant4":
+		return ooo.AsOneOfVariant4()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
 }
 
-func (t OneOfObject62) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooo OneOfObject63) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
 	return b, err
 }
 
-func (t *OneOfObject62) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooo *OneOfObject63) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	return err
 }
 
 // AsOneOfVariant1 returns the union data inside the OneOfObject7_Item as a OneOfVariant1
-func (t OneOfObject7_Item) AsOneOfVariant1() (OneOfVariant1, error) {
+func (oooi OneOfObject7_Item) AsOneOfVariant1() (OneOfVariant1, error) {
 	var body OneOfVariant1
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(oooi.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant1 overwrites any union data inside the OneOfObject7_Item as the provided OneOfVariant1
-func (t *OneOfObject7_Item) FromOneOfVariant1(v OneOfVariant1) error {
+func (oooi *OneOfObject7_Item) FromOneOfVariant1(v OneOfVariant1) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	oooi.union = b
 	return err
 }
 
 // MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject7_Item, using the provided OneOfVariant1
-func (t *OneOfObject7_Item) MergeOneOfVariant1(v OneOfVariant1) error {
+func (oooi *OneOfObject7_Item) MergeOneOfVariant1(v OneOfVariant1) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(oooi.union, b)
+	oooi.union = merged
 	return err
 }
 
 // AsOneOfVariant2 returns the union data inside the OneOfObject7_Item as a OneOfVariant2
-func (t OneOfObject7_Item) AsOneOfVariant2() (OneOfVariant2, error) {
+func (oooi OneOfObject7_Item) AsOneOfVariant2() (OneOfVariant2, error) {
 	var body OneOfVariant2
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(oooi.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant2 overwrites any union data inside the OneOfObject7_Item as the provided OneOfVariant2
-func (t *OneOfObject7_Item) FromOneOfVariant2(v OneOfVariant2) error {
+func (oooi *OneOfObject7_Item) FromOneOfVariant2(v OneOfVariant2) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	oooi.union = b
 	return err
 }
 
 // MergeOneOfVariant2 performs a merge with any union data inside the OneOfObject7_Item, using the provided OneOfVariant2
-func (t *OneOfObject7_Item) MergeOneOfVariant2(v OneOfVariant2) error {
+func (oooi *OneOfObject7_Item) MergeOneOfVariant2(v OneOfVariant2) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(oooi.union, b)
+	oooi.union = merged
 	return err
 }
 
-func (t OneOfObject7_Item) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (oooi OneOfObject7_Item) MarshalJSON() ([]byte, error) {
+	b, err := oooi.union.MarshalJSON()
 	return b, err
 }
 
-func (t *OneOfObject7_Item) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (oooi *OneOfObject7_Item) UnmarshalJSON(b []byte) error {
+	err := oooi.union.UnmarshalJSON(b)
 	return err
 }
 
 // AsOneOfVariant1 returns the union data inside the OneOfObject8 as a OneOfVariant1
-func (t OneOfObject8) AsOneOfVariant1() (OneOfVariant1, error) {
+func (ooo OneOfObject8) AsOneOfVariant1() (OneOfVariant1, error) {
 	var body OneOfVariant1
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant1 overwrites any union data inside the OneOfObject8 as the provided OneOfVariant1
-func (t *OneOfObject8) FromOneOfVariant1(v OneOfVariant1) error {
+func (ooo *OneOfObject8) FromOneOfVariant1(v OneOfVariant1) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject8, using the provided OneOfVariant1
-func (t *OneOfObject8) MergeOneOfVariant1(v OneOfVariant1) error {
+func (ooo *OneOfObject8) MergeOneOfVariant1(v OneOfVariant1) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant2 returns the union data inside the OneOfObject8 as a OneOfVariant2
-func (t OneOfObject8) AsOneOfVariant2() (OneOfVariant2, error) {
+func (ooo OneOfObject8) AsOneOfVariant2() (OneOfVariant2, error) {
 	var body OneOfVariant2
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant2 overwrites any union data inside the OneOfObject8 as the provided OneOfVariant2
-func (t *OneOfObject8) FromOneOfVariant2(v OneOfVariant2) error {
+func (ooo *OneOfObject8) FromOneOfVariant2(v OneOfVariant2) error {
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant2 performs a merge with any union data inside the OneOfObject8, using the provided OneOfVariant2
-func (t *OneOfObject8) MergeOneOfVariant2(v OneOfVariant2) error {
+func (ooo *OneOfObject8) MergeOneOfVariant2(v OneOfVariant2) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject8) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooo OneOfObject8) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
 	if err != nil {
 		return nil, err
 	}
 	object := make(map[string]json.RawMessage)
-	if t.union != nil {
+	if ooo.union != nil {
 		err = json.Unmarshal(b, &object)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if t.Fixed != nil {
-		object["fixed"], err = json.Marshal(t.Fixed)
+	if ooo.Fixed != nil {
+		object["fixed"], err = json.Marshal(ooo.Fixed)
 		if err != nil {
 			return nil, fmt.Errorf("error marshaling 'fixed': %w", err)
 		}
@@ -2162,8 +2624,8 @@ func (t OneOfObject8) MarshalJSON() ([]byte, error) {
 	return b, err
 }
 
-func (t *OneOfObject8) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooo *OneOfObject8) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	if err != nil {
 		return err
 	}
@@ -2174,7 +2636,7 @@ func (t *OneOfObject8) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["fixed"]; found {
-		err = json.Unmarshal(raw, &t.Fixed)
+		err = json.Unmarshal(raw, &ooo.Fixed)
 		if err != nil {
 			return fmt.Errorf("error reading 'fixed': %w", err)
 		}
@@ -2184,102 +2646,98 @@ func (t *OneOfObject8) UnmarshalJSON(b []byte) error {
 }
 
 // AsOneOfVariant1 returns the union data inside the OneOfObject9 as a OneOfVariant1
-func (t OneOfObject9) AsOneOfVariant1() (OneOfVariant1, error) {
+func (ooo OneOfObject9) AsOneOfVariant1() (OneOfVariant1, error) {
 	var body OneOfVariant1
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant1 overwrites any union data inside the OneOfObject9 as the provided OneOfVariant1
-func (t *OneOfObject9) FromOneOfVariant1(v OneOfVariant1) error {
-	t.Type = "v1"
-
+func (ooo *OneOfObject9) FromOneOfVariant1(v OneOfVariant1) error {
+	ooo.Type = "v1"
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject9, using the provided OneOfVariant1
-func (t *OneOfObject9) MergeOneOfVariant1(v OneOfVariant1) error {
-	t.Type = "v1"
-
+func (ooo *OneOfObject9) MergeOneOfVariant1(v OneOfVariant1) error {
+	ooo.Type = "v1"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
 // AsOneOfVariant6 returns the union data inside the OneOfObject9 as a OneOfVariant6
-func (t OneOfObject9) AsOneOfVariant6() (OneOfVariant6, error) {
+func (ooo OneOfObject9) AsOneOfVariant6() (OneOfVariant6, error) {
 	var body OneOfVariant6
-	err := json.Unmarshal(t.union, &body)
+	err := json.Unmarshal(ooo.union, &body)
 	return body, err
 }
 
 // FromOneOfVariant6 overwrites any union data inside the OneOfObject9 as the provided OneOfVariant6
-func (t *OneOfObject9) FromOneOfVariant6(v OneOfVariant6) error {
-	t.Type = "v6"
-
+func (ooo *OneOfObject9) FromOneOfVariant6(v OneOfVariant6) error {
+	ooo.Type = "v6"
 	b, err := json.Marshal(v)
-	t.union = b
+	ooo.union = b
 	return err
 }
 
 // MergeOneOfVariant6 performs a merge with any union data inside the OneOfObject9, using the provided OneOfVariant6
-func (t *OneOfObject9) MergeOneOfVariant6(v OneOfVariant6) error {
-	t.Type = "v6"
-
+func (ooo *OneOfObject9) MergeOneOfVariant6(v OneOfVariant6) error {
+	ooo.Type = "v6"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JsonMerge(ooo.union, b)
+	ooo.union = merged
 	return err
 }
 
-func (t OneOfObject9) Discriminator() (string, error) {
+func (ooo OneOfObject9) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"type"`
 	}
-	err := json.Unmarshal(t.union, &discriminator)
+	err := json.Unmarshal(ooo.union, &discriminator)
 	return discriminator.Discriminator, err
 }
 
-func (t OneOfObject9) ValueByDiscriminator() (interface{}, error) {
-	discriminator, err := t.Discriminator()
+func (ooo OneOfObject9) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := ooo.Discriminator()
 	if err != nil {
 		return nil, err
 	}
 	switch discriminator {
 	case "v1":
-		return t.AsOneOfVariant1()
+		return ooo.AsOneOfVariant1()
 	case "v6":
-		return t.AsOneOfVariant6()
+		return ooo.AsOneOfVariant6()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
 }
 
-func (t OneOfObject9) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
+func (ooo OneOfObject9) MarshalJSON() ([]byte, error) {
+	b, err := ooo.union.MarshalJSON()
 	if err != nil {
 		return nil, err
 	}
 	object := make(map[string]json.RawMessage)
-	if t.union != nil {
+	if ooo.union != nil {
 		err = json.Unmarshal(b, &object)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	object["type"], err = json.Marshal(t.Type)
+	object["type"], err = json.Marshal(ooo.Type)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'type': %w", err)
 	}
@@ -2288,8 +2746,8 @@ func (t OneOfObject9) MarshalJSON() ([]byte, error) {
 	return b, err
 }
 
-func (t *OneOfObject9) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+func (ooo *OneOfObject9) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	if err != nil {
 		return err
 	}
@@ -2300,7 +2758,7 @@ func (t *OneOfObject9) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["type"]; found {
-		err = json.Unmarshal(raw, &t.Type)
+		err = json.Unmarshal(raw, &ooo.Type)
 		if err != nil {
 			return fmt.Errorf("error reading 'type': %w", err)
 		}
@@ -2310,8 +2768,8 @@ func (t *OneOfObject9) UnmarshalJSON(b []byte) error {
 }
 
 // Override default JSON handling for OneOfObject13 to handle AdditionalProperties and union
-func (a *OneOfObject13) UnmarshalJSON(b []byte) error {
-	err := a.union.UnmarshalJSON(b)
+func (ooo *OneOfObject13) UnmarshalJSON(b []byte) error {
+	err := ooo.union.UnmarshalJSON(b)
 	if err != nil {
 		return err
 	}
@@ -2322,7 +2780,7 @@ func (a *OneOfObject13) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["type"]; found {
-		err = json.Unmarshal(raw, &a.Type)
+		err = json.Unmarshal(raw, &ooo.Type)
 		if err != nil {
 			return fmt.Errorf("error reading 'type': %w", err)
 		}
@@ -2330,44 +2788,43 @@ func (a *OneOfObject13) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]interface{})
+		ooo.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
 			var fieldVal interface{}
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
 			}
-			a.AdditionalProperties[fieldName] = fieldVal
+			ooo.AdditionalProperties[fieldName] = fieldVal
 		}
 	}
 	return nil
 }
 
-// Override default JSON handling for OneOfObject13 to handle AdditionalProperties and union
-func (a OneOfObject13) MarshalJSON() ([]byte, error) {
-	var err error
-	b, err := a.union.MarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	object := make(map[string]json.RawMessage)
-	if a.union != nil {
-		err = json.Unmarshal(b, &object)
+// Override default JSON handling for OneOfObject13 to handle AdditionalProperties and union,
+// writing the fields of the union sorted by name, then the properties in
+// order, followed by the additional properties sorted by name, so that the
+// output is always the same
+func (ooo OneOfObject13) MarshalJSON() ([]byte, error) {
+	var object runtime.JSONObject
+	if ooo.union != nil {
+		b, err := ooo.union.MarshalJSON()
 		if err != nil {
 			return nil, err
 		}
+		if err := object.SetFields(b); err != nil {
+			return nil, err
+		}
 	}
 
-	object["type"], err = json.Marshal(a.Type)
-	if err != nil {
+	if err := object.Set("type", ooo.Type); err != nil {
 		return nil, fmt.Errorf("error marshaling 'type': %w", err)
 	}
 
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
+	for _, fieldName := range runtime.SortedKeys(ooo.AdditionalProperties) {
+		if err := object.Set(fieldName, ooo.AdditionalProperties[fieldName]); err != nil {
 			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
 		}
 	}
-	return json.Marshal(object)
+	return object.MarshalJSON()
 }
@@ -2,6 +2,7 @@ package components
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -182,6 +183,29 @@ func TestOneOfWithDiscriminator_PartialMapping(t *testing.T) {
 	assertJsonEqual(t, []byte(variant5), marshaled)
 }
 
+func TestOneOfWithDiscriminator_AliasedMapping(t *testing.T) {
+	var dst OneOfObject63
+
+	err := json.Unmarshal([]byte(`{"discriminator": "variant4", "name": "123"}`), &dst)
+	require.NoError(t, err)
+	v4, err := dst.ValueByDiscriminator()
+	require.NoError(t, err)
+	assert.Equal(t, OneOfVariant4{Discriminator: "variant4", Name: "123"}, v4)
+
+	err = json.Unmarshal([]byte(`{"discriminator": "v4", "name": "123"}`), &dst)
+	require.NoError(t, err)
+	v4, err = dst.ValueByDiscriminator()
+	require.NoError(t, err)
+	assert.Equal(t, OneOfVariant4{Discriminator: "v4", Name: "123"}, v4)
+
+	// The first of the values mapping to the schema is filled in
+	err = dst.FromOneOfVariant4(OneOfVariant4{Name: "123"})
+	require.NoError(t, err)
+	marshaled, err := json.Marshal(dst)
+	require.NoError(t, err)
+	assertJsonEqual(t, []byte(`{"discriminator": "v4", "name": "123"}`), marshaled)
+}
+
 func TestOneOfWithDiscriminator_SchemaNameUsed(t *testing.T) {
 	const variant4 = `{"discriminator": "variant_four", "name": "789"}`
 	const variant51 = `{"discriminator": "one_of_variant51", "id": 987}`
@@ -292,6 +316,31 @@ func TestOneOfWithFixedProperties(t *testing.T) {
 	assertJsonEqual(t, []byte(variant6), marshaled)
 }
 
+func TestOneOfWithUndeclaredDiscriminator(t *testing.T) {
+	var dst OneOfObject14
+
+	// The variants have no field for the discriminator, so it's added to
+	// their JSON when they're set
+	err := dst.FromOneOfVariant1(OneOfVariant1{Name: "123"})
+	require.NoError(t, err)
+	marshaled, err := json.Marshal(dst)
+	require.NoError(t, err)
+	assertJsonEqual(t, []byte(`{"kind": "v1", "name": "123"}`), marshaled)
+
+	discriminator, err := dst.Discriminator()
+	require.NoError(t, err)
+	assert.Equal(t, "v1", discriminator)
+	v1, err := dst.ValueByDiscriminator()
+	require.NoError(t, err)
+	assert.Equal(t, OneOfVariant1{Name: "123"}, v1)
+
+	err = dst.MergeOneOfVariant6(OneOfVariant6{[]int{1, 2, 3}})
+	require.NoError(t, err)
+	marshaled, err = json.Marshal(dst)
+	require.NoError(t, err)
+	assertJsonEqual(t, []byte(`{"kind": "v6", "name": "123", "values": [1, 2, 3]}`), marshaled)
+}
+
 func TestAnyOf(t *testing.T) {
 	const anyOfStr = `{"discriminator": "all", "name": "123", "id": 456}`
 
@@ -345,3 +394,55 @@ func TestMarshalWhenNoUnionValueSet(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expected, string(bytes))
 }
+
+func TestDeterministicMarshalJSON(t *testing.T) {
+	optional := "opt"
+	obj1 := AdditionalPropertiesObject1{
+		Id:                   1,
+		Name:                 "name",
+		Optional:             &optional,
+		AdditionalProperties: map[string]int{"zeta": 26, "alpha": 1, "mu": 12, "beta": 2, "omega": 24},
+	}
+	// The properties come first, in order, followed by the sorted additional
+	// properties, every time
+	const expected1 = `{"id":1,"name":"name","optional":"opt","alpha":1,"beta":2,"mu":12,"omega":24,"zeta":26}`
+	for i := 0; i < 20; i++ {
+		b, err := json.Marshal(obj1)
+		require.NoError(t, err)
+		require.Equal(t, expected1, string(b))
+	}
+
+	obj13 := OneOfObject13{
+		AdditionalProperties: map[string]interface{}{"z": 1, "b": "2", "c": []int{3}},
+	}
+	require.NoError(t, obj13.MergeOneOfVariant1(OneOfVariant1{Name: "test-name"}))
+	const expected13 = `{"name":"test-name","type":"v1","b":"2","c":[3],"z":1}`
+	for i := 0; i < 20; i++ {
+		b, err := json.Marshal(obj13)
+		require.NoError(t, err)
+		require.Equal(t, expected13, string(b))
+	}
+}
+
+func TestEnumHelpers(t *testing.T) {
+	assert.Equal(t, "Cat", Cat.String())
+	assert.Equal(t, "Dog", fmt.Sprint(Dog))
+
+	e4, err := ParseEnum4("Mouse")
+	require.NoError(t, err)
+	assert.Equal(t, Mouse, e4)
+	_, err = ParseEnum4("mouse")
+	assert.EqualError(t, err, `invalid Enum4 value "mouse"`)
+
+	assert.Equal(t, "Enum5N6", Enum5N6.String())
+	assert.Equal(t, "Enum5(8)", Enum5(8).String())
+
+	e5, err := ParseEnum5("Enum5N7")
+	require.NoError(t, err)
+	assert.Equal(t, Enum5N7, e5)
+	e5, err = ParseEnum5("5")
+	require.NoError(t, err)
+	assert.Equal(t, Enum5N5, e5)
+	_, err = ParseEnum5("8")
+	assert.EqualError(t, err, `invalid Enum5 value "8"`)
+}
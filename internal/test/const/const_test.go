@@ -0,0 +1,44 @@
+package constant
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConst(t *testing.T) {
+	circle := Circle{Kind: CircleKindCircle, Version: N2, Radius: 1.5}
+	b, err := json.Marshal(circle)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kind":"circle","version":2,"radius":1.5}`, string(b))
+
+	var decoded Circle
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, circle, decoded)
+
+	// Other values of a const string are rejected
+	err = json.Unmarshal([]byte(`{"kind":"square","version":2,"radius":1.5}`), &decoded)
+	assert.EqualError(t, err, `CircleKind must be "circle", got "square"`)
+
+	// Other values of a const integer are rejected
+	err = json.Unmarshal([]byte(`{"kind":"circle","version":3,"radius":1.5}`), &decoded)
+	assert.EqualError(t, err, `CircleVersion must be 2, got 3`)
+
+	err = json.Unmarshal([]byte(`{"kind":"circle","version":"2","radius":1.5}`), &decoded)
+	assert.Error(t, err)
+
+	// Consts which aren't scalars of a plain type keep the schema's type
+	since := openapi_types.Date{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	var sizes interface{} = []interface{}{1.0, 2.0}
+	circle = Circle{Kind: CircleKindCircle, Version: N2, Meta: &map[string]interface{}{"a": 1.0}, Since: &since, Sizes: &sizes}
+	b, err = json.Marshal(circle)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kind":"circle","version":2,"radius":0,"meta":{"a":1},"since":"2020-01-01","sizes":[1,2]}`, string(b))
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, circle, decoded)
+}
@@ -0,0 +1,65 @@
+package datetimeformat
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct {
+	rawQuery string
+	since    time.Time
+	params   ListEventsParams
+}
+
+func (s *server) ListEvents(w http.ResponseWriter, r *http.Request, since time.Time, params ListEventsParams) {
+	s.rawQuery = r.URL.RawQuery
+	s.since = since
+	s.params = params
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(Window{Since: since})
+}
+
+func TestDateTimeFormat(t *testing.T) {
+	s := &server{}
+	ts := httptest.NewServer(Handler(s))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	since := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	until := since.Add(time.Hour)
+	at := []time.Time{since, until}
+	rsp, err := client.ListEventsWithResponse(context.Background(), since, &ListEventsParams{Until: &until, At: &at, Window: &Window{Since: since}})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rsp.StatusCode())
+
+	// The parameters are sent with the layout, and parsed back with it
+	assert.Equal(t, "until=2024-05-06+08%3A08%3A09&window%5Bsince%5D=2024-05-06+07%3A08%3A09&at=2024-05-06+07%3A08%3A09,2024-05-06+08%3A08%3A09", s.rawQuery)
+	assert.Equal(t, since, s.since)
+	require.NotNil(t, s.params.Until)
+	assert.Equal(t, until, *s.params.Until)
+	require.NotNil(t, s.params.At)
+	assert.Equal(t, at, *s.params.At)
+	// Including those in the fields of objects
+	require.NotNil(t, s.params.Window)
+	assert.Equal(t, since, s.params.Window.Since)
+
+	// JSON bodies remain RFC 3339
+	assert.JSONEq(t, `{"since": "2024-05-06T07:08:09Z"}`, string(rsp.Body))
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, since, rsp.JSON200.Since)
+
+	// Times in other layouts are rejected
+	res, err := http.Get(ts.URL + "/events/2024-05-06T07:08:09Z")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
@@ -0,0 +1,65 @@
+package defaultresponse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// respond returns a server answering every request with status and the JSON
+// body
+func respond(t *testing.T, status int, body string) *ClientWithResponses {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(ts.Close)
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+	return client
+}
+
+func TestDefaultResponse(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("declared status", func(t *testing.T) {
+		rsp, err := respond(t, http.StatusOK, `{"name":"Earl Grey"}`).GetTeapotWithResponse(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, rsp.JSON200)
+		assert.Equal(t, "Earl Grey", rsp.JSON200.Name)
+		assert.Nil(t, rsp.JSONDefault)
+		assert.False(t, rsp.IsDefault())
+	})
+
+	t.Run("undeclared status", func(t *testing.T) {
+		rsp, err := respond(t, http.StatusTeapot, `{"message":"I'm a teapot"}`).GetTeapotWithResponse(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, rsp.JSON200)
+		require.NotNil(t, rsp.JSONDefault)
+		assert.Equal(t, "I'm a teapot", rsp.JSONDefault.Message)
+		assert.True(t, rsp.IsDefault())
+	})
+
+	t.Run("ranged status", func(t *testing.T) {
+		rsp, err := respond(t, http.StatusTeapot, `{"title":"I'm a teapot"}`).GetKettleWithResponse(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, rsp.JSON4XX)
+		assert.Equal(t, "I'm a teapot", rsp.JSON4XX.Title)
+		assert.Nil(t, rsp.JSONDefault)
+		assert.False(t, rsp.IsDefault())
+	})
+
+	t.Run("status outside the ranges", func(t *testing.T) {
+		rsp, err := respond(t, http.StatusServiceUnavailable, `{"message":"Out of water"}`).GetKettleWithResponse(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, rsp.JSON4XX)
+		require.NotNil(t, rsp.JSONDefault)
+		assert.Equal(t, "Out of water", rsp.JSONDefault.Message)
+		assert.True(t, rsp.IsDefault())
+	})
+}
@@ -0,0 +1,98 @@
+package equal
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func newConfig() Config {
+	return Config{
+		Name:   "api",
+		Kind:   ptr(A),
+		Tags:   []string{"x", "y"},
+		Labels: &map[string]string{"team": "core"},
+		Owner:  Person{Name: "Ann", AdditionalProperties: map[string]int{"age": 40}},
+		Reviewers: &[]Person{
+			{Name: "Bob"},
+		},
+		ByRole: &map[string]Person{"lead": {Name: "Cy"}},
+		Groups: &Groups{{{Name: "Dee"}}},
+		Parent: &Config{Name: "root"},
+		Limits: &struct {
+			Cpu    *float32 `json:"cpu,omitempty"`
+			Memory *int     `json:"memory,omitempty"`
+		}{Cpu: ptr(float32(0.5))},
+		Updated: ptr(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)),
+		Extra:   &map[string]interface{}{"a": []interface{}{1.0, "b"}},
+		Value:   ptr(interface{}(map[string]interface{}{"x": 1.0})),
+		Note:    NewNullable("hello"),
+		Point:   &Point{Item0: 1, Item1: 2, Rest: []int{3}},
+	}
+}
+
+func TestEqual(t *testing.T) {
+	assert.True(t, newConfig().Equal(newConfig()))
+	assert.True(t, Config{}.Equal(Config{}))
+
+	// Empty and nil slices and maps are equal
+	c := newConfig()
+	c.Tags, c.Owner.AdditionalProperties = nil, nil
+	d := newConfig()
+	d.Tags, d.Owner.AdditionalProperties = []string{}, map[string]int{}
+	assert.True(t, c.Equal(d))
+
+	var cat, sameCat, dog Pet
+	require.NoError(t, cat.FromCat(Cat{Lives: ptr(9)}))
+	require.NoError(t, sameCat.FromCat(Cat{Lives: ptr(9)}))
+	require.NoError(t, dog.FromDog(Dog{Bark: ptr("woof")}))
+	assert.True(t, cat.Equal(sameCat))
+	assert.False(t, cat.Equal(dog))
+
+	changes := map[string]func(c *Config){
+		"value":             func(c *Config) { c.Name = "other" },
+		"nil pointer":       func(c *Config) { c.Kind = nil },
+		"pointer":           func(c *Config) { c.Kind = ptr(B) },
+		"slice element":     func(c *Config) { c.Tags[1] = "z" },
+		"slice length":      func(c *Config) { c.Tags = append(c.Tags, "z") },
+		"map value":         func(c *Config) { (*c.Labels)["team"] = "web" },
+		"map key":           func(c *Config) { *c.Labels = map[string]string{"group": "core"} },
+		"struct":            func(c *Config) { c.Owner.Name = "Al" },
+		"additional":        func(c *Config) { c.Owner.AdditionalProperties["age"] = 41 },
+		"slice of structs":  func(c *Config) { (*c.Reviewers)[0].Email = ptr(openapi_types.Email("bob@example.com")) },
+		"map of structs":    func(c *Config) { (*c.ByRole)["lead"] = Person{Name: "Di"} },
+		"nested slices":     func(c *Config) { (*c.Groups)[0][0].Name = "Ed" },
+		"recursive":         func(c *Config) { c.Parent.Name = "top" },
+		"inline struct":     func(c *Config) { c.Limits.Memory = ptr(1) },
+		"time":              func(c *Config) { c.Updated = ptr(c.Updated.Add(time.Second)) },
+		"interface":         func(c *Config) { (*c.Extra)["a"] = []interface{}{1.0, "c"} },
+		"nullable":          func(c *Config) { c.Note = NewNullNullable[string]() },
+		"nullable value":    func(c *Config) { c.Note = NewNullable("bye") },
+		"tuple item":        func(c *Config) { c.Point.Item2 = ptr("z") },
+		"tuple rest":        func(c *Config) { c.Point.Rest = nil },
+		"union":             func(c *Config) { c.Pet = &Pet{} },
+		"empty interface{}": func(c *Config) { c.Value = ptr(interface{}(json.Number("1"))) },
+	}
+	for name, change := range changes {
+		t.Run(name, func(t *testing.T) {
+			c := newConfig()
+			change(&c)
+			assert.False(t, c.Equal(newConfig()))
+			assert.False(t, newConfig().Equal(c))
+		})
+	}
+
+	// Times are compared as instants, whatever their location
+	c = newConfig()
+	c.Updated = ptr(c.Updated.In(time.FixedZone("CET", 3600)))
+	assert.True(t, c.Equal(newConfig()))
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"sync"
 
 	externalRef0 "github.com/deepmap/oapi-codegen/internal/test/externalref/packageA"
 	externalRef1 "github.com/deepmap/oapi-codegen/internal/test/externalref/packageB"
@@ -19,18 +20,19 @@ import (
 
 // Container defines model for Container.
 type Container struct {
-	ObjectA *externalRef0.ObjectA   `json:"object_a,omitempty"`
-	ObjectB *externalRef1.ObjectB   `json:"object_b,omitempty"`
-	ObjectC *map[string]interface{} `json:"object_c,omitempty"`
+	ObjectA      *externalRef0.ObjectA   `json:"object_a,omitempty"`
+	ObjectB      *externalRef1.ObjectB   `json:"object_b,omitempty"`
+	ObjectC      *map[string]interface{} `json:"object_c,omitempty"`
+	OtherObjectB *externalRef1.ObjectB   `json:"other_object_b,omitempty"`
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/5yPQU7EMAxF72JYRtOR2GXHcAC4AfJkPNSotaPEIKEqd0dJKa1gQcUqtvzf/z8TBB2j",
-	"Coll8BPk0NOIbXxQMWShVJeYNFIypnbS8ysFe8Y63ya6goebbjXqvly6x6a7h+IW5LwPOW2Q8BfyrSul",
-	"OFgyf5UWHKm+9hEJPGRLLC//qrbGnHbHlB8fwsuFjVVweNrglt7ILegsn1GWqzZXtqHewME7pcwqdane",
-	"kQQjg4e7w/FwBAcRra+NSvkMAAD//3vXjDblAQAA",
+	"H4sIAAAAAAAC/5yQQU7DQAxF72JYjppK7LKjHABuULmpS4wae+QxSKiauyNPCa1gQWA1tvzf//acYNAp",
+	"q5B4gf4EZRhpwlY+qDiykEWTTTOZM7WR7l5o8C1GfWt0gB5uuotR9+nSPTbdPdQ0I7tlyOYKGX5DvnTB",
+	"+Ei2/XNYrQnmZX9cKzhRvP6eCXoobizP/7rpErNZHFO//QTu9+ysgsenK9ztldKMnuVnlOWgzZX9GDNI",
+	"8EZWWCWa8M4kmBl6uFutV2tIkNHH2KjWjwEAzh0P4R4CAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -118,3 +120,29 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+var (
+	swaggerOnce      sync.Once
+	cachedSwagger    *openapi3.T
+	cachedSwaggerErr error
+)
+
+// GetSwaggerCached is like GetSwagger, but only loads the specification the
+// first time it's called. Every caller gets the same *openapi3.T, which must
+// not be modified.
+func GetSwaggerCached() (*openapi3.T, error) {
+	swaggerOnce.Do(func() {
+		cachedSwagger, cachedSwaggerErr = GetSwagger()
+	})
+	return cachedSwagger, cachedSwaggerErr
+}
+
+// MustGetSwagger is like GetSwaggerCached, but panics if the specification
+// can't be loaded.
+func MustGetSwagger() *openapi3.T {
+	swagger, err := GetSwaggerCached()
+	if err != nil {
+		panic(fmt.Sprintf("error loading embedded swagger spec: %s", err))
+	}
+	return swagger
+}
@@ -13,6 +13,8 @@ func TestParameters(t *testing.T) {
 	_ = Container{
 		ObjectA: &packageA.ObjectA{ObjectB: b},
 		ObjectB: b,
+		// Referred to by an unclean path to the mapped file
+		OtherObjectB: b,
 	}
 }
 
@@ -26,3 +28,14 @@ func TestGetSwagger(t *testing.T) {
 	_, err = GetSwagger()
 	require.Nil(t, err)
 }
+
+func TestMustGetSwagger(t *testing.T) {
+	swagger := MustGetSwagger()
+	require.NotNil(t, swagger)
+
+	// The spec is only loaded once
+	require.Same(t, swagger, MustGetSwagger())
+	cached, err := GetSwaggerCached()
+	require.NoError(t, err)
+	require.Same(t, swagger, cached)
+}
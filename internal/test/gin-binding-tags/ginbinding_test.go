@@ -0,0 +1,59 @@
+package ginbinding
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type server struct{}
+
+func (server) FindUsers(ctx context.Context, request FindUsersRequestObject) (FindUsersResponseObject, error) {
+	return FindUsers204Response{}, nil
+}
+
+func (server) CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error) {
+	return CreateUser201JSONResponse(User{Name: request.Body.Name, Email: request.Body.Email, Address: request.Body.Address}), nil
+}
+
+func TestGinBindingTags(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	RegisterHandlers(router, NewStrictHandler(server{}, nil))
+
+	do := func(method, target, body string) int {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	t.Run("params", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, do(http.MethodGet, "/users?email=alice@example.com", ""))
+		assert.Equal(t, http.StatusNoContent, do(http.MethodGet, "/users?email=alice@example.com&website=https://example.com", ""))
+		assert.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/users?email=alice", ""))
+		assert.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/users?email=alice@example.com&website=example", ""))
+	})
+
+	t.Run("body", func(t *testing.T) {
+		// Zero numbers, booleans and strings aren't rejected as missing
+		assert.Equal(t, http.StatusCreated, do(http.MethodPost, "/users",
+			`{"name": "", "email": "alice@example.com", "age": 0, "admin": false, "address": {"city": ""}, "roles": []}`))
+		// Missing arrays are
+		assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users",
+			`{"name": "Alice", "email": "alice@example.com", "age": 30, "admin": false, "address": {"city": "Paris"}}`))
+		assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users",
+			`{"name": "Alice", "email": "alice", "age": 30, "admin": false, "address": {"city": "Paris"}, "roles": []}`))
+		assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users",
+			`{"name": "Alice", "email": "alice@example.com", "homepage": "home", "age": 30, "admin": false, "address": {"city": "Paris"}, "roles": []}`))
+		// Nested structs are checked too
+		assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/users",
+			`{"name": "Alice", "email": "alice@example.com", "age": 30, "admin": false, "address": {"server": "not a host"}, "roles": []}`))
+	})
+}
@@ -0,0 +1,49 @@
+package headereditors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderEditors(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	// Header parameters of operations are serialized according to their schemas
+	rateLimit := 10
+	tags := Tags{"red", "green"}
+	_, err = client.ListItemsWithResponse(context.Background(), &ListItemsParams{XRateLimit: &rateLimit, XTags: &tags})
+	require.NoError(t, err)
+	assert.Equal(t, "10", header.Get("X-Rate-Limit"))
+	assert.Equal(t, "red,green", header.Get("X-Tags"))
+
+	// And so they are by the request editors of the shared ones
+	_, err = client.ListItemsWithResponse(context.Background(), &ListItemsParams{},
+		WithAuthorizationHeader("Bearer token"),
+		WithXRateLimitHeader(5),
+		WithXTagsHeader(Tags{"blue"}),
+		WithXFilterHeader(Filter{Color: &tags[0]}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token", header.Get("Authorization"))
+	assert.Equal(t, "5", header.Get("X-Rate-Limit"))
+	assert.Equal(t, "blue", header.Get("X-Tags"))
+	assert.Equal(t, `{"color":"red"}`, header.Get("X-Filter"))
+
+	// The editors take precedence over the parameters
+	_, err = client.ListItemsWithResponse(context.Background(), &ListItemsParams{XRateLimit: &rateLimit}, WithXRateLimitHeader(20))
+	require.NoError(t, err)
+	assert.Equal(t, "20", header.Get("X-Rate-Limit"))
+}
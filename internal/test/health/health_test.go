@@ -0,0 +1,45 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/deepmap/oapi-codegen/pkg/testutil"
+)
+
+type server struct{}
+
+func (server) ListThings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestHealthEndpoints(t *testing.T) {
+	var ready error
+	r := chi.NewRouter()
+	RegisterHealthHandlers(r, HealthChecks{
+		Readiness: []HealthCheck{func(ctx context.Context) error { return ready }},
+	})
+	handler := HandlerFromMux(server{}, r)
+
+	rr := testutil.NewRequest().Get("/healthz").GoWithHTTPHandler(t, handler).Recorder
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "ok\n", rr.Body.String())
+
+	rr = testutil.NewRequest().Get("/readyz").GoWithHTTPHandler(t, handler).Recorder
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	// Failing checks make the endpoint unavailable, with their error
+	ready = errors.New("database unreachable")
+	rr = testutil.NewRequest().Get("/readyz").GoWithHTTPHandler(t, handler).Recorder
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(t, "database unreachable\n", rr.Body.String())
+
+	// The spec's operations are still served
+	rr = testutil.NewRequest().Get("/things").GoWithHTTPHandler(t, handler).Recorder
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
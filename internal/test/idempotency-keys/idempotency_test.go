@@ -0,0 +1,102 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// server records the idempotency key of the last request
+type server struct {
+	key   string
+	found bool
+}
+
+func (s *server) record(w http.ResponseWriter, r *http.Request) {
+	s.key, s.found = IdempotencyKeyFromContext(r.Context())
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) CreateOrder(w http.ResponseWriter, r *http.Request) {
+	s.record(w, r)
+}
+
+func (s *server) UpdateOrder(w http.ResponseWriter, r *http.Request, id string) {
+	s.record(w, r)
+}
+
+func (s *server) ReplaceOrder(w http.ResponseWriter, r *http.Request, id string) {
+	s.record(w, r)
+}
+
+func (s *server) CancelOrder(w http.ResponseWriter, r *http.Request, id string) {
+	s.record(w, r)
+}
+
+func (s *server) RefundOrder(w http.ResponseWriter, r *http.Request, id string) {
+	s.record(w, r)
+}
+
+func TestIdempotencyKeys(t *testing.T) {
+	var s server
+	ts := httptest.NewServer(IdempotencyKeyMiddleware(Handler(&s)))
+	defer ts.Close()
+	ctx := context.Background()
+	item := "book"
+
+	t.Run("auto", func(t *testing.T) {
+		client, err := NewClient(ts.URL, WithAutoIdempotencyKeys(true))
+		require.NoError(t, err)
+
+		_, err = client.CreateOrder(ctx, CreateOrderJSONRequestBody{Item: &item})
+		require.NoError(t, err)
+		assert.True(t, s.found)
+		_, err = uuid.Parse(s.key)
+		assert.NoError(t, err)
+		first := s.key
+
+		_, err = client.CreateOrder(ctx, CreateOrderJSONRequestBody{Item: &item})
+		require.NoError(t, err)
+		assert.NotEqual(t, first, s.key, "each call has a key of its own")
+
+		_, err = client.UpdateOrder(ctx, "1", UpdateOrderJSONRequestBody{Item: &item})
+		require.NoError(t, err)
+		assert.True(t, s.found)
+
+		// PUT is idempotent, unless x-idempotency-key says otherwise
+		_, err = client.ReplaceOrder(ctx, "1", ReplaceOrderJSONRequestBody{Item: &item})
+		require.NoError(t, err)
+		assert.False(t, s.found)
+
+		_, err = client.RefundOrder(ctx, "1")
+		require.NoError(t, err)
+		assert.True(t, s.found)
+
+		_, err = client.CancelOrder(ctx, "1")
+		require.NoError(t, err)
+		assert.False(t, s.found)
+
+		// The caller's key takes precedence
+		_, err = client.CreateOrder(ctx, CreateOrderJSONRequestBody{Item: &item}, WithIdempotencyKey("order-1"))
+		require.NoError(t, err)
+		assert.Equal(t, "order-1", s.key)
+	})
+
+	t.Run("manual", func(t *testing.T) {
+		client, err := NewClient(ts.URL)
+		require.NoError(t, err)
+
+		_, err = client.CreateOrder(ctx, CreateOrderJSONRequestBody{Item: &item})
+		require.NoError(t, err)
+		assert.False(t, s.found)
+
+		_, err = client.ReplaceOrder(ctx, "1", ReplaceOrderJSONRequestBody{Item: &item}, WithIdempotencyKey("replace-1"))
+		require.NoError(t, err)
+		assert.Equal(t, "replace-1", s.key)
+	})
+}
@@ -0,0 +1,97 @@
+package inprocessclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct {
+	pets []Pet
+}
+
+func (s *server) ListPets(ctx context.Context, request ListPetsRequestObject) (ListPetsResponseObject, error) {
+	pets := []Pet{}
+	for _, pet := range s.pets {
+		if request.Params.Kind == nil || pet.Kind == *request.Params.Kind {
+			pets = append(pets, pet)
+		}
+	}
+	return ListPets200JSONResponse(pets), nil
+}
+
+func (s *server) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	if request.Body.Name == "" {
+		return AddPetdefaultJSONResponse{Body: Error{Message: "a pet needs a name"}, StatusCode: http.StatusBadRequest}, nil
+	}
+	s.pets = append(s.pets, *request.Body)
+	return AddPet201JSONResponse(*request.Body), nil
+}
+
+func TestInProcessClient(t *testing.T) {
+	var editedRequests int
+	client, err := NewInProcessClient(Handler(NewStrictHandler(&server{}, nil)),
+		WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			editedRequests++
+			return nil
+		}))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	added, err := client.AddPetWithResponse(ctx, AddPetJSONRequestBody{Name: "Tom", Kind: "cat"})
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON201)
+	assert.Equal(t, Pet{Name: "Tom", Kind: "cat"}, *added.JSON201)
+	assert.Equal(t, "/pets", added.HTTPResponse.Request.URL.Path)
+
+	_, err = client.AddPetWithResponse(ctx, AddPetJSONRequestBody{Name: "Spike", Kind: "dog"})
+	require.NoError(t, err)
+
+	failed, err := client.AddPetWithResponse(ctx, AddPetJSONRequestBody{Kind: "dog"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, failed.StatusCode())
+	require.NotNil(t, failed.JSONDefault)
+	assert.Equal(t, "a pet needs a name", failed.JSONDefault.Message)
+
+	kind := "dog"
+	listed, err := client.ListPetsWithResponse(ctx, &ListPetsParams{Kind: &kind})
+	require.NoError(t, err)
+	require.NotNil(t, listed.JSON200)
+	assert.Equal(t, []Pet{{Name: "Spike", Kind: "dog"}}, *listed.JSON200)
+
+	assert.Equal(t, 4, editedRequests)
+
+	// The client can be used through its interface
+	var _ ClientWithResponsesInterface = client
+}
+
+func TestInProcessClientServerRequests(t *testing.T) {
+	// The handler gets requests like those a server receives
+	var bodies []string
+	var requestURIs, remoteAddrs []string
+	readBody := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			bodies = append(bodies, string(body))
+			requestURIs = append(requestURIs, r.RequestURI)
+			remoteAddrs = append(remoteAddrs, r.RemoteAddr)
+			next.ServeHTTP(w, r)
+		})
+	}
+	s := &server{pets: []Pet{{Name: "Tom", Kind: "cat"}}}
+	client, err := NewInProcessClient(readBody(Handler(NewStrictHandler(s, nil))))
+	require.NoError(t, err)
+
+	kind := "cat"
+	listed, err := client.ListPetsWithResponse(context.Background(), &ListPetsParams{Kind: &kind})
+	require.NoError(t, err)
+	require.NotNil(t, listed.JSON200)
+	assert.Equal(t, []string{""}, bodies)
+	assert.Equal(t, []string{"/pets?kind=cat"}, requestURIs)
+	assert.NotEmpty(t, remoteAddrs[0])
+}
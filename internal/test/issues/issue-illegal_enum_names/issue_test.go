@@ -39,7 +39,10 @@ func TestIllegalEnumNames(t *testing.T) {
 				for _, s := range decl.Specs {
 					switch spec := s.(type) {
 					case *ast.ValueSpec:
-						constDefs[spec.Names[0].Name] = spec.Names[0].Obj.Decl.(*ast.ValueSpec).Values[0].(*ast.BasicLit).Value
+						// Other constants, like HasDefaultResponse, aren't string literals
+						if lit, ok := spec.Values[0].(*ast.BasicLit); ok {
+							constDefs[spec.Names[0].Name] = lit.Value
+						}
 					}
 				}
 			}
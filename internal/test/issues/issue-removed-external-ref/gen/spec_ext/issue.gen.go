@@ -5,6 +5,7 @@ package spec_ext
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -173,6 +174,11 @@ type StrictHTTPServerOptions struct {
 func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
 	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
 		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			var contentTypeErr *UnsupportedContentTypeError
+			if errors.As(err, &contentTypeErr) {
+				http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+				return
+			}
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		},
 		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
@@ -185,6 +191,16 @@ func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []Strict
 	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
+// UnsupportedContentTypeError is passed to the RequestErrorHandlerFunc when the
+// Content-Type of a request body matches none of those of its operation.
+type UnsupportedContentTypeError struct {
+	ContentType string
+}
+
+func (e *UnsupportedContentTypeError) Error() string {
+	return fmt.Sprintf("unsupported content type %q", e.ContentType)
+}
+
 type strictHandler struct {
 	ssi         StrictServerInterface
 	middlewares []StrictMiddlewareFunc
@@ -0,0 +1,67 @@
+package jsonnumber
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct {
+	received *Measurement
+}
+
+func (s *server) EchoMeasurement(ctx context.Context, request EchoMeasurementRequestObject) (EchoMeasurementResponseObject, error) {
+	s.received = request.Body
+	return EchoMeasurement200JSONResponse(*request.Body), nil
+}
+
+func TestJSONNumber(t *testing.T) {
+	s := &server{}
+	ts := httptest.NewServer(Handler(NewStrictHandler(s, nil)))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	// 20 significant digits, more than a float64 holds
+	const precise = "12345678901234567890.5"
+	count := json.Number("99999999999999999999")
+	readings := []json.Number{precise, "0.10000000000000000001"}
+	extra := map[string]interface{}{"total": json.Number(precise)}
+	scale := N05
+	unit := N15
+	measurement := Measurement{
+		Value:    precise,
+		Count:    &count,
+		Readings: &readings,
+		Extra:    &extra,
+		Scale:    &scale,
+		Unit:     &unit,
+	}
+	measurement.Nested = &struct {
+		Value *json.Number `json:"value,omitempty"`
+	}{Value: &readings[1]}
+
+	rsp, err := client.EchoMeasurementWithResponse(context.Background(), measurement)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rsp.StatusCode())
+
+	// Both the strict server and the client keep every digit, including in
+	// nested objects, arrays and additional properties
+	for _, got := range []*Measurement{s.received, rsp.JSON200} {
+		require.NotNil(t, got)
+		assert.Equal(t, json.Number(precise), got.Value)
+		assert.Equal(t, count, *got.Count)
+		assert.Equal(t, readings, *got.Readings)
+		assert.Equal(t, readings[1], *got.Nested.Value)
+		assert.Equal(t, json.Number(precise), (*got.Extra)["total"])
+		// Enums and consts keep float types
+		assert.Equal(t, N05, *got.Scale)
+		assert.Equal(t, N15, *got.Unit)
+	}
+}
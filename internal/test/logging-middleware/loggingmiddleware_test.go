@@ -0,0 +1,59 @@
+package loggingmiddleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (server) GetPet(w http.ResponseWriter, r *http.Request, id int) {
+	if id != 1 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write([]byte("Tom"))
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	r := chi.NewRouter()
+	r.Use(LoggingMiddleware(logger))
+	handler := HandlerFromMux(server{}, r)
+
+	serve := func(path string) map[string]interface{} {
+		logs.Reset()
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+		assert.Equal(t, "request", entry["msg"])
+		assert.Equal(t, "GET", entry["method"])
+		assert.Equal(t, path, entry["path"])
+		assert.GreaterOrEqual(t, entry["latency"], float64(0))
+		assert.Less(t, entry["latency"], float64(time.Minute))
+		return entry
+	}
+
+	entry := serve("/pets/1")
+	assert.Equal(t, "GetPet", entry["operation_id"])
+	assert.Equal(t, float64(http.StatusOK), entry["status"])
+
+	entry = serve("/pets/2")
+	assert.Equal(t, "GetPet", entry["operation_id"])
+	assert.Equal(t, float64(http.StatusNotFound), entry["status"])
+
+	// Requests which aren't routed to an operation have no operation ID
+	entry = serve("/owners")
+	assert.NotContains(t, entry, "operation_id")
+	assert.Equal(t, float64(http.StatusNotFound), entry["status"])
+}
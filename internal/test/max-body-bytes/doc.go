@@ -0,0 +1,6 @@
+package maxbodybytes
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --config=config.yaml spec.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --config=strict-config.yaml spec.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --config=strict-echo-config.yaml spec.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --config=strict-gin-config.yaml spec.yaml
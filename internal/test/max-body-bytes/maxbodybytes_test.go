@@ -0,0 +1,68 @@
+package maxbodybytes
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// server reads the bodies of requests, answering 400 when it can't.
+type server struct{}
+
+func (server) AddNote(w http.ResponseWriter, r *http.Request) {
+	readBody(w, r)
+}
+
+func (server) AddTag(w http.ResponseWriter, r *http.Request) {
+	readBody(w, r)
+}
+
+func (server) Upload(w http.ResponseWriter, r *http.Request) {
+	readBody(w, r)
+}
+
+func readBody(w http.ResponseWriter, r *http.Request) {
+	if _, err := io.ReadAll(r.Body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestMaxBodyBytes(t *testing.T) {
+	handler := Handler(server{})
+	post := func(path string, body io.Reader) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, body))
+		return rec
+	}
+
+	// The default limit
+	assert.Equal(t, http.StatusNoContent, post("/notes", strings.NewReader(strings.Repeat("a", 16))).Code)
+	rec := post("/notes", strings.NewReader(strings.Repeat("a", 17)))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	assert.Equal(t, "http: request body too large\n", rec.Body.String())
+
+	// An operation's own limit
+	assert.Equal(t, http.StatusNoContent, post("/uploads", strings.NewReader(strings.Repeat("a", 64))).Code)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, post("/uploads", strings.NewReader(strings.Repeat("a", 65))).Code)
+
+	// Bodies which are too large go to the ErrorHandlerFunc
+	var handled error
+	handler = HandlerWithOptions(server{}, ChiServerOptions{ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+		handled = err
+		w.WriteHeader(http.StatusTeapot)
+	}})
+	assert.Equal(t, http.StatusTeapot, post("/notes", strings.NewReader(strings.Repeat("a", 17))).Code)
+	assert.Equal(t, &http.MaxBytesError{Limit: 16}, handled)
+	handler = Handler(server{})
+
+	// Bodies of unknown length can't be read past the limit
+	rec = post("/notes", io.MultiReader(strings.NewReader(strings.Repeat("a", 17))))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "request body too large")
+}
@@ -0,0 +1,51 @@
+package strictecho
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type server struct{}
+
+func (server) AddNote(ctx context.Context, request AddNoteRequestObject) (AddNoteResponseObject, error) {
+	return AddNote204Response{}, nil
+}
+
+func (server) AddTag(ctx context.Context, request AddTagRequestObject) (AddTagResponseObject, error) {
+	return AddTag204Response{}, nil
+}
+
+func (server) Upload(ctx context.Context, request UploadRequestObject) (UploadResponseObject, error) {
+	return Upload204Response{}, nil
+}
+
+func TestMaxBodyBytes(t *testing.T) {
+	e := echo.New()
+	RegisterHandlers(e, NewStrictHandler(server{}, nil))
+	post := func(path, contentType string, body io.Reader) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, path, body)
+		req.Header.Set("Content-Type", contentType)
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusNoContent, post("/notes", "text/plain", strings.NewReader(strings.Repeat("a", 16))).Code)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, post("/notes", "text/plain", strings.NewReader(strings.Repeat("a", 17))).Code)
+
+	// Bodies of unknown length fail to decode once they pass the limit
+	rec := post("/notes", "text/plain", io.MultiReader(strings.NewReader(strings.Repeat("a", 17))))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	assert.Contains(t, rec.Body.String(), "request body too large")
+
+	// JSON bodies too
+	json := `{"name":"` + strings.Repeat("a", 16) + `"}`
+	assert.Equal(t, http.StatusRequestEntityTooLarge, post("/tags", "application/json", io.MultiReader(strings.NewReader(json))).Code)
+}
@@ -0,0 +1,49 @@
+package strictgin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type server struct{}
+
+func (server) AddNote(ctx context.Context, request AddNoteRequestObject) (AddNoteResponseObject, error) {
+	return AddNote204Response{}, nil
+}
+
+func (server) AddTag(ctx context.Context, request AddTagRequestObject) (AddTagResponseObject, error) {
+	return AddTag204Response{}, nil
+}
+
+func (server) Upload(ctx context.Context, request UploadRequestObject) (UploadResponseObject, error) {
+	return Upload204Response{}, nil
+}
+
+func TestMaxBodyBytes(t *testing.T) {
+	router := gin.New()
+	RegisterHandlers(router, NewStrictHandler(server{}, nil))
+	post := func(path, contentType string, body io.Reader) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, path, body)
+		req.Header.Set("Content-Type", contentType)
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusNoContent, post("/notes", "text/plain", strings.NewReader(strings.Repeat("a", 16))).Code)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, post("/notes", "text/plain", strings.NewReader(strings.Repeat("a", 17))).Code)
+
+	// Bodies of unknown length fail to decode once they pass the limit
+	assert.Equal(t, http.StatusRequestEntityTooLarge, post("/notes", "text/plain", io.MultiReader(strings.NewReader(strings.Repeat("a", 17)))).Code)
+
+	// JSON bodies too
+	json := `{"name":"` + strings.Repeat("a", 16) + `"}`
+	assert.Equal(t, http.StatusRequestEntityTooLarge, post("/tags", "application/json", io.MultiReader(strings.NewReader(json))).Code)
+}
@@ -0,0 +1,45 @@
+package mockserver
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/deepmap/oapi-codegen/pkg/testutil"
+)
+
+func TestMockServer(t *testing.T) {
+	r := chi.NewRouter()
+	RegisterMockHandlers(r)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		statusCode  int
+		contentType string
+		body        string
+	}{
+		{"example", http.MethodGet, "/pets", http.StatusOK, "application/json", `[{"id":1,"name":"Rex"}]`},
+		{"first named example", http.MethodGet, "/pets/2", http.StatusOK, "application/json", `{"id":2,"name":"Fido"}`},
+		{"zero value of lowest 2xx", http.MethodPost, "/pets", http.StatusCreated, "application/json", `{"id":0,"name":""}`},
+		{"no content", http.MethodDelete, "/pets/2", http.StatusNoContent, "", ""},
+		{"schema example of 2XX", http.MethodGet, "/owner", http.StatusOK, "application/json", `{"name":"Alice"}`},
+		{"text example", http.MethodGet, "/report", http.StatusOK, "text/plain", "all good"},
+		{"no 2xx response", http.MethodGet, "/unimplemented", http.StatusNotImplemented, "", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := testutil.NewRequest().WithMethod(tc.method, tc.path).GoWithHTTPHandler(t, r).Recorder
+			assert.Equal(t, tc.statusCode, rr.Code)
+			assert.Equal(t, tc.contentType, rr.Header().Get("Content-Type"))
+			assert.Equal(t, tc.body, rr.Body.String())
+		})
+	}
+
+	// Parameters are still parsed
+	rr := testutil.NewRequest().Get("/pets/two").GoWithHTTPHandler(t, r).Recorder
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
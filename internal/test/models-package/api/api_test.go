@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/internal/test/models-package/models"
+)
+
+type server struct {
+	pets []models.Pet
+}
+
+func (s *server) ListPets(ctx context.Context, request ListPetsRequestObject) (ListPetsResponseObject, error) {
+	pets := []models.Pet{}
+	for _, pet := range s.pets {
+		if request.Params.Kind == nil || pet.Kind == *request.Params.Kind {
+			pets = append(pets, pet)
+		}
+	}
+	return ListPets200JSONResponse(pets), nil
+}
+
+func (s *server) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	if request.Body.Name == "" {
+		return AddPetdefaultJSONResponse{Body: models.Problem{Message: "a pet needs a name"}, StatusCode: http.StatusBadRequest}, nil
+	}
+	pet := models.Pet{Id: int64(len(s.pets) + 1), Kind: request.Body.Kind, Name: request.Body.Name}
+	s.pets = append(s.pets, pet)
+	return AddPet201JSONResponse(pet), nil
+}
+
+func TestModelsPackage(t *testing.T) {
+	ts := httptest.NewServer(Handler(NewStrictHandler(&server{}, nil)))
+	defer ts.Close()
+	ctx := context.Background()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	added, err := client.AddPetWithResponse(ctx, models.AddPetJSONRequestBody{Kind: models.Cat, Name: "Tom"})
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON201)
+	assert.Equal(t, models.Pet{Id: 1, Kind: models.Cat, Name: "Tom"}, *added.JSON201)
+
+	_, err = client.AddPetWithResponse(ctx, models.AddPetJSONRequestBody{Kind: models.Dog, Name: "Spike"})
+	require.NoError(t, err)
+
+	failed, err := client.AddPetWithResponse(ctx, models.AddPetJSONRequestBody{Kind: models.Dog})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, failed.StatusCode())
+	require.NotNil(t, failed.JSONDefault)
+	assert.Equal(t, "a pet needs a name", failed.JSONDefault.Message)
+
+	kind := models.Dog
+	listed, err := client.ListPetsWithResponse(ctx, &models.ListPetsParams{Kind: &kind})
+	require.NoError(t, err)
+	require.NotNil(t, listed.JSON200)
+	assert.Equal(t, []models.Pet{{Id: 2, Kind: models.Dog, Name: "Spike"}}, *listed.JSON200)
+}
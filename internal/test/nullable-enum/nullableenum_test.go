@@ -0,0 +1,49 @@
+package nullableenum
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullableEnum(t *testing.T) {
+	var body []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL)
+	require.NoError(t, err)
+
+	// A nil enum is sent as null, as the required properties are nullable
+	coats := Two
+	rsp, err := client.Paint(context.Background(), PaintJSONRequestBody{Coats: &coats})
+	require.NoError(t, err)
+	require.NoError(t, rsp.Body.Close())
+	assert.JSONEq(t, `{"color": null, "coats": 2, "finish": null}`, string(body))
+
+	var request PaintRequest
+	require.NoError(t, json.Unmarshal([]byte(`{"color": "green", "coats": null, "finish": null}`), &request))
+	require.NotNil(t, request.Color)
+	assert.Equal(t, Green, *request.Color)
+	assert.Nil(t, request.Coats)
+	assert.Nil(t, request.Finish)
+
+	// null isn't one of the constants
+	color, err := ParseColor("red")
+	require.NoError(t, err)
+	assert.Equal(t, Red, color)
+	_, err = ParseColor("<nil>")
+	assert.EqualError(t, err, `invalid Color value "<nil>"`)
+	_, err = ParsePaintRequestCoats("Unpainted")
+	assert.EqualError(t, err, `invalid PaintRequestCoats value "Unpainted"`)
+	assert.Equal(t, "Two", coats.String())
+}
@@ -0,0 +1,105 @@
+package nullable
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullableRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch PetPatch
+		json  string
+	}{
+		{
+			name:  "unset",
+			patch: PetPatch{Id: 1},
+			json:  `{"id":1}`,
+		},
+		{
+			name:  "null",
+			patch: PetPatch{Id: 1, Name: NewNullNullable[string](), Tags: NewNullNullable[[]string]()},
+			json:  `{"id":1,"name":null,"tags":null}`,
+		},
+		{
+			name:  "value",
+			patch: PetPatch{Id: 1, Name: NewNullable("Spot"), Owner: NewNullable(Owner{})},
+			json:  `{"id":1,"name":"Spot","owner":{}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.patch)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(b))
+
+			var patch PetPatch
+			require.NoError(t, json.Unmarshal(b, &patch))
+			assert.Equal(t, tt.patch, patch)
+		})
+	}
+
+	var patch PetPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"id":1,"name":null}`), &patch))
+	_, ok := patch.Name.Get()
+	assert.False(t, ok)
+	assert.True(t, patch.Name.Set)
+	assert.True(t, patch.Name.Null)
+}
+
+func TestNullableWithAdditionalProperties(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		patch PetPatchWithExtras
+		json  string
+	}{
+		{"unset", PetPatchWithExtras{}, `{}`},
+		{"null", PetPatchWithExtras{Name: NewNullNullable[string]()}, `{"name":null}`},
+		{"value", PetPatchWithExtras{Name: NewNullable("Spot"), AdditionalProperties: map[string]string{"color": "brown"}}, `{"name":"Spot","color":"brown"}`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.patch)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(b))
+
+			var patch PetPatchWithExtras
+			require.NoError(t, json.Unmarshal(b, &patch))
+			assert.Equal(t, tt.patch, patch)
+		})
+	}
+}
+
+func TestNullableInUnions(t *testing.T) {
+	meows := true
+	for _, tt := range []struct {
+		name string
+		cat  Cat
+		json string
+		set  Nullable[string]
+	}{
+		{"unset", Cat{Meows: &meows}, `{"meows":true}`, Nullable[string]{}},
+		{"null", Cat{Meows: &meows}, `{"meows":true,"name":null}`, NewNullNullable[string]()},
+		{"value", Cat{Meows: &meows}, `{"meows":true,"name":"Tom"}`, NewNullable("Tom")},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			patch := PetPatchUnion{Name: tt.set}
+			require.NoError(t, patch.FromCat(tt.cat))
+			b, err := json.Marshal(patch)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(b))
+
+			var decoded PetPatchUnion
+			require.NoError(t, json.Unmarshal(b, &decoded))
+			assert.Equal(t, tt.set, decoded.Name)
+
+			extras := PetPatchUnionWithExtras{Name: tt.set}
+			require.NoError(t, extras.FromCat(tt.cat))
+			b, err = json.Marshal(extras)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(b))
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package optionalbody
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (s *server) CreateThing(ctx context.Context, request CreateThingRequestObject) (CreateThingResponseObject, error) {
+	if request.Body == nil {
+		return CreateThing200JSONResponse{Name: "default"}, nil
+	}
+	return CreateThing200JSONResponse(*request.Body), nil
+}
+
+func TestOptionalBody(t *testing.T) {
+	var contentType string
+	handler := Handler(NewStrictHandler(&server{}, nil))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		handler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	t.Run("with a body", func(t *testing.T) {
+		rsp, err := client.CreateThingWithResponse(context.Background(), &CreateThingJSONRequestBody{Name: "given"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rsp.StatusCode())
+		assert.Equal(t, "given", rsp.JSON200.Name)
+		assert.Equal(t, "application/json", contentType)
+	})
+
+	t.Run("without a body", func(t *testing.T) {
+		rsp, err := client.CreateThingWithResponse(context.Background(), nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rsp.StatusCode())
+		assert.Equal(t, "default", rsp.JSON200.Name)
+		assert.Empty(t, contentType)
+	})
+}
@@ -0,0 +1,132 @@
+package paginators
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var users = []string{"alex", "bo", "cy", "di", "ed"}
+
+type server struct {
+	requests int
+}
+
+func (s *server) ListUsers(ctx context.Context, request ListUsersRequestObject) (ListUsersResponseObject, error) {
+	s.requests++
+	start := 0
+	if request.Params.Cursor != nil {
+		var err error
+		if start, err = strconv.Atoi(*request.Params.Cursor); err != nil {
+			return ListUsersdefaultJSONResponse{Body: Error{Message: "bad cursor"}, StatusCode: http.StatusBadRequest}, nil
+		}
+	}
+	end := start + 2
+	if end >= len(users) {
+		return ListUsers200JSONResponse{Users: users[start:]}, nil
+	}
+	next := strconv.Itoa(end)
+	return ListUsers200JSONResponse{Users: users[start:end], NextCursor: &next}, nil
+}
+
+func (s *server) ListMembers(ctx context.Context, request ListMembersRequestObject) (ListMembersResponseObject, error) {
+	s.requests++
+	page := request.Params.Page
+	if page == 0 {
+		page = 1
+	}
+	rsp := ListMembers200JSONResponse{Members: []string{request.Team + strconv.Itoa(page)}}
+	if page < 3 {
+		rsp.NextPage = page + 1
+	}
+	return rsp, nil
+}
+
+func (s *server) ListEvents(ctx context.Context, request ListEventsRequestObject) (ListEventsResponseObject, error) {
+	s.requests++
+	start := 0
+	if request.Params.Token != nil {
+		start, _ = strconv.Atoi(string(request.Params.Token))
+	}
+	rsp := ListEvents200JSONResponse{Events: []string{"event" + strconv.Itoa(start)}}
+	if start < 2 {
+		rsp.NextToken = NewNullable([]byte(strconv.Itoa(start + 1)))
+	} else {
+		rsp.NextToken = NewNullNullable[[]byte]()
+	}
+	return rsp, nil
+}
+
+func (s *server) ListTeams(ctx context.Context, request ListTeamsRequestObject) (ListTeamsResponseObject, error) {
+	return ListTeams200JSONResponse{}, nil
+}
+
+func newClient(t *testing.T, s *server) *ClientWithResponses {
+	client, err := NewInProcessClient(Handler(NewStrictHandler(s, nil)))
+	require.NoError(t, err)
+	return client
+}
+
+func TestPaginator(t *testing.T) {
+	s := &server{}
+	client := newClient(t, s)
+	ctx := context.Background()
+
+	var fetched []string
+	paginator := client.ListUsersPaginator(nil)
+	for paginator.Next(ctx) {
+		fetched = append(fetched, paginator.Page().JSON200.Users...)
+	}
+	require.NoError(t, paginator.Err())
+	assert.Equal(t, users, fetched)
+	assert.Equal(t, 3, s.requests)
+
+	// Next keeps returning false once the pages have run out
+	assert.False(t, paginator.Next(ctx))
+	assert.Equal(t, 3, s.requests)
+
+	// Paginators start with the page which their parameters ask for
+	cursor := "2"
+	paginator = client.ListUsersPaginator(&ListUsersParams{Cursor: &cursor})
+	require.True(t, paginator.Next(ctx))
+	assert.Equal(t, []string{"cy", "di"}, paginator.Page().JSON200.Users)
+
+	// Required cursors and path parameters work too
+	fetched = nil
+	members := client.ListMembersPaginator("red", nil)
+	for members.Next(ctx) {
+		fetched = append(fetched, members.Page().JSON200.Members...)
+	}
+	require.NoError(t, members.Err())
+	assert.Equal(t, []string{"red1", "red2", "red3"}, fetched)
+
+	// Byte cursors end when the next one is null
+	fetched = nil
+	events := client.ListEventsPaginator(nil)
+	for events.Next(ctx) {
+		fetched = append(fetched, events.Page().JSON200.Events...)
+	}
+	require.NoError(t, events.Err())
+	assert.Equal(t, []string{"event0", "event1", "event2"}, fetched)
+}
+
+func TestPaginatorErrors(t *testing.T) {
+	s := &server{}
+	client := newClient(t, s)
+
+	cursor := "x"
+	paginator := client.ListUsersPaginator(&ListUsersParams{Cursor: &cursor})
+	assert.False(t, paginator.Next(context.Background()))
+	assert.EqualError(t, paginator.Err(), "unexpected response to ListUsers: 400 Bad Request")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	paginator = client.ListUsersPaginator(nil)
+	assert.False(t, paginator.Next(ctx))
+	assert.ErrorIs(t, paginator.Err(), context.Canceled)
+	assert.Equal(t, 1, s.requests)
+}
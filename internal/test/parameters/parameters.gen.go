@@ -7,14 +7,18 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
@@ -27,6 +31,12 @@ const (
 	N200 EnumParamsParamsEnumPathParam = 200
 )
 
+// Defines values for EnumParamsParamsEnumStringParam.
+const (
+	Green EnumParamsParamsEnumStringParam = "green"
+	Red   EnumParamsParamsEnumStringParam = "red"
+)
+
 // ComplexObject defines model for ComplexObject.
 type ComplexObject struct {
 	Id      int    `json:"Id"`
@@ -71,11 +81,17 @@ type GetCookieParams struct {
 type EnumParamsParams struct {
 	// EnumPathParam Parameter with enum values
 	EnumPathParam *EnumParamsParamsEnumPathParam `form:"enumPathParam,omitempty" json:"enumPathParam,omitempty"`
+
+	// EnumStringParam String parameter with enum values
+	EnumStringParam *EnumParamsParamsEnumStringParam `form:"enumStringParam,omitempty" json:"enumStringParam,omitempty"`
 }
 
 // EnumParamsParamsEnumPathParam defines parameters for EnumParams.
 type EnumParamsParamsEnumPathParam int32
 
+// EnumParamsParamsEnumStringParam defines parameters for EnumParams.
+type EnumParamsParamsEnumStringParam string
+
 // GetHeaderParams defines parameters for GetHeader.
 type GetHeaderParams struct {
 	// XPrimitive primitive
@@ -103,12 +119,33 @@ type GetHeaderParams struct {
 	N1StartingWithNumber *string `json:"1-Starting-With-Number,omitempty"`
 }
 
+// GetQueryContentParams defines parameters for GetQueryContent.
+type GetQueryContentParams struct {
+	// Vco complex object with a vendor JSON media type
+	Vco ComplexObject `form:"vco" json:"vco"`
+
+	// Pt passed through as is, since it has several media types
+	Pt *string `form:"pt,omitempty" json:"pt,omitempty"`
+}
+
 // GetDeepObjectParams defines parameters for GetDeepObject.
 type GetDeepObjectParams struct {
 	// DeepObj deep object
 	DeepObj ComplexObject `json:"deepObj"`
 }
 
+// GetDeepObjectOptionalParams defines parameters for GetDeepObjectOptional.
+type GetDeepObjectOptionalParams struct {
+	Filter struct {
+		Limit  *int    `json:"limit,omitempty"`
+		Name   *string `json:"name,omitempty"`
+		Nested *struct {
+			Label *string  `json:"label,omitempty"`
+			Size  *float32 `json:"size,omitempty"`
+		} `json:"nested,omitempty"`
+	} `json:"filter"`
+}
+
 // GetQueryFormParams defines parameters for GetQueryForm.
 type GetQueryFormParams struct {
 	// Ea exploded array
@@ -139,9 +176,37 @@ type GetQueryFormParams struct {
 	N1s *string `form:"1s,omitempty" json:"1s,omitempty"`
 }
 
+// GetRequiredCookieParams defines parameters for GetRequiredCookie.
+type GetRequiredCookieParams struct {
+	// Ra required array
+	Ra []int32 `form:"ra" json:"ra"`
+
+	// Rp required primitive
+	Rp string `form:"rp" json:"rp"`
+
+	// Op optional primitive
+	Op *int32 `form:"op,omitempty" json:"op,omitempty"`
+}
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which is called with every response received, whatever its status.
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// operationIDContextKey is the key of the ID of the operation being called in
+// the contexts which the client passes to request and response editors.
+type operationIDContextKey struct{}
+
+// OperationID returns the ID of the operation being called, as generated from
+// its operationId, from the context passed to request and response editors, so
+// that they can act differently depending on the operation.
+func OperationID(ctx context.Context) (string, bool) {
+	operationID, ok := ctx.Value(operationIDContextKey{}).(string)
+	return operationID, ok
+}
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -164,6 +229,28 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// A list of callbacks for inspecting or modifying responses, which are
+	// called as soon as they're received. If one returns an error, the
+	// response body is closed and the error is returned instead of the response.
+	ResponseEditors []ResponseEditorFn
+
+	// The policy for retrying failed requests, set by WithRetry.
+	retryPolicy *runtime.RetryPolicy
+
+	// The TLS configuration and proxy of the default http.Client, set by
+	// WithTLSConfig and WithProxy.
+	tlsConfig *tls.Config
+	proxy     func(*http.Request) (*url.URL, error)
+
+	// The transport of the default http.Client, set by WithRoundTripper, and
+	// the functions wrapping it, set by WithTransportMiddleware.
+	roundTripper         http.RoundTripper
+	transportMiddlewares []func(http.RoundTripper) http.RoundTripper
+
+	// Whether responses keep the request which was sent, set by
+	// WithCaptureRequest.
+	captureRequest bool
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -188,6 +275,37 @@ func NewClient(server string, opts ...ClientOption) (*Client, error) {
 	// create httpClient, if not already present
 	if client.Client == nil {
 		client.Client = &http.Client{}
+		if client.tlsConfig != nil || client.proxy != nil || client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+			var transport http.RoundTripper = http.DefaultTransport
+			if client.roundTripper != nil {
+				transport = client.roundTripper
+			}
+			if client.tlsConfig != nil || client.proxy != nil {
+				base, ok := transport.(*http.Transport)
+				if !ok {
+					return nil, errors.New("WithTLSConfig and WithProxy can only be combined with a WithRoundTripper of an *http.Transport")
+				}
+				t := base.Clone()
+				if client.tlsConfig != nil {
+					t.TLSClientConfig = client.tlsConfig
+				}
+				if client.proxy != nil {
+					t.Proxy = client.proxy
+				}
+				transport = t
+			}
+			for _, wrap := range client.transportMiddlewares {
+				transport = wrap(transport)
+			}
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.tlsConfig != nil || client.proxy != nil {
+		return nil, errors.New("WithTLSConfig and WithProxy only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	} else if client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+		return nil, errors.New("WithRoundTripper and WithTransportMiddleware only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	}
+	if client.retryPolicy != nil {
+		client.Client = runtime.NewRetryDoer(client.Client, *client.retryPolicy)
 	}
 	return &client, nil
 }
@@ -201,6 +319,77 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	}
 }
 
+// WithTLSConfig sets the TLS configuration, such as the certificates to
+// trust or present, of the http.Client which the client creates. It can't be
+// combined with WithHTTPClient, whose Doer should be configured instead.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		c.tlsConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function which chooses the proxy for each request made
+// by the http.Client which the client creates, such as http.ProxyURL. It
+// can't be combined with WithHTTPClient, whose Doer should be configured
+// instead.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *Client) error {
+		c.proxy = proxy
+		return nil
+	}
+}
+
+// WithRoundTripper sets the transport of the http.Client which the client
+// creates, in place of http.DefaultTransport. When it's an *http.Transport,
+// the TLS configuration and proxy set by WithTLSConfig and WithProxy apply to
+// a copy of it. It can't be combined with WithHTTPClient, whose Doer should
+// be configured instead.
+func WithRoundTripper(roundTripper http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		c.roundTripper = roundTripper
+		return nil
+	}
+}
+
+// WithTransportMiddleware wraps the transport of the http.Client which the
+// client creates, including any TLS configuration and proxy set by
+// WithTLSConfig and WithProxy, with the http.RoundTripper which wrap returns,
+// such as one which logs or traces requests. Each call wraps the transport of
+// the previous ones. It can't be combined with WithHTTPClient, whose Doer
+// should be configured instead.
+func WithTransportMiddleware(wrap func(next http.RoundTripper) http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		c.transportMiddlewares = append(c.transportMiddlewares, wrap)
+		return nil
+	}
+}
+
+// WithRetry makes the client retry requests which fail with a network error
+// or a retryable status code, with exponential backoff which honors any
+// Retry-After header. Unless the policy says otherwise, only GET, PUT, DELETE
+// and HEAD requests are retried, on 502, 503 and 504 responses. The retries
+// wrap whichever Doer the client ends up with, including one set by
+// WithHTTPClient.
+func WithRetry(policy runtime.RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithCaptureRequest makes the client keep a copy of the request which each
+// response answers, after any redirects, as its Request, and so as the
+// HTTPRequest of the responses of the WithResponse methods, for debugging
+// retries. The copy has the URL and headers of the request, but not its body,
+// so that the body can be garbage collected.
+func WithCaptureRequest(capture bool) ClientOption {
+	return func(c *Client) error {
+		c.captureRequest = capture
+		return nil
+	}
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -210,6 +399,16 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called right after receiving a response, before it's parsed. This can be
+// used for metrics, or to turn error responses into errors.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// GetContentObject request
@@ -236,6 +435,9 @@ type ClientInterface interface {
 	// GetLabelNoExplodeObject request
 	GetLabelNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLabelPrimitive request
+	GetLabelPrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetMatrixExplodeArray request
 	GetMatrixExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -248,15 +450,27 @@ type ClientInterface interface {
 	// GetMatrixNoExplodeObject request
 	GetMatrixNoExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetMatrixPrimitive request
+	GetMatrixPrimitive(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetPassThrough request
 	GetPassThrough(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetQueryContent request
+	GetQueryContent(ctx context.Context, params *GetQueryContentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDeepObject request
 	GetDeepObject(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDeepObjectOptional request
+	GetDeepObjectOptional(ctx context.Context, params *GetDeepObjectOptionalParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetQueryForm request
 	GetQueryForm(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetRequiredCookie request
+	GetRequiredCookie(ctx context.Context, params *GetRequiredCookieParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSimpleExplodeArray request
 	GetSimpleExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -281,11 +495,12 @@ func (c *Client) GetContentObject(ctx context.Context, param ComplexObject, reqE
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetContentObject")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetCookie(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -293,11 +508,12 @@ func (c *Client) GetCookie(ctx context.Context, params *GetCookieParams, reqEdit
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetCookie")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) EnumParams(ctx context.Context, params *EnumParamsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -305,11 +521,12 @@ func (c *Client) EnumParams(ctx context.Context, params *EnumParamsParams, reqEd
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "EnumParams")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetHeader(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -317,11 +534,12 @@ func (c *Client) GetHeader(ctx context.Context, params *GetHeaderParams, reqEdit
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetHeader")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetLabelExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -329,11 +547,12 @@ func (c *Client) GetLabelExplodeArray(ctx context.Context, param []int32, reqEdi
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetLabelExplodeArray")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetLabelExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -341,11 +560,12 @@ func (c *Client) GetLabelExplodeObject(ctx context.Context, param Object, reqEdi
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetLabelExplodeObject")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetLabelNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -353,11 +573,12 @@ func (c *Client) GetLabelNoExplodeArray(ctx context.Context, param []int32, reqE
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetLabelNoExplodeArray")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetLabelNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -365,11 +586,25 @@ func (c *Client) GetLabelNoExplodeObject(ctx context.Context, param Object, reqE
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetLabelNoExplodeObject")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) GetLabelPrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLabelPrimitiveRequest(c.Server, param)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetLabelPrimitive")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetMatrixExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -377,11 +612,12 @@ func (c *Client) GetMatrixExplodeArray(ctx context.Context, id []int32, reqEdito
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetMatrixExplodeArray")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetMatrixExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -389,11 +625,12 @@ func (c *Client) GetMatrixExplodeObject(ctx context.Context, id Object, reqEdito
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetMatrixExplodeObject")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetMatrixNoExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -401,11 +638,12 @@ func (c *Client) GetMatrixNoExplodeArray(ctx context.Context, id []int32, reqEdi
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetMatrixNoExplodeArray")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetMatrixNoExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -413,11 +651,25 @@ func (c *Client) GetMatrixNoExplodeObject(ctx context.Context, id Object, reqEdi
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetMatrixNoExplodeObject")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
+}
+
+func (c *Client) GetMatrixPrimitive(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMatrixPrimitiveRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetMatrixPrimitive")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetPassThrough(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -425,11 +677,25 @@ func (c *Client) GetPassThrough(ctx context.Context, param string, reqEditors ..
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetPassThrough")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) GetQueryContent(ctx context.Context, params *GetQueryContentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetQueryContentRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetQueryContent")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetDeepObject(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -437,11 +703,25 @@ func (c *Client) GetDeepObject(ctx context.Context, params *GetDeepObjectParams,
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetDeepObject")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) GetDeepObjectOptional(ctx context.Context, params *GetDeepObjectOptionalParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeepObjectOptionalRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetDeepObjectOptional")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetQueryForm(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -449,11 +729,25 @@ func (c *Client) GetQueryForm(ctx context.Context, params *GetQueryFormParams, r
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetQueryForm")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) GetRequiredCookie(ctx context.Context, params *GetRequiredCookieParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRequiredCookieRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetRequiredCookie")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetSimpleExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -461,11 +755,12 @@ func (c *Client) GetSimpleExplodeArray(ctx context.Context, param []int32, reqEd
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetSimpleExplodeArray")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetSimpleExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -473,11 +768,12 @@ func (c *Client) GetSimpleExplodeObject(ctx context.Context, param Object, reqEd
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetSimpleExplodeObject")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetSimpleNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -485,11 +781,12 @@ func (c *Client) GetSimpleNoExplodeArray(ctx context.Context, param []int32, req
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetSimpleNoExplodeArray")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetSimpleNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -497,11 +794,12 @@ func (c *Client) GetSimpleNoExplodeObject(ctx context.Context, param Object, req
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetSimpleNoExplodeObject")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetSimplePrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -509,11 +807,12 @@ func (c *Client) GetSimplePrimitive(ctx context.Context, param int32, reqEditors
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetSimplePrimitive")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetStartingWithNumber(ctx context.Context, n1param string, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -521,11 +820,12 @@ func (c *Client) GetStartingWithNumber(ctx context.Context, n1param string, reqE
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetStartingWithNumber")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 // NewGetContentObjectRequest generates requests for GetContentObject
@@ -750,6 +1050,22 @@ func NewEnumParamsRequest(server string, params *EnumParamsParams) (*http.Reques
 
 	}
 
+	if params.EnumStringParam != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "enumStringParam", runtime.ParamLocationQuery, *params.EnumStringParam); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
 	queryURL.RawQuery = queryValues.Encode()
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -1013,6 +1329,40 @@ func NewGetLabelNoExplodeObjectRequest(server string, param Object) (*http.Reque
 	return req, nil
 }
 
+// NewGetLabelPrimitiveRequest generates requests for GetLabelPrimitive
+func NewGetLabelPrimitiveRequest(server string, param int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("label", false, "param", runtime.ParamLocationPath, param)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/labelPrimitive/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetMatrixExplodeArrayRequest generates requests for GetMatrixExplodeArray
 func NewGetMatrixExplodeArrayRequest(server string, id []int32) (*http.Request, error) {
 	var err error
@@ -1149,6 +1499,40 @@ func NewGetMatrixNoExplodeObjectRequest(server string, id Object) (*http.Request
 	return req, nil
 }
 
+// NewGetMatrixPrimitiveRequest generates requests for GetMatrixPrimitive
+func NewGetMatrixPrimitiveRequest(server string, id int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("matrix", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/matrixPrimitive/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetPassThroughRequest generates requests for GetPassThrough
 func NewGetPassThroughRequest(server string, param string) (*http.Request, error) {
 	var err error
@@ -1180,8 +1564,8 @@ func NewGetPassThroughRequest(server string, param string) (*http.Request, error
 	return req, nil
 }
 
-// NewGetDeepObjectRequest generates requests for GetDeepObject
-func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.Request, error) {
+// NewGetQueryContentRequest generates requests for GetQueryContent
+func NewGetQueryContentRequest(server string, params *GetQueryContentParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1189,7 +1573,7 @@ func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/queryDeepObject")
+	operationPath := fmt.Sprintf("/queryContent")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1201,16 +1585,16 @@ func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.
 
 	queryValues := queryURL.Query()
 
-	if queryFrag, err := runtime.StyleParamWithLocation("deepObject", true, "deepObj", runtime.ParamLocationQuery, params.DeepObj); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	if queryParamBuf, err := json.Marshal(params.Vco); err != nil {
 		return nil, err
 	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
+		queryValues.Add("vco", string(queryParamBuf))
+	}
+
+	if params.Pt != nil {
+
+		queryValues.Add("pt", *params.Pt)
+
 	}
 
 	queryURL.RawQuery = queryValues.Encode()
@@ -1223,8 +1607,8 @@ func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.
 	return req, nil
 }
 
-// NewGetQueryFormRequest generates requests for GetQueryForm
-func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Request, error) {
+// NewGetDeepObjectRequest generates requests for GetDeepObject
+func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1232,7 +1616,7 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/queryForm")
+	operationPath := fmt.Sprintf("/queryDeepObject")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1244,57 +1628,90 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 	queryValues := queryURL.Query()
 
-	if params.Ea != nil {
+	queryValues.Add("deepObj[Id]", strconv.FormatInt(int64(params.DeepObj.Id), 10))
+	queryValues.Add("deepObj[IsAdmin]", strconv.FormatBool(bool(params.DeepObj.IsAdmin)))
+	queryValues.Add("deepObj[Object][firstName]", string(params.DeepObj.Object.FirstName))
+	queryValues.Add("deepObj[Object][role]", string(params.DeepObj.Object.Role))
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ea", runtime.ParamLocationQuery, *params.Ea); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	queryURL.RawQuery = queryValues.Encode()
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.A != nil {
+	return req, nil
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "a", runtime.ParamLocationQuery, *params.A); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+// NewGetDeepObjectOptionalRequest generates requests for GetDeepObjectOptional
+func NewGetDeepObjectOptionalRequest(server string, params *GetDeepObjectOptionalParams) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Eo != nil {
+	operationPath := fmt.Sprintf("/queryDeepObjectOptional")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eo", runtime.ParamLocationQuery, *params.Eo); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	queryValues := queryURL.Query()
+
+	if params.Filter.Limit != nil {
+		queryValues.Add("filter[limit]", strconv.FormatInt(int64(*params.Filter.Limit), 10))
+	}
+	if params.Filter.Name != nil {
+		queryValues.Add("filter[name]", string(*params.Filter.Name))
+	}
+	if params.Filter.Nested != nil && params.Filter.Nested.Label != nil {
+		queryValues.Add("filter[nested][label]", string(*params.Filter.Nested.Label))
+	}
+	if params.Filter.Nested != nil && params.Filter.Nested.Size != nil {
+		queryValues.Add("filter[nested][size]", strconv.FormatFloat(float64(*params.Filter.Nested.Size), 'f', -1, 32))
 	}
 
-	if params.O != nil {
+	queryURL.RawQuery = queryValues.Encode()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "o", runtime.ParamLocationQuery, *params.O); err != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetQueryFormRequest generates requests for GetQueryForm
+func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/queryForm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+	var rawQueryFrags []string
+
+	if params.Ea != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ea", runtime.ParamLocationQuery, *params.Ea); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -1308,6 +1725,37 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 	}
 
+	if params.A != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "a", runtime.ParamLocationQuery, *params.A); err != nil {
+			return nil, err
+		} else if queryFrag != "" {
+			// The values are already escaped, and the commas joining them must
+			// stay unescaped for the server to split them apart again
+			rawQueryFrags = append(rawQueryFrags, queryFrag)
+		}
+
+	}
+
+	if params.Eo != nil {
+
+		queryValues.Add("firstName", string(params.Eo.FirstName))
+		queryValues.Add("role", string(params.Eo.Role))
+
+	}
+
+	if params.O != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "o", runtime.ParamLocationQuery, *params.O); err != nil {
+			return nil, err
+		} else if queryFrag != "" {
+			// The values are already escaped, and the commas joining them must
+			// stay unescaped for the server to split them apart again
+			rawQueryFrags = append(rawQueryFrags, queryFrag)
+		}
+
+	}
+
 	if params.Ep != nil {
 
 		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ep", runtime.ParamLocationQuery, *params.Ep); err != nil {
@@ -1328,14 +1776,10 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "p", runtime.ParamLocationQuery, *params.P); err != nil {
 			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
+		} else if queryFrag != "" {
+			// The values are already escaped, and the commas joining them must
+			// stay unescaped for the server to split them apart again
+			rawQueryFrags = append(rawQueryFrags, queryFrag)
 		}
 
 	}
@@ -1383,6 +1827,12 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 	}
 
 	queryURL.RawQuery = queryValues.Encode()
+	if len(rawQueryFrags) != 0 {
+		if queryURL.RawQuery != "" {
+			rawQueryFrags = append([]string{queryURL.RawQuery}, rawQueryFrags...)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFrags, "&")
+	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
@@ -1392,6 +1842,74 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 	return req, nil
 }
 
+// NewGetRequiredCookieRequest generates requests for GetRequiredCookie
+func NewGetRequiredCookieRequest(server string, params *GetRequiredCookieParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/requiredCookie")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cookieParam0 string
+
+	cookieParam0, err = runtime.StyleParamWithLocation("simple", false, "ra", runtime.ParamLocationCookie, params.Ra)
+	if err != nil {
+		return nil, err
+	}
+
+	cookie0 := &http.Cookie{
+		Name:  "ra",
+		Value: cookieParam0,
+	}
+	req.AddCookie(cookie0)
+
+	var cookieParam1 string
+
+	cookieParam1, err = runtime.StyleParamWithLocation("simple", true, "rp", runtime.ParamLocationCookie, params.Rp)
+	if err != nil {
+		return nil, err
+	}
+
+	cookie1 := &http.Cookie{
+		Name:  "rp",
+		Value: cookieParam1,
+	}
+	req.AddCookie(cookie1)
+
+	if params.Op != nil {
+		var cookieParam2 string
+
+		cookieParam2, err = runtime.StyleParamWithLocation("simple", true, "op", runtime.ParamLocationCookie, *params.Op)
+		if err != nil {
+			return nil, err
+		}
+
+		cookie2 := &http.Cookie{
+			Name:  "op",
+			Value: cookieParam2,
+		}
+		req.AddCookie(cookie2)
+	}
+
+	return req, nil
+}
+
 // NewGetSimpleExplodeArrayRequest generates requests for GetSimpleExplodeArray
 func NewGetSimpleExplodeArrayRequest(server string, param []int32) (*http.Request, error) {
 	var err error
@@ -1593,6 +2111,40 @@ func NewGetStartingWithNumberRequest(server string, n1param string) (*http.Reque
 	return req, nil
 }
 
+// do sends the request, then calls the response editors.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	rsp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.captureRequest {
+		rsp.Request = captureRequest(rsp, req)
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(ctx, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// capturedRequestContextKey marks the copies of requests which clients made
+// WithCaptureRequest keep in their responses.
+type capturedRequestContextKey struct{}
+
+// captureRequest returns a copy of the request which rsp answers, falling
+// back to req, without its body.
+func captureRequest(rsp *http.Response, req *http.Request) *http.Request {
+	if rsp.Request != nil {
+		req = rsp.Request
+	}
+	captured := req.Clone(context.WithValue(req.Context(), capturedRequestContextKey{}, true))
+	captured.Body = nil
+	captured.GetBody = nil
+	return captured
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -1607,6 +2159,30 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 	return nil
 }
 
+// ServerURL0 returns the URL of server 0 in the spec, "http://openapitest.deepmap.ai".
+// The server has no variables, so vars must be empty.
+func ServerURL0(vars map[string]string) (string, error) {
+	return runtime.ServerURL("http://openapitest.deepmap.ai", vars, nil)
+}
+
+// serverURLs holds the URLs of the servers in the spec, in order, with their
+// variables set to their defaults.
+var serverURLs = []string{
+	"http://openapitest.deepmap.ai",
+}
+
+// WithBaseURLIndex sets the base URL to that of the i-th server in the spec,
+// with its variables set to their defaults.
+func WithBaseURLIndex(i int) ClientOption {
+	return func(c *Client) error {
+		if i < 0 || i >= len(serverURLs) {
+			return fmt.Errorf("server index %d out of range, the spec has %d servers", i, len(serverURLs))
+		}
+		c.Server = serverURLs[i]
+		return nil
+	}
+}
+
 // ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
@@ -1660,6 +2236,9 @@ type ClientWithResponsesInterface interface {
 	// GetLabelNoExplodeObject request
 	GetLabelNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeObjectResponse, error)
 
+	// GetLabelPrimitive request
+	GetLabelPrimitiveWithResponse(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*GetLabelPrimitiveResponse, error)
+
 	// GetMatrixExplodeArray request
 	GetMatrixExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixExplodeArrayResponse, error)
 
@@ -1672,15 +2251,27 @@ type ClientWithResponsesInterface interface {
 	// GetMatrixNoExplodeObject request
 	GetMatrixNoExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeObjectResponse, error)
 
+	// GetMatrixPrimitive request
+	GetMatrixPrimitiveWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetMatrixPrimitiveResponse, error)
+
 	// GetPassThrough request
 	GetPassThroughWithResponse(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*GetPassThroughResponse, error)
 
+	// GetQueryContent request
+	GetQueryContentWithResponse(ctx context.Context, params *GetQueryContentParams, reqEditors ...RequestEditorFn) (*GetQueryContentResponse, error)
+
 	// GetDeepObject request
 	GetDeepObjectWithResponse(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*GetDeepObjectResponse, error)
 
+	// GetDeepObjectOptional request
+	GetDeepObjectOptionalWithResponse(ctx context.Context, params *GetDeepObjectOptionalParams, reqEditors ...RequestEditorFn) (*GetDeepObjectOptionalResponse, error)
+
 	// GetQueryForm request
 	GetQueryFormWithResponse(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*GetQueryFormResponse, error)
 
+	// GetRequiredCookie request
+	GetRequiredCookieWithResponse(ctx context.Context, params *GetRequiredCookieParams, reqEditors ...RequestEditorFn) (*GetRequiredCookieResponse, error)
+
 	// GetSimpleExplodeArray request
 	GetSimpleExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleExplodeArrayResponse, error)
 
@@ -1700,9 +2291,12 @@ type ClientWithResponsesInterface interface {
 	GetStartingWithNumberWithResponse(ctx context.Context, n1param string, reqEditors ...RequestEditorFn) (*GetStartingWithNumberResponse, error)
 }
 
+var _ ClientWithResponsesInterface = (*ClientWithResponses)(nil)
+
 type GetContentObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1721,9 +2315,29 @@ func (r GetContentObjectResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetContentObjectResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetContentObjectExpectedStatusCodes returns the status codes which GetContentObject has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetContentObjectExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetContentObjectHasDefaultResponse is whether GetContentObject has a default response,
+// for status codes which aren't in GetContentObjectExpectedStatusCodes.
+const GetContentObjectHasDefaultResponse = false
+
 type GetCookieResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1742,9 +2356,36 @@ func (r GetCookieResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetCookieResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of GetCookie, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r GetCookieResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && true
+}
+
+// GetCookieExpectedStatusCodes returns the status codes which GetCookie has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetCookieExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// GetCookieHasDefaultResponse is whether GetCookie has a default response,
+// for status codes which aren't in GetCookieExpectedStatusCodes.
+const GetCookieHasDefaultResponse = true
+
 type EnumParamsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1763,9 +2404,29 @@ func (r EnumParamsResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r EnumParamsResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// EnumParamsExpectedStatusCodes returns the status codes which EnumParams has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func EnumParamsExpectedStatusCodes() []int {
+	return []int{204}
+}
+
+// EnumParamsHasDefaultResponse is whether EnumParams has a default response,
+// for status codes which aren't in EnumParamsExpectedStatusCodes.
+const EnumParamsHasDefaultResponse = false
+
 type GetHeaderResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1784,9 +2445,36 @@ func (r GetHeaderResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetHeaderResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of GetHeader, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r GetHeaderResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && true
+}
+
+// GetHeaderExpectedStatusCodes returns the status codes which GetHeader has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetHeaderExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// GetHeaderHasDefaultResponse is whether GetHeader has a default response,
+// for status codes which aren't in GetHeaderExpectedStatusCodes.
+const GetHeaderHasDefaultResponse = true
+
 type GetLabelExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1805,9 +2493,29 @@ func (r GetLabelExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetLabelExplodeArrayResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetLabelExplodeArrayExpectedStatusCodes returns the status codes which GetLabelExplodeArray has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetLabelExplodeArrayExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetLabelExplodeArrayHasDefaultResponse is whether GetLabelExplodeArray has a default response,
+// for status codes which aren't in GetLabelExplodeArrayExpectedStatusCodes.
+const GetLabelExplodeArrayHasDefaultResponse = false
+
 type GetLabelExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1826,9 +2534,29 @@ func (r GetLabelExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetLabelExplodeObjectResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetLabelExplodeObjectExpectedStatusCodes returns the status codes which GetLabelExplodeObject has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetLabelExplodeObjectExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetLabelExplodeObjectHasDefaultResponse is whether GetLabelExplodeObject has a default response,
+// for status codes which aren't in GetLabelExplodeObjectExpectedStatusCodes.
+const GetLabelExplodeObjectHasDefaultResponse = false
+
 type GetLabelNoExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1847,9 +2575,29 @@ func (r GetLabelNoExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetLabelNoExplodeArrayResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetLabelNoExplodeArrayExpectedStatusCodes returns the status codes which GetLabelNoExplodeArray has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetLabelNoExplodeArrayExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetLabelNoExplodeArrayHasDefaultResponse is whether GetLabelNoExplodeArray has a default response,
+// for status codes which aren't in GetLabelNoExplodeArrayExpectedStatusCodes.
+const GetLabelNoExplodeArrayHasDefaultResponse = false
+
 type GetLabelNoExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1868,9 +2616,70 @@ func (r GetLabelNoExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetLabelNoExplodeObjectResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetLabelNoExplodeObjectExpectedStatusCodes returns the status codes which GetLabelNoExplodeObject has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetLabelNoExplodeObjectExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetLabelNoExplodeObjectHasDefaultResponse is whether GetLabelNoExplodeObject has a default response,
+// for status codes which aren't in GetLabelNoExplodeObjectExpectedStatusCodes.
+const GetLabelNoExplodeObjectHasDefaultResponse = false
+
+type GetLabelPrimitiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLabelPrimitiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLabelPrimitiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetLabelPrimitiveResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetLabelPrimitiveExpectedStatusCodes returns the status codes which GetLabelPrimitive has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetLabelPrimitiveExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetLabelPrimitiveHasDefaultResponse is whether GetLabelPrimitive has a default response,
+// for status codes which aren't in GetLabelPrimitiveExpectedStatusCodes.
+const GetLabelPrimitiveHasDefaultResponse = false
+
 type GetMatrixExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1889,9 +2698,29 @@ func (r GetMatrixExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetMatrixExplodeArrayResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetMatrixExplodeArrayExpectedStatusCodes returns the status codes which GetMatrixExplodeArray has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetMatrixExplodeArrayExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetMatrixExplodeArrayHasDefaultResponse is whether GetMatrixExplodeArray has a default response,
+// for status codes which aren't in GetMatrixExplodeArrayExpectedStatusCodes.
+const GetMatrixExplodeArrayHasDefaultResponse = false
+
 type GetMatrixExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1910,9 +2739,29 @@ func (r GetMatrixExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetMatrixExplodeObjectResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetMatrixExplodeObjectExpectedStatusCodes returns the status codes which GetMatrixExplodeObject has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetMatrixExplodeObjectExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetMatrixExplodeObjectHasDefaultResponse is whether GetMatrixExplodeObject has a default response,
+// for status codes which aren't in GetMatrixExplodeObjectExpectedStatusCodes.
+const GetMatrixExplodeObjectHasDefaultResponse = false
+
 type GetMatrixNoExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1931,9 +2780,29 @@ func (r GetMatrixNoExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetMatrixNoExplodeArrayResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetMatrixNoExplodeArrayExpectedStatusCodes returns the status codes which GetMatrixNoExplodeArray has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetMatrixNoExplodeArrayExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetMatrixNoExplodeArrayHasDefaultResponse is whether GetMatrixNoExplodeArray has a default response,
+// for status codes which aren't in GetMatrixNoExplodeArrayExpectedStatusCodes.
+const GetMatrixNoExplodeArrayHasDefaultResponse = false
+
 type GetMatrixNoExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1952,9 +2821,70 @@ func (r GetMatrixNoExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetMatrixNoExplodeObjectResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetMatrixNoExplodeObjectExpectedStatusCodes returns the status codes which GetMatrixNoExplodeObject has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetMatrixNoExplodeObjectExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetMatrixNoExplodeObjectHasDefaultResponse is whether GetMatrixNoExplodeObject has a default response,
+// for status codes which aren't in GetMatrixNoExplodeObjectExpectedStatusCodes.
+const GetMatrixNoExplodeObjectHasDefaultResponse = false
+
+type GetMatrixPrimitiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMatrixPrimitiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMatrixPrimitiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetMatrixPrimitiveResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetMatrixPrimitiveExpectedStatusCodes returns the status codes which GetMatrixPrimitive has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetMatrixPrimitiveExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetMatrixPrimitiveHasDefaultResponse is whether GetMatrixPrimitive has a default response,
+// for status codes which aren't in GetMatrixPrimitiveExpectedStatusCodes.
+const GetMatrixPrimitiveHasDefaultResponse = false
+
 type GetPassThroughResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1973,9 +2903,70 @@ func (r GetPassThroughResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetPassThroughResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetPassThroughExpectedStatusCodes returns the status codes which GetPassThrough has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetPassThroughExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetPassThroughHasDefaultResponse is whether GetPassThrough has a default response,
+// for status codes which aren't in GetPassThroughExpectedStatusCodes.
+const GetPassThroughHasDefaultResponse = false
+
+type GetQueryContentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+}
+
+// Status returns HTTPResponse.Status
+func (r GetQueryContentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetQueryContentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetQueryContentResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetQueryContentExpectedStatusCodes returns the status codes which GetQueryContent has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetQueryContentExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetQueryContentHasDefaultResponse is whether GetQueryContent has a default response,
+// for status codes which aren't in GetQueryContentExpectedStatusCodes.
+const GetQueryContentHasDefaultResponse = false
+
 type GetDeepObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1994,9 +2985,84 @@ func (r GetDeepObjectResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetDeepObjectResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of GetDeepObject, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r GetDeepObjectResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && true
+}
+
+// GetDeepObjectExpectedStatusCodes returns the status codes which GetDeepObject has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetDeepObjectExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// GetDeepObjectHasDefaultResponse is whether GetDeepObject has a default response,
+// for status codes which aren't in GetDeepObjectExpectedStatusCodes.
+const GetDeepObjectHasDefaultResponse = true
+
+type GetDeepObjectOptionalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeepObjectOptionalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeepObjectOptionalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetDeepObjectOptionalResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of GetDeepObjectOptional, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r GetDeepObjectOptionalResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && true
+}
+
+// GetDeepObjectOptionalExpectedStatusCodes returns the status codes which GetDeepObjectOptional has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetDeepObjectOptionalExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// GetDeepObjectOptionalHasDefaultResponse is whether GetDeepObjectOptional has a default response,
+// for status codes which aren't in GetDeepObjectOptionalExpectedStatusCodes.
+const GetDeepObjectOptionalHasDefaultResponse = true
+
 type GetQueryFormResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -2008,16 +3074,84 @@ func (r GetQueryFormResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetQueryFormResponse) StatusCode() int {
+func (r GetQueryFormResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetQueryFormResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetQueryFormExpectedStatusCodes returns the status codes which GetQueryForm has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetQueryFormExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetQueryFormHasDefaultResponse is whether GetQueryForm has a default response,
+// for status codes which aren't in GetQueryFormExpectedStatusCodes.
+const GetQueryFormHasDefaultResponse = false
+
+type GetRequiredCookieResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRequiredCookieResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRequiredCookieResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetRequiredCookieResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of GetRequiredCookie, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r GetRequiredCookieResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && true
+}
+
+// GetRequiredCookieExpectedStatusCodes returns the status codes which GetRequiredCookie has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetRequiredCookieExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// GetRequiredCookieHasDefaultResponse is whether GetRequiredCookie has a default response,
+// for status codes which aren't in GetRequiredCookieExpectedStatusCodes.
+const GetRequiredCookieHasDefaultResponse = true
+
 type GetSimpleExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -2036,9 +3170,29 @@ func (r GetSimpleExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetSimpleExplodeArrayResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetSimpleExplodeArrayExpectedStatusCodes returns the status codes which GetSimpleExplodeArray has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetSimpleExplodeArrayExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetSimpleExplodeArrayHasDefaultResponse is whether GetSimpleExplodeArray has a default response,
+// for status codes which aren't in GetSimpleExplodeArrayExpectedStatusCodes.
+const GetSimpleExplodeArrayHasDefaultResponse = false
+
 type GetSimpleExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -2057,9 +3211,29 @@ func (r GetSimpleExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetSimpleExplodeObjectResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetSimpleExplodeObjectExpectedStatusCodes returns the status codes which GetSimpleExplodeObject has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetSimpleExplodeObjectExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetSimpleExplodeObjectHasDefaultResponse is whether GetSimpleExplodeObject has a default response,
+// for status codes which aren't in GetSimpleExplodeObjectExpectedStatusCodes.
+const GetSimpleExplodeObjectHasDefaultResponse = false
+
 type GetSimpleNoExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -2078,9 +3252,29 @@ func (r GetSimpleNoExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetSimpleNoExplodeArrayResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetSimpleNoExplodeArrayExpectedStatusCodes returns the status codes which GetSimpleNoExplodeArray has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetSimpleNoExplodeArrayExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetSimpleNoExplodeArrayHasDefaultResponse is whether GetSimpleNoExplodeArray has a default response,
+// for status codes which aren't in GetSimpleNoExplodeArrayExpectedStatusCodes.
+const GetSimpleNoExplodeArrayHasDefaultResponse = false
+
 type GetSimpleNoExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -2099,9 +3293,29 @@ func (r GetSimpleNoExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetSimpleNoExplodeObjectResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetSimpleNoExplodeObjectExpectedStatusCodes returns the status codes which GetSimpleNoExplodeObject has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetSimpleNoExplodeObjectExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetSimpleNoExplodeObjectHasDefaultResponse is whether GetSimpleNoExplodeObject has a default response,
+// for status codes which aren't in GetSimpleNoExplodeObjectExpectedStatusCodes.
+const GetSimpleNoExplodeObjectHasDefaultResponse = false
+
 type GetSimplePrimitiveResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -2120,9 +3334,29 @@ func (r GetSimplePrimitiveResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetSimplePrimitiveResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetSimplePrimitiveExpectedStatusCodes returns the status codes which GetSimplePrimitive has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetSimplePrimitiveExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetSimplePrimitiveHasDefaultResponse is whether GetSimplePrimitive has a default response,
+// for status codes which aren't in GetSimplePrimitiveExpectedStatusCodes.
+const GetSimplePrimitiveHasDefaultResponse = false
+
 type GetStartingWithNumberResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -2141,6 +3375,25 @@ func (r GetStartingWithNumberResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetStartingWithNumberResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetStartingWithNumberExpectedStatusCodes returns the status codes which GetStartingWithNumber has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetStartingWithNumberExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetStartingWithNumberHasDefaultResponse is whether GetStartingWithNumber has a default response,
+// for status codes which aren't in GetStartingWithNumberExpectedStatusCodes.
+const GetStartingWithNumberHasDefaultResponse = false
+
 // GetContentObjectWithResponse request returning *GetContentObjectResponse
 func (c *ClientWithResponses) GetContentObjectWithResponse(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*GetContentObjectResponse, error) {
 	rsp, err := c.GetContentObject(ctx, param, reqEditors...)
@@ -2213,6 +3466,15 @@ func (c *ClientWithResponses) GetLabelNoExplodeObjectWithResponse(ctx context.Co
 	return ParseGetLabelNoExplodeObjectResponse(rsp)
 }
 
+// GetLabelPrimitiveWithResponse request returning *GetLabelPrimitiveResponse
+func (c *ClientWithResponses) GetLabelPrimitiveWithResponse(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*GetLabelPrimitiveResponse, error) {
+	rsp, err := c.GetLabelPrimitive(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLabelPrimitiveResponse(rsp)
+}
+
 // GetMatrixExplodeArrayWithResponse request returning *GetMatrixExplodeArrayResponse
 func (c *ClientWithResponses) GetMatrixExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixExplodeArrayResponse, error) {
 	rsp, err := c.GetMatrixExplodeArray(ctx, id, reqEditors...)
@@ -2249,6 +3511,15 @@ func (c *ClientWithResponses) GetMatrixNoExplodeObjectWithResponse(ctx context.C
 	return ParseGetMatrixNoExplodeObjectResponse(rsp)
 }
 
+// GetMatrixPrimitiveWithResponse request returning *GetMatrixPrimitiveResponse
+func (c *ClientWithResponses) GetMatrixPrimitiveWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetMatrixPrimitiveResponse, error) {
+	rsp, err := c.GetMatrixPrimitive(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMatrixPrimitiveResponse(rsp)
+}
+
 // GetPassThroughWithResponse request returning *GetPassThroughResponse
 func (c *ClientWithResponses) GetPassThroughWithResponse(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*GetPassThroughResponse, error) {
 	rsp, err := c.GetPassThrough(ctx, param, reqEditors...)
@@ -2258,6 +3529,15 @@ func (c *ClientWithResponses) GetPassThroughWithResponse(ctx context.Context, pa
 	return ParseGetPassThroughResponse(rsp)
 }
 
+// GetQueryContentWithResponse request returning *GetQueryContentResponse
+func (c *ClientWithResponses) GetQueryContentWithResponse(ctx context.Context, params *GetQueryContentParams, reqEditors ...RequestEditorFn) (*GetQueryContentResponse, error) {
+	rsp, err := c.GetQueryContent(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetQueryContentResponse(rsp)
+}
+
 // GetDeepObjectWithResponse request returning *GetDeepObjectResponse
 func (c *ClientWithResponses) GetDeepObjectWithResponse(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*GetDeepObjectResponse, error) {
 	rsp, err := c.GetDeepObject(ctx, params, reqEditors...)
@@ -2267,6 +3547,15 @@ func (c *ClientWithResponses) GetDeepObjectWithResponse(ctx context.Context, par
 	return ParseGetDeepObjectResponse(rsp)
 }
 
+// GetDeepObjectOptionalWithResponse request returning *GetDeepObjectOptionalResponse
+func (c *ClientWithResponses) GetDeepObjectOptionalWithResponse(ctx context.Context, params *GetDeepObjectOptionalParams, reqEditors ...RequestEditorFn) (*GetDeepObjectOptionalResponse, error) {
+	rsp, err := c.GetDeepObjectOptional(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeepObjectOptionalResponse(rsp)
+}
+
 // GetQueryFormWithResponse request returning *GetQueryFormResponse
 func (c *ClientWithResponses) GetQueryFormWithResponse(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*GetQueryFormResponse, error) {
 	rsp, err := c.GetQueryForm(ctx, params, reqEditors...)
@@ -2276,6 +3565,15 @@ func (c *ClientWithResponses) GetQueryFormWithResponse(ctx context.Context, para
 	return ParseGetQueryFormResponse(rsp)
 }
 
+// GetRequiredCookieWithResponse request returning *GetRequiredCookieResponse
+func (c *ClientWithResponses) GetRequiredCookieWithResponse(ctx context.Context, params *GetRequiredCookieParams, reqEditors ...RequestEditorFn) (*GetRequiredCookieResponse, error) {
+	rsp, err := c.GetRequiredCookie(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRequiredCookieResponse(rsp)
+}
+
 // GetSimpleExplodeArrayWithResponse request returning *GetSimpleExplodeArrayResponse
 func (c *ClientWithResponses) GetSimpleExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleExplodeArrayResponse, error) {
 	rsp, err := c.GetSimpleExplodeArray(ctx, param, reqEditors...)
@@ -2330,6 +3628,15 @@ func (c *ClientWithResponses) GetStartingWithNumberWithResponse(ctx context.Cont
 	return ParseGetStartingWithNumberResponse(rsp)
 }
 
+// capturedRequest returns the request kept in rsp by a client made
+// WithCaptureRequest(true), or nil.
+func capturedRequest(rsp *http.Response) *http.Request {
+	if rsp.Request == nil || rsp.Request.Context().Value(capturedRequestContextKey{}) == nil {
+		return nil
+	}
+	return rsp.Request
+}
+
 // ParseGetContentObjectResponse parses an HTTP response from a GetContentObjectWithResponse call
 func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -2341,6 +3648,7 @@ func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectRespons
 	response := &GetContentObjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2357,6 +3665,7 @@ func ParseGetCookieResponse(rsp *http.Response) (*GetCookieResponse, error) {
 	response := &GetCookieResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2373,6 +3682,7 @@ func ParseEnumParamsResponse(rsp *http.Response) (*EnumParamsResponse, error) {
 	response := &EnumParamsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2389,6 +3699,7 @@ func ParseGetHeaderResponse(rsp *http.Response) (*GetHeaderResponse, error) {
 	response := &GetHeaderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2405,6 +3716,7 @@ func ParseGetLabelExplodeArrayResponse(rsp *http.Response) (*GetLabelExplodeArra
 	response := &GetLabelExplodeArrayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2421,6 +3733,7 @@ func ParseGetLabelExplodeObjectResponse(rsp *http.Response) (*GetLabelExplodeObj
 	response := &GetLabelExplodeObjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2437,6 +3750,7 @@ func ParseGetLabelNoExplodeArrayResponse(rsp *http.Response) (*GetLabelNoExplode
 	response := &GetLabelNoExplodeArrayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2453,6 +3767,24 @@ func ParseGetLabelNoExplodeObjectResponse(rsp *http.Response) (*GetLabelNoExplod
 	response := &GetLabelNoExplodeObjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	return response, nil
+}
+
+// ParseGetLabelPrimitiveResponse parses an HTTP response from a GetLabelPrimitiveWithResponse call
+func ParseGetLabelPrimitiveResponse(rsp *http.Response) (*GetLabelPrimitiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLabelPrimitiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2469,6 +3801,7 @@ func ParseGetMatrixExplodeArrayResponse(rsp *http.Response) (*GetMatrixExplodeAr
 	response := &GetMatrixExplodeArrayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2485,6 +3818,7 @@ func ParseGetMatrixExplodeObjectResponse(rsp *http.Response) (*GetMatrixExplodeO
 	response := &GetMatrixExplodeObjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2501,6 +3835,7 @@ func ParseGetMatrixNoExplodeArrayResponse(rsp *http.Response) (*GetMatrixNoExplo
 	response := &GetMatrixNoExplodeArrayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2517,6 +3852,24 @@ func ParseGetMatrixNoExplodeObjectResponse(rsp *http.Response) (*GetMatrixNoExpl
 	response := &GetMatrixNoExplodeObjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	return response, nil
+}
+
+// ParseGetMatrixPrimitiveResponse parses an HTTP response from a GetMatrixPrimitiveWithResponse call
+func ParseGetMatrixPrimitiveResponse(rsp *http.Response) (*GetMatrixPrimitiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMatrixPrimitiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2533,6 +3886,24 @@ func ParseGetPassThroughResponse(rsp *http.Response) (*GetPassThroughResponse, e
 	response := &GetPassThroughResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	return response, nil
+}
+
+// ParseGetQueryContentResponse parses an HTTP response from a GetQueryContentWithResponse call
+func ParseGetQueryContentResponse(rsp *http.Response) (*GetQueryContentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetQueryContentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2549,6 +3920,24 @@ func ParseGetDeepObjectResponse(rsp *http.Response) (*GetDeepObjectResponse, err
 	response := &GetDeepObjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	return response, nil
+}
+
+// ParseGetDeepObjectOptionalResponse parses an HTTP response from a GetDeepObjectOptionalWithResponse call
+func ParseGetDeepObjectOptionalResponse(rsp *http.Response) (*GetDeepObjectOptionalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeepObjectOptionalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2565,6 +3954,24 @@ func ParseGetQueryFormResponse(rsp *http.Response) (*GetQueryFormResponse, error
 	response := &GetQueryFormResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	return response, nil
+}
+
+// ParseGetRequiredCookieResponse parses an HTTP response from a GetRequiredCookieWithResponse call
+func ParseGetRequiredCookieResponse(rsp *http.Response) (*GetRequiredCookieResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRequiredCookieResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2581,6 +3988,7 @@ func ParseGetSimpleExplodeArrayResponse(rsp *http.Response) (*GetSimpleExplodeAr
 	response := &GetSimpleExplodeArrayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2597,6 +4005,7 @@ func ParseGetSimpleExplodeObjectResponse(rsp *http.Response) (*GetSimpleExplodeO
 	response := &GetSimpleExplodeObjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2613,6 +4022,7 @@ func ParseGetSimpleNoExplodeArrayResponse(rsp *http.Response) (*GetSimpleNoExplo
 	response := &GetSimpleNoExplodeArrayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2629,6 +4039,7 @@ func ParseGetSimpleNoExplodeObjectResponse(rsp *http.Response) (*GetSimpleNoExpl
 	response := &GetSimpleNoExplodeObjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2645,6 +4056,7 @@ func ParseGetSimplePrimitiveResponse(rsp *http.Response) (*GetSimplePrimitiveRes
 	response := &GetSimplePrimitiveResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2661,6 +4073,7 @@ func ParseGetStartingWithNumberResponse(rsp *http.Response) (*GetStartingWithNum
 	response := &GetStartingWithNumberResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -2693,6 +4106,9 @@ type ServerInterface interface {
 	// (GET /labelNoExplodeObject/{.param})
 	GetLabelNoExplodeObject(ctx echo.Context, param Object) error
 
+	// (GET /labelPrimitive/{.param})
+	GetLabelPrimitive(ctx echo.Context, param int32) error
+
 	// (GET /matrixExplodeArray/{.id*})
 	GetMatrixExplodeArray(ctx echo.Context, id []int32) error
 
@@ -2705,15 +4121,27 @@ type ServerInterface interface {
 	// (GET /matrixNoExplodeObject/{.id})
 	GetMatrixNoExplodeObject(ctx echo.Context, id Object) error
 
+	// (GET /matrixPrimitive/{;id})
+	GetMatrixPrimitive(ctx echo.Context, id int32) error
+
 	// (GET /passThrough/{param})
 	GetPassThrough(ctx echo.Context, param string) error
 
+	// (GET /queryContent)
+	GetQueryContent(ctx echo.Context, params GetQueryContentParams) error
+
 	// (GET /queryDeepObject)
 	GetDeepObject(ctx echo.Context, params GetDeepObjectParams) error
 
+	// (GET /queryDeepObjectOptional)
+	GetDeepObjectOptional(ctx echo.Context, params GetDeepObjectOptionalParams) error
+
 	// (GET /queryForm)
 	GetQueryForm(ctx echo.Context, params GetQueryFormParams) error
 
+	// (GET /requiredCookie)
+	GetRequiredCookie(ctx echo.Context, params GetRequiredCookieParams) error
+
 	// (GET /simpleExplodeArray/{param*})
 	GetSimpleExplodeArray(ctx echo.Context, param []int32) error
 
@@ -2872,6 +4300,21 @@ func (w *ServerInterfaceWrapper) EnumParams(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enumPathParam: %s", err))
 	}
 
+	// ------------- Optional query parameter "enumStringParam" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "enumStringParam", ctx.QueryParams(), &params.EnumStringParam)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enumStringParam: %s", err))
+	}
+
+	if params.EnumPathParam != nil && *params.EnumPathParam != 100 && *params.EnumPathParam != 200 {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid format for parameter enumPathParam: must be one of 100, 200")
+	}
+
+	if params.EnumStringParam != nil && *params.EnumStringParam != "red" && *params.EnumStringParam != "green" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid format for parameter enumStringParam: must be one of \"red\", \"green\"")
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.EnumParams(ctx, params)
 	return err
@@ -3075,6 +4518,22 @@ func (w *ServerInterfaceWrapper) GetLabelNoExplodeObject(ctx echo.Context) error
 	return err
 }
 
+// GetLabelPrimitive converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLabelPrimitive(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "param" -------------
+	var param int32
+
+	err = runtime.BindStyledParameterWithLocation("label", false, "param", runtime.ParamLocationPath, ctx.Param("param"), &param)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLabelPrimitive(ctx, param)
+	return err
+}
+
 // GetMatrixExplodeArray converts echo context to params.
 func (w *ServerInterfaceWrapper) GetMatrixExplodeArray(ctx echo.Context) error {
 	var err error
@@ -3139,6 +4598,22 @@ func (w *ServerInterfaceWrapper) GetMatrixNoExplodeObject(ctx echo.Context) erro
 	return err
 }
 
+// GetMatrixPrimitive converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMatrixPrimitive(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithLocation("matrix", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetMatrixPrimitive(ctx, id)
+	return err
+}
+
 // GetPassThrough converts echo context to params.
 func (w *ServerInterfaceWrapper) GetPassThrough(ctx echo.Context) error {
 	var err error
@@ -3152,6 +4627,40 @@ func (w *ServerInterfaceWrapper) GetPassThrough(ctx echo.Context) error {
 	return err
 }
 
+// GetQueryContent converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQueryContent(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQueryContentParams
+	// ------------- Required query parameter "vco" -------------
+
+	if paramValue := ctx.QueryParam("vco"); paramValue != "" {
+
+		var value ComplexObject
+		err = json.Unmarshal([]byte(paramValue), &value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Error unmarshalling parameter 'vco' as JSON")
+		}
+		params.Vco = value
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument vco is required, but not found"))
+	}
+
+	// ------------- Optional query parameter "pt" -------------
+
+	if paramValue := ctx.QueryParam("pt"); paramValue != "" {
+
+		params.Pt = &paramValue
+
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetQueryContent(ctx, params)
+	return err
+}
+
 // GetDeepObject converts echo context to params.
 func (w *ServerInterfaceWrapper) GetDeepObject(ctx echo.Context) error {
 	var err error
@@ -3170,6 +4679,24 @@ func (w *ServerInterfaceWrapper) GetDeepObject(ctx echo.Context) error {
 	return err
 }
 
+// GetDeepObjectOptional converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDeepObjectOptional(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDeepObjectOptionalParams
+	// ------------- Required query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, true, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetDeepObjectOptional(ctx, params)
+	return err
+}
+
 // GetQueryForm converts echo context to params.
 func (w *ServerInterfaceWrapper) GetQueryForm(ctx echo.Context) error {
 	var err error
@@ -3250,6 +4777,55 @@ func (w *ServerInterfaceWrapper) GetQueryForm(ctx echo.Context) error {
 	return err
 }
 
+// GetRequiredCookie converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRequiredCookie(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRequiredCookieParams
+
+	if cookie, err := ctx.Cookie("ra"); err == nil {
+
+		var value []int32
+		err = runtime.BindStyledParameterWithLocation("simple", false, "ra", runtime.ParamLocationCookie, cookie.Value, &value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ra: %s", err))
+		}
+		params.Ra = value
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument ra is required, but not found"))
+	}
+
+	if cookie, err := ctx.Cookie("rp"); err == nil {
+
+		var value string
+		err = runtime.BindStyledParameterWithLocation("simple", true, "rp", runtime.ParamLocationCookie, cookie.Value, &value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rp: %s", err))
+		}
+		params.Rp = value
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument rp is required, but not found"))
+	}
+
+	if cookie, err := ctx.Cookie("op"); err == nil {
+
+		var value int32
+		err = runtime.BindStyledParameterWithLocation("simple", true, "op", runtime.ParamLocationCookie, cookie.Value, &value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter op: %s", err))
+		}
+		params.Op = &value
+
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetRequiredCookie(ctx, params)
+	return err
+}
+
 // GetSimpleExplodeArray converts echo context to params.
 func (w *ServerInterfaceWrapper) GetSimpleExplodeArray(ctx echo.Context) error {
 	var err error
@@ -3379,13 +4955,18 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/labelExplodeObject/:param", wrapper.GetLabelExplodeObject)
 	router.GET(baseURL+"/labelNoExplodeArray/:param", wrapper.GetLabelNoExplodeArray)
 	router.GET(baseURL+"/labelNoExplodeObject/:param", wrapper.GetLabelNoExplodeObject)
+	router.GET(baseURL+"/labelPrimitive/:param", wrapper.GetLabelPrimitive)
 	router.GET(baseURL+"/matrixExplodeArray/:id", wrapper.GetMatrixExplodeArray)
 	router.GET(baseURL+"/matrixExplodeObject/:id", wrapper.GetMatrixExplodeObject)
 	router.GET(baseURL+"/matrixNoExplodeArray/:id", wrapper.GetMatrixNoExplodeArray)
 	router.GET(baseURL+"/matrixNoExplodeObject/:id", wrapper.GetMatrixNoExplodeObject)
+	router.GET(baseURL+"/matrixPrimitive/:id", wrapper.GetMatrixPrimitive)
 	router.GET(baseURL+"/passThrough/:param", wrapper.GetPassThrough)
+	router.GET(baseURL+"/queryContent", wrapper.GetQueryContent)
 	router.GET(baseURL+"/queryDeepObject", wrapper.GetDeepObject)
+	router.GET(baseURL+"/queryDeepObjectOptional", wrapper.GetDeepObjectOptional)
 	router.GET(baseURL+"/queryForm", wrapper.GetQueryForm)
+	router.GET(baseURL+"/requiredCookie", wrapper.GetRequiredCookie)
 	router.GET(baseURL+"/simpleExplodeArray/:param", wrapper.GetSimpleExplodeArray)
 	router.GET(baseURL+"/simpleExplodeObject/:param", wrapper.GetSimpleExplodeObject)
 	router.GET(baseURL+"/simpleNoExplodeArray/:param", wrapper.GetSimpleNoExplodeArray)
@@ -3398,26 +4979,31 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xaUXOjNhD+K8y2Tx1i7Ls+8Za5XtvM9HJpnZnrTCYPCqyDroB0kpwm4+G/dySBAYEx",
-	"dkyS61uCVvvtfqy+aJdsIGIZZznmSkK4AYGSs1yi+WVJM57iX+Uj/SRiucJc6R8VPqqAp4Tm+jcZJZgR",
-	"8/yJI4QglaD5PRRF4UOMMhKUK8pyCOHck8avV2F57O4rRgq0qfVj0D8wbfX42S6GG+CCcRSK2uAu4gYa",
-	"zRXeo4DChwt5Hmc2qHLxjrEUSa4Xa2c/ClxBCD8Edf5BCR58ruMR+G1NBcYQ3lSbfQ1d49y23LZjXFEh",
-	"1SXJsIcYHwRL+xYcVGPlN1zdGk5pvmJ6c0ojLF9OboDg08W19q6o0u7hGqXyligeUIAPDyikfQ2L2Xw2",
-	"14aMY044hRDez+azBfjAiUpM/EH5vm1+wYYTQbJCr9yjSVcnS/R71W8DfkP1obnBuBIkQ4VCQnjTqh/C",
-	"eUojszn4KplTRUOvp10YJRsQmrDBr2gwyNDkUok1Frd+u8bfzee78LZ2gXMQCoMZRIz9Q3GYDWPRoaF9",
-	"ILigGVX0QRviI09ZjBCuSCqxTCyq3FSpgd+gasVERpQ9BO/fgd85E4U/ClHTswMQn41YosQeEYI8jYUl",
-	"LViqMJOj8LdPLFpPPJ0whvieLowtLaw6MKN4Ya2AxkmZC91FHKLgOMSpjns7k8ga1Bz2ZhAx6JKg1zyp",
-	"iFA0v/f+pSrx8nV2Z6Sy18tCtohwpdtVlxhXZJ2qYxUG87UttV6B+ZivsystLHKfwlxVizZF7dZ7IOka",
-	"ZZXntzWKp0aFGdcquSpFtM5Yr0B4s5jP/Xfz+a0/Qgy6kvuz5ab1JphXVUuZfIIkRjEkr79bi+fKa1K5",
-	"KZP/++yqsWVSoR2APvtYasOLSG83kHNt3R/EiwnxjqheWY67UVlt6idrCnXeFcF3J9LdREpHVUJHSLbr",
-	"c3G2LK3PvlCVnF1W1i8m4ym5w7QsDlPAwWZmJOunwbv0H+62rtL1leeYa/BpDpAPUj2ZJsNkCKe8XDc5",
-	"q9qPQ0nb1YWcgrUxp2tyfi5ZX1Xt56e9b4Cgpuj8j+pqm3+7sg4gbm9pPYe5166tjChBH53SovHwwfvU",
-	"2XTMwaPx5DVls5uOsG1NHcTY8Vq1h7LDimkycjpSReMR5JxAqL7niurq1GGsPUOl3npVcSLldSLY+j4Z",
-	"M5e8qs0Hp5IHTLVfZeZo+vRfEHk9ct6VcsNqT4ccI/LhlscZD8TW9dEV4nQLdaHEdcynvoSbFH5lIhvi",
-	"7M+t0R7KRjXV7lDlVHPEmi+9FQ5sqp2oXiyocc21y9n0o04H8RSA21T3zX/cbKeZ7A9kezpAr9TGHTjD",
-	"c9NXHkM4wR43KnacHDgpfsbfBPs5tX29GtEpLzvb3u58waYIk7HW+sB5AG1vZ8IwGUPuxX3/XWvZs+8N",
-	"zximZ2785/Nl38Y3MWWYjKXtB4/x/DQ/zzjMHMXEiOKZkobyb8oXqhI7mw42ixFUdLZN2NgsJu5sNMPm",
-	"X1Rs3GuRQgiJUjwMgvL/UxRKNdP9QUb4jFAobov/AgAA//8eGGgVvSQAAA==",
+	"H4sIAAAAAAAC/9xaS3PbNhD+Kxi0p5YWJacn9pRx0zadxnajzLQzGR9gcmUhJQEEgFS7Gv33DkBSJMG3",
+	"LPrRmy0s9vFh8QHY5Q6HPBGcAdMKBzssQQnOFNh/ljQRMXzMfjK/hJxpYNr8qeFe+yImlJn/VLiGhNjf",
+	"HwTgACstKbvD+/3ewxGoUFKhKWc4wG+RsnpRbgvx2y8QamxEUz3W+gU3UvdX6WCww0JyAVLT1Ln3Ucka",
+	"ZRruQOK9h9+rt1FCWWnwlvMYCDODhbJvJaxwgL/xi/j9zLh/Vfgj4euGSohw8Dmf7BnThZ2bitqqjysq",
+	"lb4kCTQA42HJ46YBx6qV8kqqbiymlK24mRzTELLFYdYQ/vD+k9GuqTbq8SdQGi1BbkFiD29BqnQZFrP5",
+	"bG4EuQBGBMUBfjObzxbYw4LotfXfz9Y7jc/fCSJJsjcjd2DDNcESs65mNfAvoC/KE6wqSRLQIBUOPlfy",
+	"hwgR09BO9r8o7mRR1/JUEyNDAwfWbezlMFjLuIyllhvY33jVHD+fz9vsHeR8ZyPsrU0/5PxvCt1oWIka",
+	"DNUNISRNqKZbIwj3IuYR4GBFYgVZYGGuJg8NeyWoVlwmRKeb4M059mp7Yu8NsmjgaTEIj7aYWYkQkZI8",
+	"DDVLKmaphkQNsn/4JbXW4E/NjS68p3PjAAvPN8wgXHjFoWFU5pquW+yC4DiLU233aiRhKlBg2BhByHEd",
+	"BDOGlCZSU3aH/qF6jdgmuQXZpmWhKkC41O2ySwQrson1sQwDbJOoVoJ5xzbJtSEW1ccw1/lgGqJRi7Yk",
+	"3oDK4/y6AflQhAlWtV5fZyRaRGxGcPB5MZ975/P5jXcMGSwtXEgc41U6t9UvLMEczncSgOEbr3eBzuc/",
+	"4MD1j3GUZ262EGsgEcguqv81lXgs1a9zNVnIf51dl6ZMSvodps/eZTz1JMdA3ZG3RrrZiSc7FFq8euaj",
+	"oe5VypPNYE1xUrR58OoOjHogmaI8oCOOD1fn4myZSZ/9SfX67DKXfrIjJSa3EGfJYRPY380sZX3Xea//",
+	"3Z1WZ7qm9BxyJT/NBvKw0g/2wWMjxKe86Jcxy59CY0FrexGdArUhu2tyfC55U1b141Od1wFQmXT+R3l1",
+	"iL+aWSOA602txyD3InLrcBEZjk752uTgchQOAxJnOhASoiW9d/YXjbrZ50Nt0jHsQ6PJN1Ya3XSAHTbW",
+	"KMSOJ+weyMbtqMnAqfE1jQaAcwK2fs0ZVSfrcag9gqpfR1aVmPrHQdCM4+keEEaQ9AQICKLUp7Xkm7v1",
+	"kFL5dSHeWSgf0Wh5ljK4LdJcFP62xftHWW5oZ2DLohncE2P3+0lfgenrjaAtsIhL9Nvy6hIlEFGCLM7N",
+	"BaltyOvQjnnGZmtYaoAd3VYz2QcR0mlGIaIQVR5SlIWAqEZropCCLUgSl+Jqq7QJjU+eIj8BiKJR1pYl",
+	"JameWloEILqLI05QUar6aBp1Mqrgkqjw+dTPdQe5Kxs8iYcheJAedIlx0FrRWIPsBKva7IwNkTf3ZFlb",
+	"C5SB0hA16LJX6KYZiv5bVpWVW/bFZaC8l6q/PN2C/cxl0kuFVqgnxwfVS52FO1nXrMDLTMUj66WOV0/m",
+	"1LC6qYvZ9B01x+IpDB5C7Svtu9FO00DuiPZ0BlFGBS12uttzz1xhdpw9riPpKBnZkHzEIZ6fAxe9nz18",
+	"rEr2cFyuF43qyUsy0SuyviQHB8tZ3+yU6HSqIR0dBskObDT2Aw0+doOd+NRLv+iqFhQGFMiXtWkvt62Q",
+	"hnjSa3EFtco3ViNgezmNhckQcktV/W/rZcO8F9xamB654V/wLZsmvojmwmQolYpWQ/F5xvbCFDBk9w3T",
+	"k05b0v5uMQCK2rQJC1mLiStZBmH7lWzq90bGOMBrrUXg+9knshqUnkUAIiFiRije3+z/GwCBD1avQC0A",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -3491,3 +5077,29 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+var (
+	swaggerOnce      sync.Once
+	cachedSwagger    *openapi3.T
+	cachedSwaggerErr error
+)
+
+// GetSwaggerCached is like GetSwagger, but only loads the specification the
+// first time it's called. Every caller gets the same *openapi3.T, which must
+// not be modified.
+func GetSwaggerCached() (*openapi3.T, error) {
+	swaggerOnce.Do(func() {
+		cachedSwagger, cachedSwaggerErr = GetSwagger()
+	})
+	return cachedSwagger, cachedSwaggerErr
+}
+
+// MustGetSwagger is like GetSwaggerCached, but panics if the specification
+// can't be loaded.
+func MustGetSwagger() *openapi3.T {
+	swagger, err := GetSwaggerCached()
+	if err != nil {
+		panic(fmt.Sprintf("error loading embedded swagger spec: %s", err))
+	}
+	return swagger
+}
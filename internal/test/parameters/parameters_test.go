@@ -23,8 +23,11 @@ type testServer struct {
 	primitive       *int32
 	primitiveString *string
 	cookieParams    *GetCookieParams
+	requiredCookies *GetRequiredCookieParams
 	queryParams     *GetQueryFormParams
 	headerParams    *GetHeaderParams
+	optionalFilter  *GetDeepObjectOptionalParams
+	enumParams      *EnumParamsParams
 }
 
 func (t *testServer) reset() {
@@ -36,8 +39,11 @@ func (t *testServer) reset() {
 	t.primitive = nil
 	t.primitiveString = nil
 	t.cookieParams = nil
+	t.requiredCookies = nil
 	t.queryParams = nil
 	t.headerParams = nil
+	t.optionalFilter = nil
+	t.enumParams = nil
 }
 
 // (GET /contentObject/{param})
@@ -82,6 +88,18 @@ func (t *testServer) GetMatrixExplodeObject(ctx echo.Context, param Object) erro
 	return nil
 }
 
+// (GET /labelPrimitive/{.param})
+func (t *testServer) GetLabelPrimitive(ctx echo.Context, param int32) error {
+	t.primitive = &param
+	return nil
+}
+
+// (GET /matrixPrimitive/{;id})
+func (t *testServer) GetMatrixPrimitive(ctx echo.Context, id int32) error {
+	t.primitive = &id
+	return nil
+}
+
 // (GET /matrixNoExplodeArray/{.param})
 func (t *testServer) GetMatrixNoExplodeArray(ctx echo.Context, param []int32) error {
 	t.array = param
@@ -130,12 +148,25 @@ func (t *testServer) GetStartingWithNumber(ctx echo.Context, n1param string) err
 	return nil
 }
 
+// (GET /queryContent)
+func (t *testServer) GetQueryContent(ctx echo.Context, params GetQueryContentParams) error {
+	t.complexObject = &params.Vco
+	t.passThrough = params.Pt
+	return nil
+}
+
 // (GET /queryDeepObject)
 func (t *testServer) GetDeepObject(ctx echo.Context, params GetDeepObjectParams) error {
 	t.complexObject = &params.DeepObj
 	return nil
 }
 
+// (GET /queryDeepObjectOptional)
+func (t *testServer) GetDeepObjectOptional(ctx echo.Context, params GetDeepObjectOptionalParams) error {
+	t.optionalFilter = &params
+	return nil
+}
+
 // (GET /simplePrimitive/{param})
 func (t *testServer) GetSimplePrimitive(ctx echo.Context, param int32) error {
 	t.primitive = &param
@@ -205,6 +236,12 @@ func (t *testServer) GetHeader(ctx echo.Context, params GetHeaderParams) error {
 	return nil
 }
 
+// (GET /requiredCookie)
+func (t *testServer) GetRequiredCookie(ctx echo.Context, params GetRequiredCookieParams) error {
+	t.requiredCookies = &params
+	return nil
+}
+
 // (GET /cookie)
 func (t *testServer) GetCookie(ctx echo.Context, params GetCookieParams) error {
 	t.cookieParams = &params
@@ -237,7 +274,8 @@ func (t *testServer) GetCookie(ctx echo.Context, params GetCookieParams) error {
 
 // (GET /enums)
 func (t *testServer) EnumParams(ctx echo.Context, params EnumParamsParams) error {
-	return ctx.NoContent(http.StatusNotImplemented)
+	t.enumParams = &params
+	return ctx.NoContent(http.StatusNoContent)
 }
 
 func TestParameterBinding(t *testing.T) {
@@ -333,6 +371,23 @@ func TestParameterBinding(t *testing.T) {
 	assert.EqualValues(t, &expectedObject, ts.object)
 	ts.reset()
 
+	//  (GET /labelPrimitive/{.param})
+	result = testutil.NewRequest().Get("/labelPrimitive/.5").Go(t, e)
+	assert.Equal(t, http.StatusOK, result.Code())
+	assert.EqualValues(t, &expectedPrimitive, ts.primitive)
+	ts.reset()
+
+	// The prefix of the style is required
+	result = testutil.NewRequest().Get("/labelPrimitive/5").Go(t, e)
+	assert.Equal(t, http.StatusBadRequest, result.Code())
+	ts.reset()
+
+	//  (GET /matrixPrimitive/{;id})
+	result = testutil.NewRequest().Get("/matrixPrimitive/;id=5").Go(t, e)
+	assert.Equal(t, http.StatusOK, result.Code())
+	assert.EqualValues(t, &expectedPrimitive, ts.primitive)
+	ts.reset()
+
 	//  (GET /simpleExplodeArray/{param*})
 	result = testutil.NewRequest().Get("/simpleExplodeArray/3,4,5").Go(t, e)
 	assert.Equal(t, http.StatusOK, result.Code())
@@ -524,6 +579,44 @@ func doRequest(t *testing.T, e *echo.Echo, code int, req *http.Request) *httptes
 	return rec
 }
 
+func TestEnumParams(t *testing.T) {
+	var ts testServer
+	e := echo.New()
+	RegisterHandlers(e, &ts)
+
+	tests := []struct {
+		query   string
+		status  int
+		message string
+	}{
+		{query: "", status: http.StatusNoContent},
+		{query: "?enumPathParam=200&enumStringParam=green", status: http.StatusNoContent},
+		{query: "?enumPathParam=300", status: http.StatusBadRequest, message: "Invalid format for parameter enumPathParam: must be one of 100, 200"},
+		{query: "?enumStringParam=blue", status: http.StatusBadRequest, message: `Invalid format for parameter enumStringParam: must be one of "red", "green"`},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			ts.reset()
+			result := testutil.NewRequest().Get("/enums"+test.query).GoWithHTTPHandler(t, e)
+			require.Equal(t, test.status, result.Code())
+			if test.status != http.StatusNoContent {
+				var body map[string]string
+				require.NoError(t, result.UnmarshalBodyToObject(&body))
+				assert.Equal(t, test.message, body["message"])
+				assert.Nil(t, ts.enumParams, "the handler shouldn't be called")
+			}
+		})
+	}
+
+	// The client takes the enum types, with their constants
+	var clientParams EnumParamsParams
+	size, color := N200, Green
+	clientParams.EnumPathParam, clientParams.EnumStringParam = &size, &color
+	req, err := NewEnumParamsRequest("http://example.com", &clientParams)
+	require.NoError(t, err)
+	assert.Equal(t, "enumPathParam=200&enumStringParam=green", req.URL.RawQuery)
+}
+
 func TestClientPathParams(t *testing.T) {
 	var ts testServer
 	e := echo.New()
@@ -616,6 +709,18 @@ func TestClientPathParams(t *testing.T) {
 	assert.EqualValues(t, &expectedObject, ts.object)
 	ts.reset()
 
+	req, err = NewGetLabelPrimitiveRequest(server, expectedPrimitive)
+	assert.NoError(t, err)
+	doRequest(t, e, http.StatusOK, req)
+	assert.EqualValues(t, &expectedPrimitive, ts.primitive)
+	ts.reset()
+
+	req, err = NewGetMatrixPrimitiveRequest(server, expectedPrimitive)
+	assert.NoError(t, err)
+	doRequest(t, e, http.StatusOK, req)
+	assert.EqualValues(t, &expectedPrimitive, ts.primitive)
+	ts.reset()
+
 	// Simple style
 	req, err = NewGetSimpleExplodeArrayRequest(server, expectedArray)
 	assert.NoError(t, err)
@@ -699,6 +804,56 @@ func TestClientQueryParams(t *testing.T) {
 	assert.EqualValues(t, qParams, *ts.queryParams)
 	ts.reset()
 
+	// Unexploded arrays and objects are sent as a single comma joined value,
+	// as the server expects, with only the values themselves escaped
+	expectedArray3 := []int32{3, 4, 5}
+	expectedPrimitiveString2 := "a,b"
+	req, err = NewGetQueryFormRequest(server, &GetQueryFormParams{
+		Ea: &expectedArray1,
+		A:  &expectedArray3,
+		O:  &expectedObject1,
+		Ps: &expectedPrimitiveString2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ea=3&ea=4&ea=5&ps=a%2Cb&a=3,4,5&o=firstName,Alex,role,admin", req.URL.RawQuery)
+	doRequest(t, e, http.StatusOK, req)
+	require.NotNil(t, ts.queryParams)
+	assert.EqualValues(t, expectedArray3, *ts.queryParams.A)
+	assert.EqualValues(t, expectedPrimitiveString2, *ts.queryParams.Ps)
+	ts.reset()
+
+	// Query params with content are JSON encoded, or passed through as is
+	// when they have several media types, and escaped either way
+	passThrough := `{"a": 1} & more`
+	req, err = NewGetQueryContentRequest(server, &GetQueryContentParams{
+		Vco: expectedComplexObject,
+		Pt:  &passThrough,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "pt=%7B%22a%22%3A+1%7D+%26+more&vco=%7B%22Id%22%3A12345%2C%22IsAdmin%22%3Atrue%2C%22Object%22%3A%7B%22firstName%22%3A%22Marcin%22%2C%22role%22%3A%22annoyed_at_swagger%22%7D%7D", req.URL.RawQuery)
+	doRequest(t, e, http.StatusOK, req)
+	assert.EqualValues(t, &expectedComplexObject, ts.complexObject)
+	require.NotNil(t, ts.passThrough)
+	assert.Equal(t, passThrough, *ts.passThrough)
+	ts.reset()
+
+	// Optional fields of deepObjects which are nil are left out, and
+	// nested objects are subscripted
+	name := "shelf"
+	size := float32(2.5)
+	var optionalParams GetDeepObjectOptionalParams
+	optionalParams.Filter.Name = &name
+	optionalParams.Filter.Nested = &struct {
+		Label *string  `json:"label,omitempty"`
+		Size  *float32 `json:"size,omitempty"`
+	}{Size: &size}
+	req, err = NewGetDeepObjectOptionalRequest(server, &optionalParams)
+	require.NoError(t, err)
+	assert.Equal(t, "filter%5Bname%5D=shelf&filter%5Bnested%5D%5Bsize%5D=2.5", req.URL.RawQuery)
+	doRequest(t, e, http.StatusOK, req)
+	assert.EqualValues(t, &optionalParams, ts.optionalFilter)
+	ts.reset()
+
 	// Check cookie params
 	cParams := GetCookieParams{
 		Ea:  &expectedArray1,
@@ -717,6 +872,31 @@ func TestClientQueryParams(t *testing.T) {
 	assert.EqualValues(t, cParams, *ts.cookieParams)
 	ts.reset()
 
+	// Required cookie params are always sent, optional ones only when set
+	rcParams := GetRequiredCookieParams{
+		Ra: expectedArray1,
+		Rp: expectedStartingWithNumber,
+	}
+	req, err = NewGetRequiredCookieRequest(server, &rcParams)
+	require.NoError(t, err)
+	assertCookie(t, req, "ra", "3,4,5")
+	assertCookie(t, req, "rp", "111")
+	_, err = req.Cookie("op")
+	assert.ErrorIs(t, err, http.ErrNoCookie)
+	doRequest(t, e, http.StatusOK, req)
+	require.NotNil(t, ts.requiredCookies)
+	assert.EqualValues(t, rcParams, *ts.requiredCookies)
+	ts.reset()
+
+	rcParams.Op = &expectedPrimitive1
+	req, err = NewGetRequiredCookieRequest(server, &rcParams)
+	require.NoError(t, err)
+	assertCookie(t, req, "op", "5")
+	doRequest(t, e, http.StatusOK, req)
+	require.NotNil(t, ts.requiredCookies)
+	assert.EqualValues(t, rcParams, *ts.requiredCookies)
+	ts.reset()
+
 	// Check Header parameters
 	hParams := GetHeaderParams{
 		XArrayExploded:       &expectedArray1,
@@ -735,3 +915,10 @@ func TestClientQueryParams(t *testing.T) {
 	assert.EqualValues(t, hParams, *ts.headerParams)
 	ts.reset()
 }
+
+func assertCookie(t *testing.T, req *http.Request, name, value string) {
+	t.Helper()
+	cookie, err := req.Cookie(name)
+	require.NoError(t, err)
+	assert.Equal(t, value, cookie.Value)
+}
@@ -0,0 +1,45 @@
+package patternproperties
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatternProperties(t *testing.T) {
+	var service Service
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "billing",
+		"extensions": {"x-team": "payments"},
+		"owners": {"alice": {"email": "alice@example.com"}},
+		"endpoints": {"/invoices": {"method": "GET"}}
+	}`), &service))
+	assert.Equal(t, Extensions{"x-team": "payments"}, *service.Extensions)
+	assert.Equal(t, "alice@example.com", *(*service.Owners)["alice"].Email)
+	assert.Equal(t, "GET", *(*service.Endpoints)["/invoices"].Method)
+
+	err := json.Unmarshal([]byte(`{"name": "billing", "extensions": {"x-team": "payments", "team": "payments"}}`), &service)
+	assert.EqualError(t, err, `property "team" of Extensions doesn't match its pattern ^x-`)
+
+	err = json.Unmarshal([]byte(`{"name": "billing", "owners": {"Alice": {}}}`), &service)
+	assert.EqualError(t, err, `property "Alice" of Service_Owners doesn't match its pattern ^[a-z]+$`)
+
+	// Values which don't have the type of their pattern's schema are rejected
+	var extensions Extensions
+	assert.Error(t, json.Unmarshal([]byte(`{"x-count": 1}`), &extensions))
+}
+
+func TestPatternPropertiesValidate(t *testing.T) {
+	// Values of several patterns, with different types, are interface{}
+	limits := Limits{"count-users": 10, "size-disk": "10GB"}
+	assert.NoError(t, limits.Validate())
+
+	limits["memory"] = "1GB"
+	assert.EqualError(t, limits.Validate(), `property "memory" of Limits doesn't match any of its patterns [^count-[a-z]+$ ^size-[a-z]+$]`)
+
+	var decoded Limits
+	require.NoError(t, json.Unmarshal([]byte(`{"count-users": 10, "size-disk": "10GB"}`), &decoded))
+	assert.Equal(t, Limits{"count-users": float64(10), "size-disk": "10GB"}, decoded)
+}
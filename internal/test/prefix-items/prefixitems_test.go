@@ -0,0 +1,52 @@
+package prefixitems
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixItems(t *testing.T) {
+	altitude := 120.5
+	feature := Feature{
+		Position: Position{Item0: 4.9, Item1: 52.4, Item2: &altitude},
+		Label: &Label{
+			Item0: "route",
+			Rest:  []Position{{Item0: 1, Item1: 2}, {Item0: 3, Item1: 4}},
+		},
+		Range: &Feature_Range{Item0: 1, Item1: 10},
+	}
+	b, err := json.Marshal(feature)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"position":[4.9,52.4,120.5],"label":["route",[1,2],[3,4]],"range":[1,10]}`, string(b))
+
+	var decoded Feature
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, feature, decoded)
+
+	// Optional items which aren't set are left out
+	b, err = json.Marshal(Position{Item0: 4.9, Item1: 52.4})
+	require.NoError(t, err)
+	assert.Equal(t, `[4.9,52.4]`, string(b))
+
+	var position Position
+	require.NoError(t, json.Unmarshal([]byte(`[4.9,52.4]`), &position))
+	assert.Equal(t, Position{Item0: 4.9, Item1: 52.4}, position)
+
+	var label Label
+	require.NoError(t, json.Unmarshal([]byte(`["alone"]`), &label))
+	assert.Equal(t, Label{Item0: "alone"}, label)
+}
+
+func TestPrefixItemsErrors(t *testing.T) {
+	var position Position
+	assert.EqualError(t, json.Unmarshal([]byte(`[4.9]`), &position), "Position must have at least 2 items, got 1")
+	assert.EqualError(t, json.Unmarshal([]byte(`[1,2,3,4]`), &position), "Position must have at most 3 items, got 4")
+	assert.EqualError(t, json.Unmarshal([]byte(`[1,"north"]`), &position), "error unmarshaling item 1 of Position: json: cannot unmarshal string into Go value of type float64")
+	assert.Error(t, json.Unmarshal([]byte(`{"longitude":1}`), &position))
+
+	var label Label
+	assert.EqualError(t, json.Unmarshal([]byte(`["route",[1,2],[3]]`), &label), "error unmarshaling item 2 of Label: Position must have at least 2 items, got 1")
+}
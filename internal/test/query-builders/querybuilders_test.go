@@ -0,0 +1,101 @@
+package querybuilders
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// server records the query and headers of the last request
+type server struct {
+	query  url.Values
+	header http.Header
+}
+
+func (s *server) SearchPets(w http.ResponseWriter, r *http.Request, shop string, params SearchPetsParams) {
+	s.query, s.header = r.URL.Query(), r.Header
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode([]Pet{{Name: shop}})
+}
+
+func (s *server) AddPet(w http.ResponseWriter, r *http.Request, shop string, params AddPetParams) {
+	s.query, s.header = r.URL.Query(), r.Header
+	var pet Pet
+	_ = json.NewDecoder(r.Body).Decode(&pet)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(pet)
+}
+
+func (s *server) GetPet(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func TestQueryBuilders(t *testing.T) {
+	var s server
+	ts := httptest.NewServer(Handler(&s))
+	defer ts.Close()
+	ctx := context.Background()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	t.Run("only what's set", func(t *testing.T) {
+		rsp, err := client.SearchPetsQuery("north").WithName("rex").WithLimit(10).Do(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, rsp.JSON200)
+		assert.Equal(t, []Pet{{Name: "north"}}, *rsp.JSON200)
+		assert.Equal(t, url.Values{"name": {"rex"}, "limit": {"10"}}, s.query)
+		assert.Empty(t, s.header.Get("X-Request-Id"))
+	})
+
+	t.Run("all", func(t *testing.T) {
+		bornAfter := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+		builder := client.SearchPetsQuery("north").
+			WithName("rex").
+			WithKind(Dog).
+			WithTags([]string{"a", "b"}).
+			WithLimit(5).
+			WithBornAfter(bornAfter).
+			WithXRequestId("abc")
+		kind := Dog
+		assert.Equal(t, SearchPetsParams{
+			Name:       ptr("rex"),
+			Kind:       &kind,
+			Tags:       &[]string{"a", "b"},
+			Limit:      5,
+			BornAfter:  &bornAfter,
+			XRequestId: ptr("abc"),
+		}, builder.Params())
+
+		_, err := builder.Do(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, url.Values{
+			"name":      {"rex"},
+			"kind":      {"dog"},
+			"tags":      {"a", "b"},
+			"limit":     {"5"},
+			"bornAfter": {"2024-05-06T07:08:09Z"},
+		}, s.query)
+		assert.Equal(t, "abc", s.header.Get("X-Request-Id"))
+	})
+
+	t.Run("body", func(t *testing.T) {
+		rsp, err := client.AddPetQuery("north").WithDryRun(true).Do(ctx, AddPetJSONRequestBody{Name: "rex"})
+		require.NoError(t, err)
+		require.NotNil(t, rsp.JSON201)
+		assert.Equal(t, Pet{Name: "rex"}, *rsp.JSON201)
+		assert.Equal(t, url.Values{"dryRun": {"true"}}, s.query)
+	})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
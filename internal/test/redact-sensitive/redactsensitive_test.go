@@ -0,0 +1,32 @@
+package redactsensitive
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactSensitive(t *testing.T) {
+	password := "secret"
+	apiKey := "key"
+	pin := 1234
+	credentials := Credentials{Username: "alice", Password: &password, ApiKey: &apiKey, Pin: &pin}
+
+	s := fmt.Sprint(credentials)
+	assert.Equal(t, "{ApiKey:*** Password:*** Pin:1234 Scopes:<nil> Username:alice}", s)
+	assert.Equal(t, s, fmt.Sprintf("%+v", credentials))
+	assert.Equal(t, s, fmt.Sprintf("%v", &credentials))
+	assert.Equal(t, `redactsensitive.Credentials{ApiKey:"***", Password:"***", Pin:1234, Scopes:nil, Username:"alice"}`, fmt.Sprintf("%#v", credentials))
+
+	// So are the elements of slices and maps
+	assert.Equal(t, "[{ApiKey:*** Password:*** Pin:1234 Scopes:<nil> Username:alice}]", fmt.Sprint([]Credentials{credentials}))
+	assert.NotContains(t, fmt.Sprint(map[string]ServiceCredentials{"service": credentials}), "secret")
+
+	// JSON still has the values
+	b, err := json.Marshal(credentials)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"username":"alice","password":"secret","apiKey":"key","pin":1234}`, string(b))
+}
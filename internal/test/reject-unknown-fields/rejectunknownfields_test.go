@@ -0,0 +1,42 @@
+package rejectunknownfields
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectUnknownFields(t *testing.T) {
+	var order Order
+	require.NoError(t, json.Unmarshal([]byte(`{"id": 1, "status": "open", "note": {"text": "hi"}}`), &order))
+	assert.Equal(t, 1, order.Id)
+	require.NotNil(t, order.Status)
+	assert.Equal(t, Open, *order.Status)
+	assert.Equal(t, "hi", *order.Note.Text)
+
+	err := json.Unmarshal([]byte(`{"id": 1, "extra": true}`), &order)
+	assert.EqualError(t, err, `unknown property "extra" in Order`)
+
+	// Note allows additional properties, even inside an Order
+	require.NoError(t, json.Unmarshal([]byte(`{"id": 1, "note": {"text": "hi", "extra": true}}`), &order))
+
+	// Enums and unions keep unmarshaling as usual
+	require.NoError(t, json.Unmarshal([]byte(`{"id": 1, "payment": {"number": "4111"}}`), &order))
+	card, err := order.Payment.AsCard()
+	require.NoError(t, err)
+	assert.Equal(t, "4111", *card.Number)
+	require.NoError(t, json.Unmarshal([]byte(`{"id": 1, "payment": {"text": "cash"}}`), &order))
+	_, err = order.Payment.AsCard()
+	assert.EqualError(t, err, `unknown property "text" in Card`)
+
+	// Request bodies are checked too
+	var body CreateOrderJSONRequestBody
+	require.NoError(t, json.Unmarshal([]byte(`{"order": {"id": 2}}`), &body))
+	assert.Equal(t, 2, body.Order.Id)
+	err = json.Unmarshal([]byte(`{"order": {"id": 2}, "priority": 1}`), &body)
+	assert.EqualError(t, err, `unknown property "priority" in CreateOrderJSONBody`)
+	err = json.Unmarshal([]byte(`{"order": {"id": 2, "priority": 1}}`), &body)
+	assert.EqualError(t, err, `unknown property "priority" in Order`)
+}
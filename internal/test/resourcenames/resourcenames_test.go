@@ -0,0 +1,60 @@
+package resourcenames
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoServer responds with the value of the path parameter of each operation.
+type echoServer struct{}
+
+func (echoServer) GetFile(w http.ResponseWriter, r *http.Request, path string) {
+	_, _ = w.Write([]byte(path))
+}
+
+func (echoServer) GetBook(w http.ResponseWriter, r *http.Request, name string) {
+	_, _ = w.Write([]byte(name))
+}
+
+func (echoServer) ListBooks(w http.ResponseWriter, r *http.Request, name string) {
+	_, _ = w.Write([]byte(name))
+}
+
+func TestResourceNames(t *testing.T) {
+	server := httptest.NewServer(Handler(echoServer{}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	book, err := client.GetBookWithResponse(ctx, "shelves/1/books/war and peace")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, book.StatusCode())
+	assert.Equal(t, "shelves/1/books/war and peace", string(book.Body))
+
+	books, err := client.ListBooksWithResponse(ctx, "shelves/1")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, books.StatusCode())
+	assert.Equal(t, "shelves/1", string(books.Body))
+
+	file, err := client.GetFileWithResponse(ctx, "docs/2023/report.pdf")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, file.StatusCode())
+	assert.Equal(t, "docs/2023/report.pdf", string(file.Body))
+
+	// The client won't send names which don't match the pattern
+	_, err = client.GetBookWithResponse(ctx, "shelves/1")
+	assert.EqualError(t, err, `path parameter name, "shelves/1", doesn't match the resource name pattern shelves/*/books/*`)
+
+	// and the server won't route them
+	rsp, err := http.Get(server.URL + "/v1/shelves/1/magazines/2")
+	require.NoError(t, err)
+	_ = rsp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, rsp.StatusCode)
+}
@@ -7,15 +7,18 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"sync"
 
 	"gopkg.in/yaml.v2"
 
@@ -52,16 +55,18 @@ type CustomStringType = string
 type DeprecatedProperty struct {
 	// NewProp Use this now!
 	NewProp string `json:"newProp"`
-	// Deprecated:
+	// Deprecated: this property is deprecated.
 	OldProp1 *string `json:"oldProp1,omitempty"`
 
 	// OldProp2 It used to do this and that
-	// Deprecated:
+	//
+	// Deprecated: this property is deprecated.
 	OldProp2 *string `json:"oldProp2,omitempty"`
 	// Deprecated: Use NewProp instead!
 	OldProp3 *string `json:"oldProp3,omitempty"`
 
 	// OldProp4 It used to do this and that
+	//
 	// Deprecated: Use NewProp instead!
 	OldProp4 *string `json:"oldProp4,omitempty"`
 }
@@ -85,6 +90,32 @@ type NullableProperties struct {
 	RequiredAndNullable *string `json:"requiredAndNullable"`
 }
 
+// RecursiveExtended defines model for RecursiveExtended.
+type RecursiveExtended struct {
+	Self *RecursiveExtended `json:"self"`
+	Wife RecursiveWife      `json:"wife"`
+}
+
+// RecursiveHusband defines model for RecursiveHusband.
+type RecursiveHusband struct {
+	Wife *RecursiveWife `json:"wife"`
+}
+
+// RecursiveNode defines model for RecursiveNode.
+type RecursiveNode struct {
+	Children []RecursiveNode `json:"children"`
+	Meta     struct {
+		Origin *RecursiveNode `json:"origin"`
+	} `json:"meta"`
+	Parent *RecursiveNode `json:"parent"`
+	Value  string         `json:"value"`
+}
+
+// RecursiveWife defines model for RecursiveWife.
+type RecursiveWife struct {
+	Husband *RecursiveHusband `json:"husband"`
+}
+
 // StringInPath defines model for StringInPath.
 type StringInPath = string
 
@@ -105,6 +136,22 @@ type Issue9JSONRequestBody = Issue9JSONBody
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which is called with every response received, whatever its status.
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// operationIDContextKey is the key of the ID of the operation being called in
+// the contexts which the client passes to request and response editors.
+type operationIDContextKey struct{}
+
+// OperationID returns the ID of the operation being called, as generated from
+// its operationId, from the context passed to request and response editors, so
+// that they can act differently depending on the operation.
+func OperationID(ctx context.Context) (string, bool) {
+	operationID, ok := ctx.Value(operationIDContextKey{}).(string)
+	return operationID, ok
+}
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -127,6 +174,28 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// A list of callbacks for inspecting or modifying responses, which are
+	// called as soon as they're received. If one returns an error, the
+	// response body is closed and the error is returned instead of the response.
+	ResponseEditors []ResponseEditorFn
+
+	// The policy for retrying failed requests, set by WithRetry.
+	retryPolicy *runtime.RetryPolicy
+
+	// The TLS configuration and proxy of the default http.Client, set by
+	// WithTLSConfig and WithProxy.
+	tlsConfig *tls.Config
+	proxy     func(*http.Request) (*url.URL, error)
+
+	// The transport of the default http.Client, set by WithRoundTripper, and
+	// the functions wrapping it, set by WithTransportMiddleware.
+	roundTripper         http.RoundTripper
+	transportMiddlewares []func(http.RoundTripper) http.RoundTripper
+
+	// Whether responses keep the request which was sent, set by
+	// WithCaptureRequest.
+	captureRequest bool
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -151,6 +220,37 @@ func NewClient(server string, opts ...ClientOption) (*Client, error) {
 	// create httpClient, if not already present
 	if client.Client == nil {
 		client.Client = &http.Client{}
+		if client.tlsConfig != nil || client.proxy != nil || client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+			var transport http.RoundTripper = http.DefaultTransport
+			if client.roundTripper != nil {
+				transport = client.roundTripper
+			}
+			if client.tlsConfig != nil || client.proxy != nil {
+				base, ok := transport.(*http.Transport)
+				if !ok {
+					return nil, errors.New("WithTLSConfig and WithProxy can only be combined with a WithRoundTripper of an *http.Transport")
+				}
+				t := base.Clone()
+				if client.tlsConfig != nil {
+					t.TLSClientConfig = client.tlsConfig
+				}
+				if client.proxy != nil {
+					t.Proxy = client.proxy
+				}
+				transport = t
+			}
+			for _, wrap := range client.transportMiddlewares {
+				transport = wrap(transport)
+			}
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.tlsConfig != nil || client.proxy != nil {
+		return nil, errors.New("WithTLSConfig and WithProxy only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	} else if client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+		return nil, errors.New("WithRoundTripper and WithTransportMiddleware only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	}
+	if client.retryPolicy != nil {
+		client.Client = runtime.NewRetryDoer(client.Client, *client.retryPolicy)
 	}
 	return &client, nil
 }
@@ -164,6 +264,77 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	}
 }
 
+// WithTLSConfig sets the TLS configuration, such as the certificates to
+// trust or present, of the http.Client which the client creates. It can't be
+// combined with WithHTTPClient, whose Doer should be configured instead.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		c.tlsConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function which chooses the proxy for each request made
+// by the http.Client which the client creates, such as http.ProxyURL. It
+// can't be combined with WithHTTPClient, whose Doer should be configured
+// instead.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *Client) error {
+		c.proxy = proxy
+		return nil
+	}
+}
+
+// WithRoundTripper sets the transport of the http.Client which the client
+// creates, in place of http.DefaultTransport. When it's an *http.Transport,
+// the TLS configuration and proxy set by WithTLSConfig and WithProxy apply to
+// a copy of it. It can't be combined with WithHTTPClient, whose Doer should
+// be configured instead.
+func WithRoundTripper(roundTripper http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		c.roundTripper = roundTripper
+		return nil
+	}
+}
+
+// WithTransportMiddleware wraps the transport of the http.Client which the
+// client creates, including any TLS configuration and proxy set by
+// WithTLSConfig and WithProxy, with the http.RoundTripper which wrap returns,
+// such as one which logs or traces requests. Each call wraps the transport of
+// the previous ones. It can't be combined with WithHTTPClient, whose Doer
+// should be configured instead.
+func WithTransportMiddleware(wrap func(next http.RoundTripper) http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		c.transportMiddlewares = append(c.transportMiddlewares, wrap)
+		return nil
+	}
+}
+
+// WithRetry makes the client retry requests which fail with a network error
+// or a retryable status code, with exponential backoff which honors any
+// Retry-After header. Unless the policy says otherwise, only GET, PUT, DELETE
+// and HEAD requests are retried, on 502, 503 and 504 responses. The retries
+// wrap whichever Doer the client ends up with, including one set by
+// WithHTTPClient.
+func WithRetry(policy runtime.RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithCaptureRequest makes the client keep a copy of the request which each
+// response answers, after any redirects, as its Request, and so as the
+// HTTPRequest of the responses of the WithResponse methods, for debugging
+// retries. The copy has the URL and headers of the request, but not its body,
+// so that the body can be garbage collected.
+func WithCaptureRequest(capture bool) ClientOption {
+	return func(c *Client) error {
+		c.captureRequest = capture
+		return nil
+	}
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -173,6 +344,16 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called right after receiving a response, before it's parsed. This can be
+// used for metrics, or to turn error responses into errors.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// EnsureEverythingIsReferenced request
@@ -205,6 +386,9 @@ type ClientInterface interface {
 
 	// Issue975 request
 	Issue975(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecursive request
+	GetRecursive(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) EnsureEverythingIsReferenced(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -212,11 +396,12 @@ func (c *Client) EnsureEverythingIsReferenced(ctx context.Context, reqEditors ..
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "EnsureEverythingIsReferenced")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) Issue127(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -224,11 +409,12 @@ func (c *Client) Issue127(ctx context.Context, reqEditors ...RequestEditorFn) (*
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "Issue127")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) Issue185WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -236,11 +422,12 @@ func (c *Client) Issue185WithBody(ctx context.Context, contentType string, body
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "Issue185")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) Issue185(ctx context.Context, body Issue185JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -248,11 +435,12 @@ func (c *Client) Issue185(ctx context.Context, body Issue185JSONRequestBody, req
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "Issue185")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) Issue209(ctx context.Context, str StringInPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -260,11 +448,12 @@ func (c *Client) Issue209(ctx context.Context, str StringInPath, reqEditors ...R
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "Issue209")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) Issue30(ctx context.Context, pFallthrough string, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -272,11 +461,12 @@ func (c *Client) Issue30(ctx context.Context, pFallthrough string, reqEditors ..
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "Issue30")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) GetIssues375(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -284,11 +474,12 @@ func (c *Client) GetIssues375(ctx context.Context, reqEditors ...RequestEditorFn
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetIssues375")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) Issue41(ctx context.Context, n1param N5StartsWithNumber, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -296,11 +487,12 @@ func (c *Client) Issue41(ctx context.Context, n1param N5StartsWithNumber, reqEdi
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "Issue41")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) Issue9WithBody(ctx context.Context, params *Issue9Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -308,11 +500,12 @@ func (c *Client) Issue9WithBody(ctx context.Context, params *Issue9Params, conte
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "Issue9")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) Issue9(ctx context.Context, params *Issue9Params, body Issue9JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -320,11 +513,12 @@ func (c *Client) Issue9(ctx context.Context, params *Issue9Params, body Issue9JS
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "Issue9")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) Issue975(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -332,11 +526,25 @@ func (c *Client) Issue975(ctx context.Context, reqEditors ...RequestEditorFn) (*
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "Issue975")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
+}
+
+func (c *Client) GetRecursive(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecursiveRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "GetRecursive")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
 }
 
 // NewEnsureEverythingIsReferencedRequest generates requests for EnsureEverythingIsReferenced
@@ -645,6 +853,67 @@ func NewIssue975Request(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewGetRecursiveRequest generates requests for GetRecursive
+func NewGetRecursiveRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recursive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// do sends the request, then calls the response editors.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	rsp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.captureRequest {
+		rsp.Request = captureRequest(rsp, req)
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(ctx, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// capturedRequestContextKey marks the copies of requests which clients made
+// WithCaptureRequest keep in their responses.
+type capturedRequestContextKey struct{}
+
+// captureRequest returns a copy of the request which rsp answers, falling
+// back to req, without its body.
+func captureRequest(rsp *http.Response, req *http.Request) *http.Request {
+	if rsp.Request != nil {
+		req = rsp.Request
+	}
+	captured := req.Clone(context.WithValue(req.Context(), capturedRequestContextKey{}, true))
+	captured.Body = nil
+	captured.GetBody = nil
+	return captured
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -659,6 +928,30 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 	return nil
 }
 
+// ServerURL0 returns the URL of server 0 in the spec, "http://openapitest.deepmap.ai".
+// The server has no variables, so vars must be empty.
+func ServerURL0(vars map[string]string) (string, error) {
+	return runtime.ServerURL("http://openapitest.deepmap.ai", vars, nil)
+}
+
+// serverURLs holds the URLs of the servers in the spec, in order, with their
+// variables set to their defaults.
+var serverURLs = []string{
+	"http://openapitest.deepmap.ai",
+}
+
+// WithBaseURLIndex sets the base URL to that of the i-th server in the spec,
+// with its variables set to their defaults.
+func WithBaseURLIndex(i int) ClientOption {
+	return func(c *Client) error {
+		if i < 0 || i >= len(serverURLs) {
+			return fmt.Errorf("server index %d out of range, the spec has %d servers", i, len(serverURLs))
+		}
+		c.Server = serverURLs[i]
+		return nil
+	}
+}
+
 // ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
@@ -718,15 +1011,21 @@ type ClientWithResponsesInterface interface {
 
 	// Issue975 request
 	Issue975WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Issue975Response, error)
+
+	// GetRecursive request
+	GetRecursiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRecursiveResponse, error)
 }
 
+var _ ClientWithResponsesInterface = (*ClientWithResponses)(nil)
+
 type EnsureEverythingIsReferencedResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *struct {
 		AnyType1 *AnyType1 `json:"anyType1,omitempty"`
 
-		// AnyType2 AnyType2 represents any type.
+		// AnyType2 represents any type.
 		//
 		// This should be an interface{}
 		AnyType2         *AnyType2         `json:"anyType2,omitempty"`
@@ -750,9 +1049,29 @@ func (r EnsureEverythingIsReferencedResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r EnsureEverythingIsReferencedResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// EnsureEverythingIsReferencedExpectedStatusCodes returns the status codes which EnsureEverythingIsReferenced has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func EnsureEverythingIsReferencedExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// EnsureEverythingIsReferencedHasDefaultResponse is whether EnsureEverythingIsReferenced has a default response,
+// for status codes which aren't in EnsureEverythingIsReferencedExpectedStatusCodes.
+const EnsureEverythingIsReferencedHasDefaultResponse = false
+
 type Issue127Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *GenericObject
 	XML200       *GenericObject
 	YAML200      *GenericObject
@@ -775,9 +1094,39 @@ func (r Issue127Response) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r Issue127Response) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	if r.JSONDefault != nil {
+		return fmt.Errorf("%s: %+v", r.HTTPResponse.Status, *r.JSONDefault)
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of Issue127, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r Issue127Response) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200
+}
+
+// Issue127ExpectedStatusCodes returns the status codes which Issue127 has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func Issue127ExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// Issue127HasDefaultResponse is whether Issue127 has a default response,
+// for status codes which aren't in Issue127ExpectedStatusCodes.
+const Issue127HasDefaultResponse = true
+
 type Issue185Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -796,9 +1145,29 @@ func (r Issue185Response) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r Issue185Response) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// Issue185ExpectedStatusCodes returns the status codes which Issue185 has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func Issue185ExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// Issue185HasDefaultResponse is whether Issue185 has a default response,
+// for status codes which aren't in Issue185ExpectedStatusCodes.
+const Issue185HasDefaultResponse = false
+
 type Issue209Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -817,9 +1186,29 @@ func (r Issue209Response) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r Issue209Response) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// Issue209ExpectedStatusCodes returns the status codes which Issue209 has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func Issue209ExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// Issue209HasDefaultResponse is whether Issue209 has a default response,
+// for status codes which aren't in Issue209ExpectedStatusCodes.
+const Issue209HasDefaultResponse = false
+
 type Issue30Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -838,9 +1227,29 @@ func (r Issue30Response) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r Issue30Response) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// Issue30ExpectedStatusCodes returns the status codes which Issue30 has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func Issue30ExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// Issue30HasDefaultResponse is whether Issue30 has a default response,
+// for status codes which aren't in Issue30ExpectedStatusCodes.
+const Issue30HasDefaultResponse = false
+
 type GetIssues375Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *EnumInObjInArray
 }
 
@@ -860,9 +1269,29 @@ func (r GetIssues375Response) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetIssues375Response) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetIssues375ExpectedStatusCodes returns the status codes which GetIssues375 has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetIssues375ExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetIssues375HasDefaultResponse is whether GetIssues375 has a default response,
+// for status codes which aren't in GetIssues375ExpectedStatusCodes.
+const GetIssues375HasDefaultResponse = false
+
 type Issue41Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -881,9 +1310,29 @@ func (r Issue41Response) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r Issue41Response) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// Issue41ExpectedStatusCodes returns the status codes which Issue41 has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func Issue41ExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// Issue41HasDefaultResponse is whether Issue41 has a default response,
+// for status codes which aren't in Issue41ExpectedStatusCodes.
+const Issue41HasDefaultResponse = false
+
 type Issue9Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -902,9 +1351,29 @@ func (r Issue9Response) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r Issue9Response) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// Issue9ExpectedStatusCodes returns the status codes which Issue9 has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func Issue9ExpectedStatusCodes() []int {
+	return []int{}
+}
+
+// Issue9HasDefaultResponse is whether Issue9 has a default response,
+// for status codes which aren't in Issue9ExpectedStatusCodes.
+const Issue9HasDefaultResponse = false
+
 type Issue975Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *DeprecatedProperty
 }
 
@@ -924,6 +1393,71 @@ func (r Issue975Response) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r Issue975Response) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// Issue975ExpectedStatusCodes returns the status codes which Issue975 has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func Issue975ExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// Issue975HasDefaultResponse is whether Issue975 has a default response,
+// for status codes which aren't in Issue975ExpectedStatusCodes.
+const Issue975HasDefaultResponse = false
+
+type GetRecursiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+	JSON200      *struct {
+		Extended *RecursiveExtended `json:"extended,omitempty"`
+		Husband  *RecursiveHusband  `json:"husband,omitempty"`
+		Node     *RecursiveNode     `json:"node,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRecursiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecursiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r GetRecursiveResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// GetRecursiveExpectedStatusCodes returns the status codes which GetRecursive has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func GetRecursiveExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// GetRecursiveHasDefaultResponse is whether GetRecursive has a default response,
+// for status codes which aren't in GetRecursiveExpectedStatusCodes.
+const GetRecursiveHasDefaultResponse = false
+
 // EnsureEverythingIsReferencedWithResponse request returning *EnsureEverythingIsReferencedResponse
 func (c *ClientWithResponses) EnsureEverythingIsReferencedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnsureEverythingIsReferencedResponse, error) {
 	rsp, err := c.EnsureEverythingIsReferenced(ctx, reqEditors...)
@@ -1021,6 +1555,24 @@ func (c *ClientWithResponses) Issue975WithResponse(ctx context.Context, reqEdito
 	return ParseIssue975Response(rsp)
 }
 
+// GetRecursiveWithResponse request returning *GetRecursiveResponse
+func (c *ClientWithResponses) GetRecursiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRecursiveResponse, error) {
+	rsp, err := c.GetRecursive(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecursiveResponse(rsp)
+}
+
+// capturedRequest returns the request kept in rsp by a client made
+// WithCaptureRequest(true), or nil.
+func capturedRequest(rsp *http.Response) *http.Request {
+	if rsp.Request == nil || rsp.Request.Context().Value(capturedRequestContextKey{}) == nil {
+		return nil
+	}
+	return rsp.Request
+}
+
 // ParseEnsureEverythingIsReferencedResponse parses an HTTP response from a EnsureEverythingIsReferencedWithResponse call
 func ParseEnsureEverythingIsReferencedResponse(rsp *http.Response) (*EnsureEverythingIsReferencedResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1032,6 +1584,7 @@ func ParseEnsureEverythingIsReferencedResponse(rsp *http.Response) (*EnsureEvery
 	response := &EnsureEverythingIsReferencedResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
@@ -1039,7 +1592,7 @@ func ParseEnsureEverythingIsReferencedResponse(rsp *http.Response) (*EnsureEvery
 		var dest struct {
 			AnyType1 *AnyType1 `json:"anyType1,omitempty"`
 
-			// AnyType2 AnyType2 represents any type.
+			// AnyType2 represents any type.
 			//
 			// This should be an interface{}
 			AnyType2         *AnyType2         `json:"anyType2,omitempty"`
@@ -1066,6 +1619,7 @@ func ParseIssue127Response(rsp *http.Response) (*Issue127Response, error) {
 	response := &Issue127Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
@@ -1076,7 +1630,7 @@ func ParseIssue127Response(rsp *http.Response) (*Issue127Response, error) {
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode != 200:
 		var dest GenericObject
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -1097,10 +1651,10 @@ func ParseIssue127Response(rsp *http.Response) (*Issue127Response, error) {
 		}
 		response.YAML200 = &dest
 
-	case rsp.StatusCode == 200:
+	case rsp.StatusCode != 200:
 	// Content-type (text/markdown) unsupported
 
-	case true:
+	case rsp.StatusCode == 200:
 		// Content-type (text/markdown) unsupported
 
 	}
@@ -1119,6 +1673,7 @@ func ParseIssue185Response(rsp *http.Response) (*Issue185Response, error) {
 	response := &Issue185Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -1135,6 +1690,7 @@ func ParseIssue209Response(rsp *http.Response) (*Issue209Response, error) {
 	response := &Issue209Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -1151,6 +1707,7 @@ func ParseIssue30Response(rsp *http.Response) (*Issue30Response, error) {
 	response := &Issue30Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -1167,6 +1724,7 @@ func ParseGetIssues375Response(rsp *http.Response) (*GetIssues375Response, error
 	response := &GetIssues375Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
@@ -1193,6 +1751,7 @@ func ParseIssue41Response(rsp *http.Response) (*Issue41Response, error) {
 	response := &Issue41Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -1209,6 +1768,7 @@ func ParseIssue9Response(rsp *http.Response) (*Issue9Response, error) {
 	response := &Issue9Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -1225,6 +1785,7 @@ func ParseIssue975Response(rsp *http.Response) (*Issue975Response, error) {
 	response := &Issue975Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
@@ -1240,6 +1801,37 @@ func ParseIssue975Response(rsp *http.Response) (*Issue975Response, error) {
 	return response, nil
 }
 
+// ParseGetRecursiveResponse parses an HTTP response from a GetRecursiveWithResponse call
+func ParseGetRecursiveResponse(rsp *http.Response) (*GetRecursiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecursiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Extended *RecursiveExtended `json:"extended,omitempty"`
+			Husband  *RecursiveHusband  `json:"husband,omitempty"`
+			Node     *RecursiveNode     `json:"node,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -1269,6 +1861,9 @@ type ServerInterface interface {
 
 	// (GET /issues/975)
 	Issue975(ctx echo.Context) error
+
+	// (GET /recursive)
+	GetRecursive(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -1405,6 +2000,17 @@ func (w *ServerInterfaceWrapper) Issue975(ctx echo.Context) error {
 	return err
 }
 
+// GetRecursive converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecursive(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Access_tokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetRecursive(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -1442,36 +2048,41 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/issues/41/:1param", wrapper.Issue41)
 	router.GET(baseURL+"/issues/9", wrapper.Issue9)
 	router.GET(baseURL+"/issues/975", wrapper.Issue975)
+	router.GET(baseURL+"/recursive", wrapper.GetRecursive)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7RYT2/bOhL/KlNugb3YluO0aONbttstvMBrgyYPPdQ50OTYYiORKknZEQx994chZcuu",
-	"Jb/mpc0lksjh/ObfbzjeMmHywmjU3rHplhXc8hw92vB2663Sq5m+4T6ld4lOWFV4ZTSbsmtwYR0K7lPY",
-	"S7IBU7RMX9mAaZ4jmzLnacHi91JZlGzqbYkD5kSKOaejfVU025Resbqud4sByOtbz613X5RPP5b5Au0p",
-	"mrtUOYgiQDrBBRHYKJ8CBx3FBjtFZvENhWf1gF3r6q4q8IJNt+3bpMPcZgUsFhYdeQy4roAOHM31XEcE",
-	"qSkzCQsErkFpj3bJBW7ruSZd70rnTR7deheAbNnS2Jx7NmUiLLYQG18M2OPQ8EINhZG4Qj3ER2/50POV",
-	"i+KGTdmCW0Y++y9hE9yjvLGmQOurENX4rDBIaNzQ4qmFfzoET0Zos3lxgqMeMJOFYy+i6E7TLph92yfd",
-	"2491zzyUDiV4A9JEFFxL8Cn3Z5Bc/gwScmC7Z2iRu725H6MvQGnnkcsXB2e/+tWwn4ajPqyWr/ug7eGx",
-	"+45cfq/LfKY/Lb7N9LW1PARfeczdaRaseUb/UJc5nb9U1hFkh8JoeXD4viI71DUfeFBVD9gH1GiV+BQ3",
-	"tFXdSnwss4wvMrw5wnKMzATnRningW8Wr7XcnRVyev/ck4utL7f9i0879IcI7Z+7zzsNVx28XVrlq1vi",
-	"rWg9FwKdG3rzgJreF8gt2v/tSOL/X+6GkTEg7oSwczTXrGFMUhGF2gxMvS8iqSq9NB3kic6D4A4dLI2F",
-	"NbfKlA6Uc2X4VGoJZo0WvMpxBDcZcofApQQOfidLonNNlLgoV7BUjygjLK88OTFquUW7DtDWaF3UfjEa",
-	"j8YxuKh5odiUXY7Gows2CE0kuCVB7UqLQ1yjrXyq9Gqo3NDiEi1qEeO6Qt/TF1DLwijtAR+V8w6cCSUK",
-	"bfMDwTWxtrBI1QlKh2qea1egCDWtjacNhS01ymAXJS0nNTPJpux9APh+j2/mPrfoKCdcYbSLQZ6Mx/RP",
-	"GO1RB9C8KDIlwmnJt8AL24PueFwgvO1Y7KXFJZuyfyWtKUnTOJN9Z6sHO5nJT8pMSEZ0dKtzsifdrYM0",
-	"4t+AJTG3kovJm97Q/cEfEMipUGpXFoWxFJngtEcf+q4DafS/PRQWMS88tLvC6qgjTDPSS1qfGZJzjjjm",
-	"QTL38KzHPHvOUWR8knP7IM1GP/ugij8HDR0jccnLzP9G5/0ii3/MvLev+0mjKhBWJB8sgE2KGnatJ9nR",
-	"O7RlCdwi7PpFf9q9fd10B3T+P0ZWv8xpHX01WnuQ4wTv0AGT8VXycuu8rXv98C5F8eBALdvrfTRVosh4",
-	"64Ks6jZ4Mr5ipxgGR2PG127L2i3J0RhS3x+YcDlOtkueZT61plyl9akFn9FRw5HwgNXGWHl4Qy8shi5F",
-	"ZE8tjxwYZoeGOBqXdNh1Of4ZszrGoAOwTxqHjox+05+4dAFsgtNkLne7RCZW3CiBFE6fItDVL6wrTcNK",
-	"ZOi53qRKpM13pySCWdJyuOR1ZfYH9MEnjnD9RlI9udueVPSri2R7EWLQn9E3uxAdDIk0w4YxcT8kdoT8",
-	"VbyO/F2Ao/6zsT1n5OmgW9f3Z6v4qr94M4Xax8p1oSGC0sJYi8JnFT1npUQZbnwNJ0U3LIys6Moz1629",
-	"vZx21eOW7yXa6iDxjXlawv9jnmya0qEnPjXMHSxj51nx6kx1tdM1LBVmLZms0ANvuJAulTlq3+uw31sm",
-	"Hb8AdHgk/HZTiibg8sSu8HnNbUW1keEaM6IBaURJpgVcrKm+3QwTQn88vXy9pzgGAm5So7RZM45Mk6S5",
-	"7tMAMZKIRc6LEVfE8H8FAAD//5edhkCXEgAA",
+	"H4sIAAAAAAAC/7RYX3PbuBH/Kjj0ZvpCibKSzJ31ll7TqzvTxBOnk4fIDxCwFBGTAA9YSuZo+N07AEiR",
+	"EkmddUn8YgrA7v72D/YPDpTrvNAKFFq6OtCCGZYDgvG/HtBItb1T9wxT91uA5UYWKLWiK/qWWL9PCoYp",
+	"OVLSiEq37VZpRBXLga6oRbdh4I9SGhB0haaEiFqeQs4ca6yK5phUW1rXdbvpgbx5QGbQfpaYvi/zDZgh",
+	"mk+ptCSQECeTWE9C9hJTwogKZFErSG++AkdaR/Stqj5VBdzQ1aH7tRxRt9khBgoD1lmMMFURx3C+VmsV",
+	"EKS6zATZAGGKSIVgEsbhUK+Vk/VbaVHnwayfPJADTbTJGdIV5X6TRme2iOjzTLNCzrgWsAU1g2c0bIZs",
+	"awO5piu6YYY6m/3TYeMMQdwbXYDByns1fEvwFAr2bnOo4f8sEHRKKL3/aYCjjqjOPNubQNpKap05dXw5",
+	"fvxU9h2S0oIgqInQAQVTgmDK8AKSVy9B4gzYnZkZYPao7vtgCyKVRWDipx7v198b9nU46v5t+XJ02hEe",
+	"fRyJ5XeqzO/Uh83XO/XWGOadLxFyO4yCHcvcP1Bl7vgn0lgH2QLXStDHc/B1fVzpxDULzIuqI/o7KDCS",
+	"fwgHVochxfsyy9gmg/sTLKfItDcuy3oMeo5vNt8q0fJy59TxeyIWO1sepjevY3rmoeP3OL8xd30EXhor",
+	"d/DuGUGJgI5l2YeErr4c6M8GErqif4u7HB03STE+kv67tBumBK2jcztayBK6eiGbI4JztTyXIfjHPvwW",
+	"w8CVe5nAiyF8dofPxXsOF233XgsYSuapzIQBdXIDXgTD8xsJ7hyQjQSrkVuprmR+pmPDY0zLghlQeDX2",
+	"HctKGCurp4LDsagz1lFgo+5Fw39unHtqkLSLhevi9wxby2cIofZZqjQSqwfHLchlnIO1M9RPwekbYAbM",
+	"v9ri+p/Pn2ah0pJwkviT87VT2oNyIgJRl7lTxCI0I1IleqTpAIuEMwuWJNqQHTNSl5ZIa0u/VCpB9A4M",
+	"QZnDnNxnwCwQJgRhBFtaR7pWrpXYlFuSyGcQARZKzKCV8gBm56HtwNgg/Wa+mC9CUgTFCklX9NV8Mb/x",
+	"bsTUmyUGZUsDM9iBqTCVajuTdmYgAQOKh4yzBZzop0CJQkuFBJ6lRUus9qWNdA4lnCnX7XADrqoRqXwV",
+	"XCtbAPe1UGl0BwpTKhBeLxcuzIm5E3RF33mA74747uzHDp2LCVtoZYOTl4uF+8e1wuZasKLIJPfc4q++",
+	"nh56XeVpaLKu07sYm8eOsI5amuULaZaOho90eZdoB13hSLENfxGNQ2zFN8tfJl33X/YExBmVlMqWRaGN",
+	"84w32jP6ftUSodXfkRQGIC+QdKf87nzETXdOrpP6jS65ZIjT/sGp2+f1nGffwsopH+fMPAm9V9/MqGLf",
+	"gsaxEZCwMsMfaLzvpPF55P36ZjppVAWQraP3GpB9Coq0LVvcpnfSXUvCDJC2z5oOu1/fNF0VWPyHFtV3",
+	"M9pIPxq07cW4g9c3wHJxG/98sGjqSTv8lgJ/skQm3VgcVBXAM9aZIKvGFV4ubukQQ3Qynk80iN2R+GR8",
+	"rx97KrxaxIeEZRmmRpfbtB5q8BGsKziCPEG110b0J9vCgK9SLtm7kucM6GfuJnE0JhnR69XiJWqNPB/0",
+	"wF71jHCi9C/TgesGp8Y5TeQy2wayy4p7ycG5E1MgbmTy+1K5IT9k6LXap5KnzbqVAohO3LbvH8ci+3dA",
+	"bxPrcP3ApDqYCQc3+vVNfLjxPpiO6PvWRb3HFff2459Xjo8rIy5/HdqRP3NwkH/Rt5eUHD4Q1fXjxVt8",
+	"O315MwkKw821viASqbg2BjhmlfvOSgHCd3xNTgpm2GhRuZZnrTp9J3Pa7YRZ/ijBVL3A1/q6gP/LebIp",
+	"Sn1LfGgyt9eMXs6KtxduV/cqRRIJWZdMtoCENbnQNZU5KJw02I+9JiMvZyMW8W+eJW8cLgZ6+eUdM5W7",
+	"GxnsIHNpQGheOtU8rqbAxKadgCbN9hCgkZBZfM/uHpowhdxCtgMbESGbqNSGNPmRaEzBNE+hNlqrvLS+",
+	"A+/SmUfpG3swbhAiGwPsySc3XvEMxrPVcWT7vl059N4+rnyqiP76tBlR1bwbXDe3D9ryaFA4G5LWA7TJ",
+	"tu3M6q/66bT65dHdW19wm1RQmqwZP1dx3Ix3CBbnAqDIWTFn0lX0/w8AJgKI778XAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1545,3 +2156,29 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+var (
+	swaggerOnce      sync.Once
+	cachedSwagger    *openapi3.T
+	cachedSwaggerErr error
+)
+
+// GetSwaggerCached is like GetSwagger, but only loads the specification the
+// first time it's called. Every caller gets the same *openapi3.T, which must
+// not be modified.
+func GetSwaggerCached() (*openapi3.T, error) {
+	swaggerOnce.Do(func() {
+		cachedSwagger, cachedSwaggerErr = GetSwagger()
+	})
+	return cachedSwagger, cachedSwaggerErr
+}
+
+// MustGetSwagger is like GetSwaggerCached, but panics if the specification
+// can't be loaded.
+func MustGetSwagger() *openapi3.T {
+	swagger, err := GetSwaggerCached()
+	if err != nil {
+		panic(fmt.Sprintf("error loading embedded swagger spec: %s", err))
+	}
+	return swagger
+}
@@ -4,6 +4,7 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -24,7 +25,7 @@ type EveryTypeOptional struct {
 	ArrayInlineField     *[]int              `json:"array_inline_field,omitempty"`
 	ArrayReferencedField *[]SomeObject       `json:"array_referenced_field,omitempty"`
 	BoolField            *bool               `json:"bool_field,omitempty"`
-	ByteField            *[]byte             `json:"byte_field,omitempty"`
+	ByteField            []byte              `json:"byte_field,omitempty"`
 	DateField            *openapi_types.Date `json:"date_field,omitempty"`
 	DateTimeField        *time.Time          `json:"date_time_field,omitempty"`
 	DoubleField          *float64            `json:"double_field,omitempty"`
@@ -315,6 +316,11 @@ func (siw *ServerInterfaceWrapper) GetWithContentType(w http.ResponseWriter, r *
 		return
 	}
 
+	if contentType != "text" && contentType != "json" {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "content_type", Err: errors.New("must be one of \"text\", \"json\"")})
+		return
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetWithContentType(w, r, contentType)
 	})
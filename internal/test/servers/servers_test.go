@@ -0,0 +1,57 @@
+package servers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingDoer records the URLs of the requests it receives.
+type recordingDoer struct {
+	urls []string
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.urls = append(d.urls, req.URL.String())
+	return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+}
+
+func TestServerURLs(t *testing.T) {
+	u, err := ServerURL0(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/v1", u)
+
+	_, err = ServerURL0(map[string]string{"region": "us"})
+	assert.Error(t, err)
+
+	u, err = ServerURL1(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://eu.example.com/v1", u)
+
+	u, err = ServerURL1(map[string]string{"region": "us"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://us.example.com/v1", u)
+
+	_, err = ServerURL1(map[string]string{"region": "ap"})
+	assert.Error(t, err)
+}
+
+func TestWithBaseURLIndex(t *testing.T) {
+	doer := &recordingDoer{}
+	client, err := NewClient("", WithHTTPClient(doer), WithBaseURLIndex(1))
+	require.NoError(t, err)
+
+	_, err = client.Ping(context.Background())
+	require.NoError(t, err)
+	// Operations with their own servers resolve them against the client's
+	_, err = client.ListFiles(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"https://eu.example.com/v1/ping", "https://eu.example.com/v2/files"}, doer.urls)
+
+	_, err = NewClient("", WithBaseURLIndex(2))
+	assert.EqualError(t, err, "server index 2 out of range, the spec has 2 servers")
+}
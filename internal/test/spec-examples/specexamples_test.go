@@ -0,0 +1,57 @@
+package specexamples
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedSpecExamples(t *testing.T) {
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+
+	inventory := swagger.Components.Schemas["Inventory"].Value
+	assert.Equal(t, map[string]interface{}{"apples": float64(7)}, inventory.Example)
+	assert.Equal(t, float64(12), inventory.AdditionalProperties.Schema.Value.Example)
+
+	// Components which were pruned from the generated code keep their examples
+	require.Contains(t, swagger.Components.Schemas, "Labels")
+	labels := swagger.Components.Schemas["Labels"].Value.AdditionalProperties.Schema.Value
+	assert.Equal(t, map[string]interface{}{"color": "blue"}, labels.Example)
+	assert.Equal(t, "red", labels.AdditionalProperties.Schema.Value.Example)
+
+	op := swagger.Paths["/inventory"].Get
+	assert.Equal(t, "north", op.Parameters[0].Value.Example)
+	small := op.Responses.Get(http.StatusOK).Value.Content["application/json"].Examples["small"]
+	assert.Equal(t, "#/components/examples/SmallInventory", small.Ref)
+	assert.Equal(t, map[string]interface{}{"apples": float64(3), "pears": float64(5)}, small.Value.Value)
+
+	// The embedded spec has the same examples as the one it was generated from
+	original, err := openapi3.NewLoader().LoadFromFile("spec.yaml")
+	require.NoError(t, err)
+	assertSameJSON(t, original.Components.Schemas, swagger.Components.Schemas)
+	assertSameJSON(t, original.Components.Examples, swagger.Components.Examples)
+
+	// And so does the one which is served
+	rec := httptest.NewRecorder()
+	SpecHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	served, err := openapi3.NewLoader().LoadFromData(rec.Body.Bytes())
+	require.NoError(t, err)
+	assertSameJSON(t, original.Components.Schemas, served.Components.Schemas)
+	assertSameJSON(t, original.Components.Examples, served.Components.Examples)
+}
+
+func assertSameJSON(t *testing.T, expected, actual interface{}) {
+	t.Helper()
+	expectedJSON, err := json.Marshal(expected)
+	require.NoError(t, err)
+	actualJSON, err := json.Marshal(actual)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expectedJSON), string(actualJSON))
+}
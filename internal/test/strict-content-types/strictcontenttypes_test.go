@@ -0,0 +1,98 @@
+package strictcontenttypes
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (server) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	var pet Pet
+	switch {
+	case request.JSONBody != nil:
+		pet = Pet(*request.JSONBody)
+	case request.FormdataBody != nil:
+		pet = Pet(*request.FormdataBody)
+	case request.Body != nil:
+		// Text of any subtype is the pet's name
+		name, err := io.ReadAll(request.Body)
+		if err != nil {
+			return nil, err
+		}
+		pet = Pet{Name: string(name)}
+	default:
+		return AddPetdefaultTextResponse{Body: "no body", StatusCode: http.StatusBadRequest}, nil
+	}
+	return AddPet201JSONResponse{Pet: pet, ContentType: request.ContentType}, nil
+}
+
+func TestSeveralRequestBodyContentTypes(t *testing.T) {
+	client, err := NewInProcessClient(Handler(NewStrictHandler(server{}, nil)))
+	require.NoError(t, err)
+	ctx := context.Background()
+	age := 3
+
+	rsp, err := client.AddPetWithResponse(ctx, AddPetJSONRequestBody{Name: "Tom", Age: &age})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON201)
+	assert.Equal(t, AddedPet{Pet: Pet{Name: "Tom", Age: &age}, ContentType: "application/json"}, *rsp.JSON201)
+
+	rsp, err = client.AddPetWithFormdataBodyWithResponse(ctx, AddPetFormdataRequestBody{Name: "Spike", Age: &age})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON201)
+	assert.Equal(t, AddedPet{Pet: Pet{Name: "Spike", Age: &age}, ContentType: "application/x-www-form-urlencoded"}, *rsp.JSON201)
+
+	// Parameters of the content type are kept
+	rsp, err = client.AddPetWithBodyWithResponse(ctx, "application/json; charset=utf-8", strings.NewReader(`{"name":"Jerry"}`))
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON201)
+	assert.Equal(t, AddedPet{Pet: Pet{Name: "Jerry"}, ContentType: "application/json; charset=utf-8"}, *rsp.JSON201)
+
+	// Media ranges match the content types they cover
+	rsp, err = client.AddPetWithBodyWithResponse(ctx, "text/markdown", strings.NewReader("Tyke"))
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON201)
+	assert.Equal(t, AddedPet{Pet: Pet{Name: "Tyke"}, ContentType: "text/markdown"}, *rsp.JSON201)
+}
+
+func TestUnsupportedRequestBodyContentType(t *testing.T) {
+	client, err := NewInProcessClient(Handler(NewStrictHandler(server{}, nil)))
+	require.NoError(t, err)
+
+	// Content types are matched as a whole, rather than by prefix
+	rsp, err := client.AddPetWithBody(context.Background(), "application/json-seq", strings.NewReader(`{"name":"Tom"}`))
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusUnsupportedMediaType, rsp.StatusCode)
+	body, err := io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "unsupported content type \"application/json-seq\"\n", string(body))
+}
+
+func TestUnsupportedRequestBodyContentTypeError(t *testing.T) {
+	var handled error
+	client, err := NewInProcessClient(Handler(NewStrictHandlerWithOptions(server{}, nil, StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			handled = err
+			w.WriteHeader(http.StatusTeapot)
+		},
+	})))
+	require.NoError(t, err)
+
+	rsp, err := client.AddPetWithBody(context.Background(), "application/json-seq", strings.NewReader(`{"name":"Tom"}`))
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusTeapot, rsp.StatusCode)
+
+	var contentTypeErr *UnsupportedContentTypeError
+	require.True(t, errors.As(handled, &contentTypeErr))
+	assert.Equal(t, "application/json-seq", contentTypeErr.ContentType)
+}
@@ -0,0 +1,100 @@
+package stricterrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// server returns the declared error responses of GetPet as errors.
+type server struct{}
+
+func (server) GetPet(ctx context.Context, request GetPetRequestObject) (GetPetResponseObject, error) {
+	message := "no such pet"
+	switch request.Id {
+	case 1:
+		return GetPet200JSONResponse{}, nil
+	case 400:
+		return nil, GetPet400JSONResponse{Message: "invalid", AdditionalProperties: map[string]string{"name": "required"}}
+	case 403:
+		return nil, GetPet403Response{}
+	case 404:
+		return nil, fmt.Errorf("error finding pet: %w", GetPet404JSONResponse{Message: &message})
+	case 410:
+		return nil, GetPet410JSONResponse{AdditionalProperties: map[string]string{"kind": "cat"}}
+	case 409:
+		return nil, GetPet409JSONResponse{ConflictJSONResponse{Detail: "taken"}}
+	case 500:
+		return nil, GetPet500TextResponse("broken")
+	case 503:
+		return nil, GetPetdefaultJSONResponse{Body: Problem{Detail: "later"}, StatusCode: 503}
+	default:
+		return nil, errors.New("unexpected")
+	}
+}
+
+func TestStrictErrorResponses(t *testing.T) {
+	handler := Handler(NewStrictHandler(server{}, nil))
+	get := func(id int) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/pets/%d", id), nil))
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, get(1).Code)
+
+	// Responses of referenced schemas and of inline schemas with additional
+	// properties are errors too, encoded as their schemas
+	rec := get(400)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.JSONEq(t, `{"message":"invalid","name":"required"}`, rec.Body.String())
+
+	rec = get(410)
+	assert.Equal(t, http.StatusGone, rec.Code)
+	assert.JSONEq(t, `{"kind":"cat"}`, rec.Body.String())
+
+	rec = get(403)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	rec = get(404)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.JSONEq(t, `{"message":"no such pet"}`, rec.Body.String())
+
+	rec = get(409)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.JSONEq(t, `{"detail":"taken"}`, rec.Body.String())
+
+	rec = get(500)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "broken", rec.Body.String())
+
+	rec = get(503)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.JSONEq(t, `{"detail":"later"}`, rec.Body.String())
+
+	// Other errors are still handled by the ResponseErrorHandlerFunc
+	rec = get(2)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "unexpected\n", rec.Body.String())
+}
+
+func TestStrictErrorResponseMessages(t *testing.T) {
+	message := "no such pet"
+	assert.EqualError(t, GetPet400JSONResponse{Message: "invalid", AdditionalProperties: map[string]string{"name": "required"}}, `GetPet: 400 Bad Request: {"message":"invalid","name":"required"}`)
+	assert.EqualError(t, GetPet403Response{}, "GetPet: 403 Forbidden")
+	assert.EqualError(t, GetPet404JSONResponse{Message: &message}, `GetPet: 404 Not Found: {"message":"no such pet"}`)
+	assert.EqualError(t, GetPet409JSONResponse{ConflictJSONResponse{Detail: "taken"}}, `GetPet: 409 Conflict: {"detail":"taken"}`)
+	assert.EqualError(t, GetPet500TextResponse("broken"), "GetPet: 500 Internal Server Error: broken")
+	assert.EqualError(t, GetPetdefaultJSONResponse{Body: Problem{Detail: "later"}, StatusCode: 503}, `GetPet: 503 Service Unavailable: {"detail":"later"}`)
+
+	// A response with an Error field can't be an error
+	var response interface{} = GetPet422JSONResponse{}
+	_, ok := response.(error)
+	assert.False(t, ok)
+}
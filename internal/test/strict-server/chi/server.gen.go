@@ -7,8 +7,12 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -16,6 +20,9 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
@@ -25,21 +32,36 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /csv)
+	CSVExample(w http.ResponseWriter, r *http.Request)
+
+	// (GET /events)
+	EventsExample(w http.ResponseWriter, r *http.Request, params EventsExampleParams)
+
 	// (POST /json)
 	JSONExample(w http.ResponseWriter, r *http.Request)
 
+	// (PATCH /merge-patch)
+	MergePatchExample(w http.ResponseWriter, r *http.Request)
+
 	// (POST /multipart)
 	MultipartExample(w http.ResponseWriter, r *http.Request)
 
 	// (POST /multiple)
 	MultipleRequestAndResponseTypes(w http.ResponseWriter, r *http.Request)
 
+	// (POST /negotiated)
+	NegotiatedExample(w http.ResponseWriter, r *http.Request)
+
 	// (GET /reserved-go-keyword-parameters/{type})
 	ReservedGoKeywordParameters(w http.ResponseWriter, r *http.Request, pType string)
 
 	// (POST /reusable-responses)
 	ReusableResponses(w http.ResponseWriter, r *http.Request)
 
+	// (GET /socket/{room})
+	WebSocketExample(w http.ResponseWriter, r *http.Request, room string, params WebSocketExampleParams)
+
 	// (POST /text)
 	TextExample(w http.ResponseWriter, r *http.Request)
 
@@ -65,6 +87,56 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// CSVExample operation middleware
+func (siw *ServerInterfaceWrapper) CSVExample(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CSVExample(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// EventsExample operation middleware
+func (siw *ServerInterfaceWrapper) EventsExample(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EventsExampleParams
+
+	// ------------- Required query parameter "count" -------------
+
+	if paramValue := r.URL.Query().Get("count"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "count"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EventsExample(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // JSONExample operation middleware
 func (siw *ServerInterfaceWrapper) JSONExample(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -80,6 +152,21 @@ func (siw *ServerInterfaceWrapper) JSONExample(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// MergePatchExample operation middleware
+func (siw *ServerInterfaceWrapper) MergePatchExample(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergePatchExample(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // MultipartExample operation middleware
 func (siw *ServerInterfaceWrapper) MultipartExample(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -110,6 +197,21 @@ func (siw *ServerInterfaceWrapper) MultipleRequestAndResponseTypes(w http.Respon
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// NegotiatedExample operation middleware
+func (siw *ServerInterfaceWrapper) NegotiatedExample(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.NegotiatedExample(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // ReservedGoKeywordParameters operation middleware
 func (siw *ServerInterfaceWrapper) ReservedGoKeywordParameters(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -151,6 +253,50 @@ func (siw *ServerInterfaceWrapper) ReusableResponses(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// WebSocketExample operation middleware
+func (siw *ServerInterfaceWrapper) WebSocketExample(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "room" -------------
+	var room string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "room", runtime.ParamLocationPath, chi.URLParam(r, "room"), &room)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "room", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebSocketExampleParams
+
+	// ------------- Required query parameter "name" -------------
+
+	if paramValue := r.URL.Query().Get("name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebSocketExample(w, r, room, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // TextExample operation middleware
 func (siw *ServerInterfaceWrapper) TextExample(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -388,21 +534,36 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/csv", wrapper.CSVExample)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/events", wrapper.EventsExample)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/json", wrapper.JSONExample)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/merge-patch", wrapper.MergePatchExample)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/multipart", wrapper.MultipartExample)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/multiple", wrapper.MultipleRequestAndResponseTypes)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/negotiated", wrapper.NegotiatedExample)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/reserved-go-keyword-parameters/{type}", wrapper.ReservedGoKeywordParameters)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/reusable-responses", wrapper.ReusableResponses)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/socket/{room}", wrapper.WebSocketExample)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/text", wrapper.TextExample)
 	})
@@ -435,6 +596,63 @@ type ReusableresponseJSONResponse struct {
 	Headers ReusableresponseResponseHeaders
 }
 
+type CSVExampleRequestObject struct {
+}
+
+type CSVExampleResponseObject interface {
+	VisitCSVExampleResponse(w http.ResponseWriter) error
+}
+
+type CSVExample200CSVResponse []ReportRow
+
+func (response CSVExample200CSVResponse) VisitCSVExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	w.WriteHeader(200)
+
+	out, err := runtime.MarshalCSV(response)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+type CSVExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response CSVExampledefaultResponse) VisitCSVExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(response.StatusCode)
+	return nil
+}
+
+type EventsExampleRequestObject struct {
+	Params EventsExampleParams
+}
+
+type EventsExampleResponseObject interface {
+	VisitEventsExampleResponse(w http.ResponseWriter) error
+}
+
+type EventsExample200EventStreamResponse func(writer *runtime.EventStreamWriter) error
+
+func (response EventsExample200EventStreamResponse) VisitEventsExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(200)
+
+	return response(runtime.NewEventStreamWriter(w))
+}
+
+type EventsExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response EventsExampledefaultResponse) VisitEventsExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(response.StatusCode)
+	return nil
+}
+
 type JSONExampleRequestObject struct {
 	Body *JSONExampleJSONRequestBody
 }
@@ -468,6 +686,39 @@ func (response JSONExampledefaultResponse) VisitJSONExampleResponse(w http.Respo
 	return nil
 }
 
+type MergePatchExampleRequestObject struct {
+	Body *MergePatchExampleJSONRequestBody
+}
+
+type MergePatchExampleResponseObject interface {
+	VisitMergePatchExampleResponse(w http.ResponseWriter) error
+}
+
+type MergePatchExample200JSONResponse Example
+
+func (response MergePatchExample200JSONResponse) VisitMergePatchExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePatchExample400Response = BadrequestResponse
+
+func (response MergePatchExample400Response) VisitMergePatchExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type MergePatchExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response MergePatchExampledefaultResponse) VisitMergePatchExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(response.StatusCode)
+	return nil
+}
+
 type MultipartExampleRequestObject struct {
 	Body *multipart.Reader
 }
@@ -504,6 +755,7 @@ func (response MultipartExampledefaultResponse) VisitMultipartExampleResponse(w
 }
 
 type MultipleRequestAndResponseTypesRequestObject struct {
+	ContentType   string
 	JSONBody      *MultipleRequestAndResponseTypesJSONRequestBody
 	FormdataBody  *MultipleRequestAndResponseTypesFormdataRequestBody
 	Body          io.Reader
@@ -585,6 +837,103 @@ func (response MultipleRequestAndResponseTypes400Response) VisitMultipleRequestA
 	return nil
 }
 
+type NegotiatedExampleRequestObject struct {
+	Body *NegotiatedExampleJSONRequestBody
+}
+
+type NegotiatedExampleResponseObject interface {
+	VisitNegotiatedExampleResponse(w http.ResponseWriter) error
+}
+
+type NegotiatedExample200JSONResponse Example
+
+func (response NegotiatedExample200JSONResponse) VisitNegotiatedExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NegotiatedExample200ApplicationxmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response NegotiatedExample200ApplicationxmlResponse) VisitNegotiatedExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type NegotiatedExample200ApplicationyamlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response NegotiatedExample200ApplicationyamlResponse) VisitNegotiatedExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+// NegotiatedExample200NegotiatedResponse is the 200 response of NegotiatedExample, in whichever of its
+// content types the Accept header of the request prefers.
+type NegotiatedExample200NegotiatedResponse struct {
+	Body Example
+}
+
+// NegotiateNegotiatedExampleResponse writes the response in the content type which
+// accept, the Accept header of the request, prefers.
+func (response NegotiatedExample200NegotiatedResponse) NegotiateNegotiatedExampleResponse(w http.ResponseWriter, accept string) error {
+	contentType := runtime.NegotiateContentType(accept, []string{"application/json", "application/xml", "application/yaml"})
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(200)
+	switch contentType {
+	default:
+		return json.NewEncoder(w).Encode(response.Body)
+	case "application/xml":
+		return xml.NewEncoder(w).Encode(response.Body)
+	case "application/yaml":
+		out, err := yaml.Marshal(response.Body)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+	}
+}
+
+// VisitNegotiatedExampleResponse writes the response in its first content type,
+// application/json.
+func (response NegotiatedExample200NegotiatedResponse) VisitNegotiatedExampleResponse(w http.ResponseWriter) error {
+	return response.NegotiateNegotiatedExampleResponse(w, "")
+}
+
+type NegotiatedExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response NegotiatedExampledefaultResponse) VisitNegotiatedExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(response.StatusCode)
+	return nil
+}
+
 type ReservedGoKeywordParametersRequestObject struct {
 	Type string `json:"type"`
 }
@@ -638,6 +987,23 @@ func (response ReusableResponsesdefaultResponse) VisitReusableResponsesResponse(
 	return nil
 }
 
+type WebSocketExampleRequestObject struct {
+	Room   string `json:"room"`
+	Params WebSocketExampleParams
+}
+
+type WebSocketExampleResponseObject interface {
+	VisitWebSocketExampleResponse(w http.ResponseWriter) error
+}
+
+type WebSocketExample101Response struct {
+}
+
+func (response WebSocketExample101Response) VisitWebSocketExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(101)
+	return nil
+}
+
 type TextExampleRequestObject struct {
 	Body *TextExampleTextRequestBody
 }
@@ -861,21 +1227,36 @@ func (response HeadersExampledefaultResponse) VisitHeadersExampleResponse(w http
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
+	// (GET /csv)
+	CSVExample(ctx context.Context, request CSVExampleRequestObject) (CSVExampleResponseObject, error)
+
+	// (GET /events)
+	EventsExample(ctx context.Context, request EventsExampleRequestObject) (EventsExampleResponseObject, error)
+
 	// (POST /json)
 	JSONExample(ctx context.Context, request JSONExampleRequestObject) (JSONExampleResponseObject, error)
 
+	// (PATCH /merge-patch)
+	MergePatchExample(ctx context.Context, request MergePatchExampleRequestObject) (MergePatchExampleResponseObject, error)
+
 	// (POST /multipart)
 	MultipartExample(ctx context.Context, request MultipartExampleRequestObject) (MultipartExampleResponseObject, error)
 
 	// (POST /multiple)
 	MultipleRequestAndResponseTypes(ctx context.Context, request MultipleRequestAndResponseTypesRequestObject) (MultipleRequestAndResponseTypesResponseObject, error)
 
+	// (POST /negotiated)
+	NegotiatedExample(ctx context.Context, request NegotiatedExampleRequestObject) (NegotiatedExampleResponseObject, error)
+
 	// (GET /reserved-go-keyword-parameters/{type})
 	ReservedGoKeywordParameters(ctx context.Context, request ReservedGoKeywordParametersRequestObject) (ReservedGoKeywordParametersResponseObject, error)
 
 	// (POST /reusable-responses)
 	ReusableResponses(ctx context.Context, request ReusableResponsesRequestObject) (ReusableResponsesResponseObject, error)
 
+	// (GET /socket/{room})
+	WebSocketExample(ctx context.Context, w http.ResponseWriter, r *http.Request, request WebSocketExampleRequestObject) error
+
 	// (POST /text)
 	TextExample(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error)
 
@@ -904,6 +1285,11 @@ type StrictHTTPServerOptions struct {
 func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
 	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
 		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			var contentTypeErr *UnsupportedContentTypeError
+			if errors.As(err, &contentTypeErr) {
+				http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+				return
+			}
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		},
 		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
@@ -916,12 +1302,72 @@ func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []Strict
 	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
+// UnsupportedContentTypeError is passed to the RequestErrorHandlerFunc when the
+// Content-Type of a request body matches none of those of its operation.
+type UnsupportedContentTypeError struct {
+	ContentType string
+}
+
+func (e *UnsupportedContentTypeError) Error() string {
+	return fmt.Sprintf("unsupported content type %q", e.ContentType)
+}
+
 type strictHandler struct {
 	ssi         StrictServerInterface
 	middlewares []StrictMiddlewareFunc
 	options     StrictHTTPServerOptions
 }
 
+// CSVExample operation middleware
+func (sh *strictHandler) CSVExample(w http.ResponseWriter, r *http.Request) {
+	var request CSVExampleRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CSVExample(ctx, request.(CSVExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CSVExample")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CSVExampleResponseObject); ok {
+		if err := validResponse.VisitCSVExampleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// EventsExample operation middleware
+func (sh *strictHandler) EventsExample(w http.ResponseWriter, r *http.Request, params EventsExampleParams) {
+	var request EventsExampleRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EventsExample(ctx, request.(EventsExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventsExample")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EventsExampleResponseObject); ok {
+		if err := validResponse.VisitEventsExampleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
 // JSONExample operation middleware
 func (sh *strictHandler) JSONExample(w http.ResponseWriter, r *http.Request) {
 	var request JSONExampleRequestObject
@@ -953,6 +1399,37 @@ func (sh *strictHandler) JSONExample(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// MergePatchExample operation middleware
+func (sh *strictHandler) MergePatchExample(w http.ResponseWriter, r *http.Request) {
+	var request MergePatchExampleRequestObject
+
+	var body MergePatchExampleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MergePatchExample(ctx, request.(MergePatchExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergePatchExample")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MergePatchExampleResponseObject); ok {
+		if err := validResponse.VisitMergePatchExampleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
 // MultipartExample operation middleware
 func (sh *strictHandler) MultipartExample(w http.ResponseWriter, r *http.Request) {
 	var request MultipartExampleRequestObject
@@ -988,15 +1465,15 @@ func (sh *strictHandler) MultipartExample(w http.ResponseWriter, r *http.Request
 func (sh *strictHandler) MultipleRequestAndResponseTypes(w http.ResponseWriter, r *http.Request) {
 	var request MultipleRequestAndResponseTypesRequestObject
 
-	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+	request.ContentType = r.Header.Get("Content-Type")
+	if runtime.MatchesContentType(r.Header.Get("Content-Type"), "application/json") {
 		var body MultipleRequestAndResponseTypesJSONRequestBody
 		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 			return
 		}
 		request.JSONBody = &body
-	}
-	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+	} else if runtime.MatchesContentType(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
 		if err := r.ParseForm(); err != nil {
 			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode formdata: %w", err))
 			return
@@ -1007,19 +1484,16 @@ func (sh *strictHandler) MultipleRequestAndResponseTypes(w http.ResponseWriter,
 			return
 		}
 		request.FormdataBody = &body
-	}
-	if strings.HasPrefix(r.Header.Get("Content-Type"), "image/png") {
+	} else if runtime.MatchesContentType(r.Header.Get("Content-Type"), "image/png") {
 		request.Body = r.Body
-	}
-	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+	} else if runtime.MatchesContentType(r.Header.Get("Content-Type"), "multipart/form-data") {
 		if reader, err := r.MultipartReader(); err != nil {
 			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
 			return
 		} else {
 			request.MultipartBody = reader
 		}
-	}
-	if strings.HasPrefix(r.Header.Get("Content-Type"), "text/plain") {
+	} else if runtime.MatchesContentType(r.Header.Get("Content-Type"), "text/plain") {
 		data, err := io.ReadAll(r.Body)
 		if err != nil {
 			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
@@ -1027,6 +1501,9 @@ func (sh *strictHandler) MultipleRequestAndResponseTypes(w http.ResponseWriter,
 		}
 		body := MultipleRequestAndResponseTypesTextRequestBody(data)
 		request.TextBody = &body
+	} else if r.Header.Get("Content-Type") != "" {
+		sh.options.RequestErrorHandlerFunc(w, r, &UnsupportedContentTypeError{ContentType: r.Header.Get("Content-Type")})
+		return
 	}
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
@@ -1049,6 +1526,43 @@ func (sh *strictHandler) MultipleRequestAndResponseTypes(w http.ResponseWriter,
 	}
 }
 
+// NegotiatedExample operation middleware
+func (sh *strictHandler) NegotiatedExample(w http.ResponseWriter, r *http.Request) {
+	var request NegotiatedExampleRequestObject
+
+	var body NegotiatedExampleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.NegotiatedExample(ctx, request.(NegotiatedExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "NegotiatedExample")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if negotiated, ok := response.(interface {
+		NegotiateNegotiatedExampleResponse(http.ResponseWriter, string) error
+	}); ok {
+		if err := negotiated.NegotiateNegotiatedExampleResponse(w, r.Header.Get("Accept")); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if validResponse, ok := response.(NegotiatedExampleResponseObject); ok {
+		if err := validResponse.VisitNegotiatedExampleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
 // ReservedGoKeywordParameters operation middleware
 func (sh *strictHandler) ReservedGoKeywordParameters(w http.ResponseWriter, r *http.Request, pType string) {
 	var request ReservedGoKeywordParametersRequestObject
@@ -1106,6 +1620,26 @@ func (sh *strictHandler) ReusableResponses(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// WebSocketExample operation middleware
+func (sh *strictHandler) WebSocketExample(w http.ResponseWriter, r *http.Request, room string, params WebSocketExampleParams) {
+	var request WebSocketExampleRequestObject
+
+	request.Room = room
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return nil, sh.ssi.WebSocketExample(ctx, w, r, request.(WebSocketExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebSocketExample")
+	}
+
+	// The handler writes the response itself, usually by upgrading the connection
+	if _, err := handler(r.Context(), w, r, request); err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	}
+}
+
 // TextExample operation middleware
 func (sh *strictHandler) TextExample(w http.ResponseWriter, r *http.Request) {
 	var request TextExampleRequestObject
@@ -1263,23 +1797,28 @@ func (sh *strictHandler) HeadersExample(w http.ResponseWriter, r *http.Request,
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYX2/bNhD/KgS3p0KynDRPeluDotu6rYOTPg15oMWzzFYiuePJimHouw8UJf+pFS/O",
-	"7BgY+mbRd787/u4Pj1zxzJTWaNDkeLriCM4a7aD9mAqJ8HcFjvyXBJehsqSM5il/J+Sk+6+JOELlxLSA",
-	"Xt3LZ0YT6FZVWFuoTHjV5Ivz+ivusjmUwv/6EWHGU/5DsnElCf+6BB5FaQvgTdNE33jw6SOP+ByEBGy9",
-	"DT+vdrFpaYGn3BEqnXMPEsSuB8WUJsgBvTUv2jnhBXo/0hW3aCwgqcDRQhQVDFvqVsz0C2QUdqD0zOxz",
-	"eWs0CaUdk2o2AwRNrCOPeQzHXGWtQQLJpkvmLWTEHOACkEecFHnH+N32OuscdjziC0AXDF2NxqOxj5ex",
-	"oIVVPOVv26WIW0HzdkPrAFkzFPdf7z79wZRjoiJTClKZKIolKwW6uSgKkExpMt7HKiM34q0pbCP/i+zU",
-	"33dc+rRpM+idkctzZEybmFv5fD0ev1JiNhG/CcaGMNZOJVsV1sLMRFUMkP5Zf9Wm1gwQDXY7S8qqIGUF",
-	"0nawdtn+vRd5DuVrvGRmsIylIHEm1k9l6aLEd81gsEju5qZ2bG5qRoZJEAWrFc1Zr/hNdSvNBHNK5wWw",
-	"3qloMJIFdD33Jy0n3V7uPcbZaynaQXmM67qO2+BVWIDOjAT5MlhVihwSq/NddY8tiKd8uiSftvvd9URJ",
-	"FHGCR0psIZQ+fHS8Ujv5zvTJCjuUK0J7JMo4N/FXWNYGZWwFihII0CUrb73xwDkMlPKfa0mWCc2mwLQo",
-	"QTIxI0D2wbAO0u2V7KSz+8F8DCIbqPa8XX+kf624p6Q9g3nEvQGeBlZCXSv0QSesIDpA28O/5ud/CkDP",
-	"Zpj04h1Tw22wb1Fr6hBmzrfEocgN8BcsTbYkLjMwHM64vdn3Nc4gH8mnz/17eHzWkX/C1vfatX0sYVVY",
-	"fJqzTus5tL2wkz6DxYWSYJLS3hyJfDFSnYVMzRTIuNtFHHx7qiXcGp0h0O4I5O8T2hBbg/lrDs2BBQYi",
-	"5gyrgZWVI2aFc0xR20UKFa5KEvaax+eNZ7fB0v2mnR6K6pszxfTNpSJ6M746XuXtmfNmZ5R5oh4nv70P",
-	"MsfeF082Mx058Z3O7oXK2V9S4q0XleES/jkIbM70DNTCT0RaMgSqUINkCyX6R4C92uwANmEdmoWCG5tp",
-	"qH/dOWYgig5iXfPo0AvQw//4eeKc72bnztMm4uGJKyRLhYWPKJFNkyQ8jY1cLfIccKRMIqzizUPzTwAA",
-	"AP//O0NNuucUAAA=",
+	"H4sIAAAAAAAC/+xZX3PTOBD/Kju6e+LsOgWe8gYdhrvjDpgWuAeGh421SQS2JCQ5rieT734jyW7+OW1S",
+	"knaG4SmJs9pd/fa3q115znJVaiVJOsuGc2bIaiUthR8j5Ia+V2Sd/8XJ5kZoJ5RkQ/YS+WX73yJhhiqL",
+	"o4K65V4+V9KRDEtR60Lk6JdmX61fP2c2n1KJ/tvvhsZsyH7Llq5k8V+b0TWWuiC2WCySDQ/evWEJmxJy",
+	"MsHb+PV8XbdrNLEhs84IOWFeSRR72ismpKMJGW/Ni7ZOeIHOj+GcaaM0GSciRjMsKuq31D5Ro6+Utyhp",
+	"ZdylqrfV5KqKUG16kjCJ5Q4DPjjCEGfDz1EqafV82TLuxYUcq+1AXijpUEgLXIzHZEg6aCMHXocFW2nv",
+	"NnEYNeCt5w4smRkZljAnnEeFXa0+hxYtyxI2I2OjofOzwdnAb0hpkqgFG7Jn4VHCNLppgCHL7cx/TqiH",
+	"ci+MwcaCGkPclQU0BLURzpEEtHBx9SmBWrgpIBhVw1gZIMynrfwZC7ZN4OFf3O/96tOrNrDJOvWfDgYb",
+	"HHZ07Tr3lsQRjkp7F4mXcV+yAv1mdtA6PBtjVfSA8FF+k6qWQMaoSNSEZTTr8rcFbn2br8Lfy51qNFiS",
+	"C3nzec6E1/u9ItOwjm0tj1Yp5kxFyW0p82U/CIOvqXWGsLw9V48CTVdutOqrYn9fvXsLwgJWTpXoRI5F",
+	"0UCJxk6xKIiDkE550le5s9v88ctXCRTq4UvFm1PUv8Wd+J6qzC4S9nww2KXjxqls5bw4OE4lmQmlGl0+",
+	"DeHqvmycOooLCiUAZQMheiVxgaFUhXLAKVecOLgpgcWSoMbGFy6akWna+rQdyH+99ffe5qHhXPH7j1+h",
+	"7Q9tVTih0bjVPNzAvxPZB/4bfdlYmTLl6PBEqB/L0qMCX9Aq7uvLrqaqtjBVNTgFnLCIx2e3cKMTEBIQ",
+	"rJCTgqBzKumNZEFtc/hC8st2Lx+8jpOXyWRNy3Va13UagleZgmSoDfdTK0qcUKblZH25142ODdmoccSS",
+	"ni7tSCRK4vGpCxTyjnPzYcrJL6SPltgxXSVNlBPoiO9O2C6ZbExU6481LDbydLUtFjIchUpS+HyR56Qd",
+	"xCEItKExmZ7G5u2NKz9Ze7NB2rL4cSUN3k/LUdpbQ6Gl4elEpd+oqZXh6bK9z+aeEIudE9X7G0nIUcKI",
+	"QGJJHHDsyMBrBa1Ku0WQy9bua/UmiixV7Rgw/Iy3nC9CJu0xXtyk2p7TxX2TtkMzXmOka6buysQWOkNj",
+	"64/RvmzvwS9aulyReJwEu71KbV3sPETfYlX+jVw2N0qVi51T7X80ugqCtw+267zzKg/iXdI/H4ePH+Hv",
+	"+eC8px2rhcunQk5AG+VUrgrrXWDXaU2jiEq0FGDyhN/dUn+g67266SN2FQ99bB7Kqyo+3I1Zu2of2O7Z",
+	"pOyB4kxwUlmpnx+o+dFAtZpyMRbE03YXafRtV+W8UDI35NanC38LI5WDG2V+aA9DfEAgAaugJigr60Cj",
+	"tSBcKLaFiDeWnLZq7MelZxfR0odG7xPVJyeK6ZPHiujzwfnhS56dmDdrU8KOfLz851WUObQNPdo4cmBf",
+	"ejy7j5TOfqxIV96q9Kfwn1Fg2frkJGa+cZQcDLnKSOIwE9hdnW7lZqvg9qM7urE8dLs3PPc5v/t1PWV3",
+	"X2n/pJe6p3x3dmqeLhIWb3IjWSpT+Ig6p4dZFt9QndkaJxMyZ0JlqAVbfFn8PwCtNdux6xwAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1353,3 +1892,86 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+var (
+	swaggerOnce      sync.Once
+	cachedSwagger    *openapi3.T
+	cachedSwaggerErr error
+)
+
+// GetSwaggerCached is like GetSwagger, but only loads the specification the
+// first time it's called. Every caller gets the same *openapi3.T, which must
+// not be modified.
+func GetSwaggerCached() (*openapi3.T, error) {
+	swaggerOnce.Do(func() {
+		cachedSwagger, cachedSwaggerErr = GetSwagger()
+	})
+	return cachedSwagger, cachedSwaggerErr
+}
+
+// MustGetSwagger is like GetSwaggerCached, but panics if the specification
+// can't be loaded.
+func MustGetSwagger() *openapi3.T {
+	swagger, err := GetSwaggerCached()
+	if err != nil {
+		panic(fmt.Sprintf("error loading embedded swagger spec: %s", err))
+	}
+	return swagger
+}
+
+var (
+	specHandlerOnce sync.Once
+	specJSON        []byte
+	specYAML        []byte
+	specHandlerErr  error
+)
+
+// loadSpecFormats decodes the embedded spec, and converts it to YAML, the
+// first time SpecHandler serves it.
+func loadSpecFormats() {
+	specJSON, specHandlerErr = rawSpec()
+	if specHandlerErr != nil {
+		return
+	}
+	var spec yaml.MapSlice
+	if specHandlerErr = yaml.Unmarshal(specJSON, &spec); specHandlerErr != nil {
+		return
+	}
+	specYAML, specHandlerErr = yaml.Marshal(spec)
+}
+
+// SpecHandler returns an http.HandlerFunc serving the embedded OpenAPI spec
+// as JSON, or as YAML when the request has a format=yaml query parameter.
+// Responses have an ETag derived from their content, and requests whose
+// If-None-Match header has it get a 304 Not Modified response.
+func SpecHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		specHandlerOnce.Do(loadSpecFormats)
+		if specHandlerErr != nil {
+			http.Error(w, fmt.Sprintf("error loading spec: %s", specHandlerErr), http.StatusInternalServerError)
+			return
+		}
+
+		var data []byte
+		switch format := r.URL.Query().Get("format"); format {
+		case "", "json":
+			data = specJSON
+			w.Header().Set("Content-Type", "application/json")
+		case "yaml":
+			data = specYAML
+			w.Header().Set("Content-Type", "application/yaml")
+		default:
+			http.Error(w, fmt.Sprintf("unsupported spec format %q, expected json or yaml", format), http.StatusBadRequest)
+			return
+		}
+
+		sum := sha256.Sum256(data)
+		etag := `"` + hex.EncodeToString(sum[:]) + `"`
+		w.Header().Set("ETag", etag)
+		if match := r.Header.Get("If-None-Match"); match != "" && (match == "*" || strings.Contains(match, etag)) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = w.Write(data)
+	}
+}
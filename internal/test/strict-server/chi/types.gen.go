@@ -8,12 +8,28 @@ type Example struct {
 	Value *string `json:"value,omitempty"`
 }
 
+// ReportRow defines model for reportRow.
+type ReportRow struct {
+	Count int    `json:"count"`
+	Name  string `json:"name"`
+}
+
 // Reusableresponse defines model for reusableresponse.
 type Reusableresponse = Example
 
+// EventsExampleParams defines parameters for EventsExample.
+type EventsExampleParams struct {
+	Count int `form:"count" json:"count"`
+}
+
 // MultipleRequestAndResponseTypesTextBody defines parameters for MultipleRequestAndResponseTypes.
 type MultipleRequestAndResponseTypesTextBody = string
 
+// WebSocketExampleParams defines parameters for WebSocketExample.
+type WebSocketExampleParams struct {
+	Name string `form:"name" json:"name"`
+}
+
 // TextExampleTextBody defines parameters for TextExample.
 type TextExampleTextBody = string
 
@@ -26,6 +42,9 @@ type HeadersExampleParams struct {
 // JSONExampleJSONRequestBody defines body for JSONExample for application/json ContentType.
 type JSONExampleJSONRequestBody = Example
 
+// MergePatchExampleJSONRequestBody defines body for MergePatchExample for application/merge-patch+json ContentType.
+type MergePatchExampleJSONRequestBody = Example
+
 // MultipartExampleMultipartRequestBody defines body for MultipartExample for multipart/form-data ContentType.
 type MultipartExampleMultipartRequestBody = Example
 
@@ -41,6 +60,9 @@ type MultipleRequestAndResponseTypesMultipartRequestBody = Example
 // MultipleRequestAndResponseTypesTextRequestBody defines body for MultipleRequestAndResponseTypes for text/plain ContentType.
 type MultipleRequestAndResponseTypesTextRequestBody = MultipleRequestAndResponseTypesTextBody
 
+// NegotiatedExampleJSONRequestBody defines body for NegotiatedExample for application/json ContentType.
+type NegotiatedExampleJSONRequestBody = Example
+
 // ReusableResponsesJSONRequestBody defines body for ReusableResponses for application/json ContentType.
 type ReusableResponsesJSONRequestBody = Example
 
@@ -6,13 +6,18 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
 
+	"gopkg.in/yaml.v2"
+
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
 
@@ -21,12 +26,28 @@ type Example struct {
 	Value *string `json:"value,omitempty"`
 }
 
+// ReportRow defines model for reportRow.
+type ReportRow struct {
+	Count int    `json:"count"`
+	Name  string `json:"name"`
+}
+
 // Reusableresponse defines model for reusableresponse.
 type Reusableresponse = Example
 
+// EventsExampleParams defines parameters for EventsExample.
+type EventsExampleParams struct {
+	Count int `form:"count" json:"count"`
+}
+
 // MultipleRequestAndResponseTypesTextBody defines parameters for MultipleRequestAndResponseTypes.
 type MultipleRequestAndResponseTypesTextBody = string
 
+// WebSocketExampleParams defines parameters for WebSocketExample.
+type WebSocketExampleParams struct {
+	Name string `form:"name" json:"name"`
+}
+
 // TextExampleTextBody defines parameters for TextExample.
 type TextExampleTextBody = string
 
@@ -39,6 +60,9 @@ type HeadersExampleParams struct {
 // JSONExampleJSONRequestBody defines body for JSONExample for application/json ContentType.
 type JSONExampleJSONRequestBody = Example
 
+// MergePatchExampleJSONRequestBody defines body for MergePatchExample for application/merge-patch+json ContentType.
+type MergePatchExampleJSONRequestBody = Example
+
 // MultipartExampleMultipartRequestBody defines body for MultipartExample for multipart/form-data ContentType.
 type MultipartExampleMultipartRequestBody = Example
 
@@ -54,6 +78,9 @@ type MultipleRequestAndResponseTypesMultipartRequestBody = Example
 // MultipleRequestAndResponseTypesTextRequestBody defines body for MultipleRequestAndResponseTypes for text/plain ContentType.
 type MultipleRequestAndResponseTypesTextRequestBody = MultipleRequestAndResponseTypesTextBody
 
+// NegotiatedExampleJSONRequestBody defines body for NegotiatedExample for application/json ContentType.
+type NegotiatedExampleJSONRequestBody = Example
+
 // ReusableResponsesJSONRequestBody defines body for ReusableResponses for application/json ContentType.
 type ReusableResponsesJSONRequestBody = Example
 
@@ -69,6 +96,22 @@ type HeadersExampleJSONRequestBody = Example
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which is called with every response received, whatever its status.
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// operationIDContextKey is the key of the ID of the operation being called in
+// the contexts which the client passes to request and response editors.
+type operationIDContextKey struct{}
+
+// OperationID returns the ID of the operation being called, as generated from
+// its operationId, from the context passed to request and response editors, so
+// that they can act differently depending on the operation.
+func OperationID(ctx context.Context) (string, bool) {
+	operationID, ok := ctx.Value(operationIDContextKey{}).(string)
+	return operationID, ok
+}
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -91,6 +134,28 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// A list of callbacks for inspecting or modifying responses, which are
+	// called as soon as they're received. If one returns an error, the
+	// response body is closed and the error is returned instead of the response.
+	ResponseEditors []ResponseEditorFn
+
+	// The policy for retrying failed requests, set by WithRetry.
+	retryPolicy *runtime.RetryPolicy
+
+	// The TLS configuration and proxy of the default http.Client, set by
+	// WithTLSConfig and WithProxy.
+	tlsConfig *tls.Config
+	proxy     func(*http.Request) (*url.URL, error)
+
+	// The transport of the default http.Client, set by WithRoundTripper, and
+	// the functions wrapping it, set by WithTransportMiddleware.
+	roundTripper         http.RoundTripper
+	transportMiddlewares []func(http.RoundTripper) http.RoundTripper
+
+	// Whether responses keep the request which was sent, set by
+	// WithCaptureRequest.
+	captureRequest bool
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -115,6 +180,37 @@ func NewClient(server string, opts ...ClientOption) (*Client, error) {
 	// create httpClient, if not already present
 	if client.Client == nil {
 		client.Client = &http.Client{}
+		if client.tlsConfig != nil || client.proxy != nil || client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+			var transport http.RoundTripper = http.DefaultTransport
+			if client.roundTripper != nil {
+				transport = client.roundTripper
+			}
+			if client.tlsConfig != nil || client.proxy != nil {
+				base, ok := transport.(*http.Transport)
+				if !ok {
+					return nil, errors.New("WithTLSConfig and WithProxy can only be combined with a WithRoundTripper of an *http.Transport")
+				}
+				t := base.Clone()
+				if client.tlsConfig != nil {
+					t.TLSClientConfig = client.tlsConfig
+				}
+				if client.proxy != nil {
+					t.Proxy = client.proxy
+				}
+				transport = t
+			}
+			for _, wrap := range client.transportMiddlewares {
+				transport = wrap(transport)
+			}
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.tlsConfig != nil || client.proxy != nil {
+		return nil, errors.New("WithTLSConfig and WithProxy only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	} else if client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+		return nil, errors.New("WithRoundTripper and WithTransportMiddleware only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	}
+	if client.retryPolicy != nil {
+		client.Client = runtime.NewRetryDoer(client.Client, *client.retryPolicy)
 	}
 	return &client, nil
 }
@@ -128,6 +224,77 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	}
 }
 
+// WithTLSConfig sets the TLS configuration, such as the certificates to
+// trust or present, of the http.Client which the client creates. It can't be
+// combined with WithHTTPClient, whose Doer should be configured instead.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		c.tlsConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function which chooses the proxy for each request made
+// by the http.Client which the client creates, such as http.ProxyURL. It
+// can't be combined with WithHTTPClient, whose Doer should be configured
+// instead.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *Client) error {
+		c.proxy = proxy
+		return nil
+	}
+}
+
+// WithRoundTripper sets the transport of the http.Client which the client
+// creates, in place of http.DefaultTransport. When it's an *http.Transport,
+// the TLS configuration and proxy set by WithTLSConfig and WithProxy apply to
+// a copy of it. It can't be combined with WithHTTPClient, whose Doer should
+// be configured instead.
+func WithRoundTripper(roundTripper http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		c.roundTripper = roundTripper
+		return nil
+	}
+}
+
+// WithTransportMiddleware wraps the transport of the http.Client which the
+// client creates, including any TLS configuration and proxy set by
+// WithTLSConfig and WithProxy, with the http.RoundTripper which wrap returns,
+// such as one which logs or traces requests. Each call wraps the transport of
+// the previous ones. It can't be combined with WithHTTPClient, whose Doer
+// should be configured instead.
+func WithTransportMiddleware(wrap func(next http.RoundTripper) http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		c.transportMiddlewares = append(c.transportMiddlewares, wrap)
+		return nil
+	}
+}
+
+// WithRetry makes the client retry requests which fail with a network error
+// or a retryable status code, with exponential backoff which honors any
+// Retry-After header. Unless the policy says otherwise, only GET, PUT, DELETE
+// and HEAD requests are retried, on 502, 503 and 504 responses. The retries
+// wrap whichever Doer the client ends up with, including one set by
+// WithHTTPClient.
+func WithRetry(policy runtime.RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithCaptureRequest makes the client keep a copy of the request which each
+// response answers, after any redirects, as its Request, and so as the
+// HTTPRequest of the responses of the WithResponse methods, for debugging
+// retries. The copy has the URL and headers of the request, but not its body,
+// so that the body can be garbage collected.
+func WithCaptureRequest(capture bool) ClientOption {
+	return func(c *Client) error {
+		c.captureRequest = capture
+		return nil
+	}
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -137,13 +304,34 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called right after receiving a response, before it's parsed. This can be
+// used for metrics, or to turn error responses into errors.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
+	// CSVExample request
+	CSVExample(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventsExample request
+	EventsExample(ctx context.Context, params *EventsExampleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// JSONExample request with any body
 	JSONExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	JSONExample(ctx context.Context, body JSONExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// MergePatchExample request with any body
+	MergePatchExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MergePatchExample(ctx context.Context, body MergePatchExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// MultipartExample request with any body
 	MultipartExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -156,6 +344,11 @@ type ClientInterface interface {
 
 	MultipleRequestAndResponseTypesWithTextBody(ctx context.Context, body MultipleRequestAndResponseTypesTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// NegotiatedExample request with any body
+	NegotiatedExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NegotiatedExample(ctx context.Context, body NegotiatedExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ReservedGoKeywordParameters request
 	ReservedGoKeywordParameters(ctx context.Context, pType string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -164,6 +357,9 @@ type ClientInterface interface {
 
 	ReusableResponses(ctx context.Context, body ReusableResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WebSocketExample request
+	WebSocketExample(ctx context.Context, room string, params *WebSocketExampleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// TextExample request with any body
 	TextExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -186,16 +382,43 @@ type ClientInterface interface {
 	HeadersExample(ctx context.Context, params *HeadersExampleParams, body HeadersExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) CSVExample(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCSVExampleRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "CSVExample")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) EventsExample(ctx context.Context, params *EventsExampleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventsExampleRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "EventsExample")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
 func (c *Client) JSONExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewJSONExampleRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "JSONExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) JSONExample(ctx context.Context, body JSONExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -203,11 +426,38 @@ func (c *Client) JSONExample(ctx context.Context, body JSONExampleJSONRequestBod
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "JSONExample")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) MergePatchExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergePatchExampleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "MergePatchExample")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) MergePatchExample(ctx context.Context, body MergePatchExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergePatchExampleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "MergePatchExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) MultipartExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -215,11 +465,12 @@ func (c *Client) MultipartExampleWithBody(ctx context.Context, contentType strin
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "MultipartExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) MultipleRequestAndResponseTypesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -227,11 +478,12 @@ func (c *Client) MultipleRequestAndResponseTypesWithBody(ctx context.Context, co
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "MultipleRequestAndResponseTypes")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) MultipleRequestAndResponseTypes(ctx context.Context, body MultipleRequestAndResponseTypesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -239,11 +491,12 @@ func (c *Client) MultipleRequestAndResponseTypes(ctx context.Context, body Multi
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "MultipleRequestAndResponseTypes")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) MultipleRequestAndResponseTypesWithFormdataBody(ctx context.Context, body MultipleRequestAndResponseTypesFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -251,11 +504,12 @@ func (c *Client) MultipleRequestAndResponseTypesWithFormdataBody(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "MultipleRequestAndResponseTypes")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) MultipleRequestAndResponseTypesWithTextBody(ctx context.Context, body MultipleRequestAndResponseTypesTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -263,11 +517,38 @@ func (c *Client) MultipleRequestAndResponseTypesWithTextBody(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "MultipleRequestAndResponseTypes")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) NegotiatedExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNegotiatedExampleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "NegotiatedExample")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) NegotiatedExample(ctx context.Context, body NegotiatedExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNegotiatedExampleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "NegotiatedExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) ReservedGoKeywordParameters(ctx context.Context, pType string, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -275,11 +556,12 @@ func (c *Client) ReservedGoKeywordParameters(ctx context.Context, pType string,
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "ReservedGoKeywordParameters")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) ReusableResponsesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -287,11 +569,12 @@ func (c *Client) ReusableResponsesWithBody(ctx context.Context, contentType stri
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "ReusableResponses")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) ReusableResponses(ctx context.Context, body ReusableResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -299,11 +582,25 @@ func (c *Client) ReusableResponses(ctx context.Context, body ReusableResponsesJS
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "ReusableResponses")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+func (c *Client) WebSocketExample(ctx context.Context, room string, params *WebSocketExampleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebSocketExampleRequest(c.Server, room, params)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "WebSocketExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) TextExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -311,11 +608,12 @@ func (c *Client) TextExampleWithBody(ctx context.Context, contentType string, bo
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "TextExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) TextExampleWithTextBody(ctx context.Context, body TextExampleTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -323,11 +621,12 @@ func (c *Client) TextExampleWithTextBody(ctx context.Context, body TextExampleTe
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "TextExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) UnknownExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -335,11 +634,12 @@ func (c *Client) UnknownExampleWithBody(ctx context.Context, contentType string,
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "UnknownExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) UnspecifiedContentTypeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -347,11 +647,12 @@ func (c *Client) UnspecifiedContentTypeWithBody(ctx context.Context, contentType
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "UnspecifiedContentType")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) URLEncodedExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -359,11 +660,12 @@ func (c *Client) URLEncodedExampleWithBody(ctx context.Context, contentType stri
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "URLEncodedExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) URLEncodedExampleWithFormdataBody(ctx context.Context, body URLEncodedExampleFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -371,11 +673,12 @@ func (c *Client) URLEncodedExampleWithFormdataBody(ctx context.Context, body URL
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "URLEncodedExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) HeadersExampleWithBody(ctx context.Context, params *HeadersExampleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -383,11 +686,12 @@ func (c *Client) HeadersExampleWithBody(ctx context.Context, params *HeadersExam
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "HeadersExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
 }
 
 func (c *Client) HeadersExample(ctx context.Context, params *HeadersExampleParams, body HeadersExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -395,11 +699,82 @@ func (c *Client) HeadersExample(ctx context.Context, params *HeadersExampleParam
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "HeadersExample")
 	req = req.WithContext(ctx)
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, req)
+}
+
+// NewCSVExampleRequest generates requests for CSVExample
+func NewCSVExampleRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/csv")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEventsExampleRequest generates requests for EventsExample
+func NewEventsExampleRequest(server string, params *EventsExampleParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, params.Count); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
 // NewJSONExampleRequest calls the generic JSONExample builder with application/json body
@@ -442,6 +817,46 @@ func NewJSONExampleRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
+// NewMergePatchExampleRequest calls the generic MergePatchExample builder with application/merge-patch+json body
+func NewMergePatchExampleRequest(server string, body MergePatchExampleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMergePatchExampleRequestWithBody(server, "application/merge-patch+json", bodyReader)
+}
+
+// NewMergePatchExampleRequestWithBody generates requests for MergePatchExample with any type of body
+func NewMergePatchExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/merge-patch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewMultipartExampleRequestWithBody generates requests for MultipartExample with any type of body
 func NewMultipartExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -529,6 +944,46 @@ func NewMultipleRequestAndResponseTypesRequestWithBody(server string, contentTyp
 	return req, nil
 }
 
+// NewNegotiatedExampleRequest calls the generic NegotiatedExample builder with application/json body
+func NewNegotiatedExampleRequest(server string, body NegotiatedExampleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNegotiatedExampleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewNegotiatedExampleRequestWithBody generates requests for NegotiatedExample with any type of body
+func NewNegotiatedExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/negotiated")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewReservedGoKeywordParametersRequest generates requests for ReservedGoKeywordParameters
 func NewReservedGoKeywordParametersRequest(server string, pType string) (*http.Request, error) {
 	var err error
@@ -603,15 +1058,65 @@ func NewReusableResponsesRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
-// NewTextExampleRequestWithTextBody calls the generic TextExample builder with text/plain body
-func NewTextExampleRequestWithTextBody(server string, body TextExampleTextRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	bodyReader = strings.NewReader(string(body))
-	return NewTextExampleRequestWithBody(server, "text/plain", bodyReader)
-}
-
-// NewTextExampleRequestWithBody generates requests for TextExample with any type of body
-func NewTextExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWebSocketExampleRequest generates requests for WebSocketExample
+func NewWebSocketExampleRequest(server string, room string, params *WebSocketExampleParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "room", runtime.ParamLocationPath, room)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/socket/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, params.Name); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTextExampleRequestWithTextBody calls the generic TextExample builder with text/plain body
+func NewTextExampleRequestWithTextBody(server string, body TextExampleTextRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = strings.NewReader(string(body))
+	return NewTextExampleRequestWithBody(server, "text/plain", bodyReader)
+}
+
+// NewTextExampleRequestWithBody generates requests for TextExample with any type of body
+func NewTextExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -797,6 +1302,40 @@ func NewHeadersExampleRequestWithBody(server string, params *HeadersExampleParam
 	return req, nil
 }
 
+// do sends the request, then calls the response editors.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	rsp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.captureRequest {
+		rsp.Request = captureRequest(rsp, req)
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(ctx, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// capturedRequestContextKey marks the copies of requests which clients made
+// WithCaptureRequest keep in their responses.
+type capturedRequestContextKey struct{}
+
+// captureRequest returns a copy of the request which rsp answers, falling
+// back to req, without its body.
+func captureRequest(rsp *http.Response, req *http.Request) *http.Request {
+	if rsp.Request != nil {
+		req = rsp.Request
+	}
+	captured := req.Clone(context.WithValue(req.Context(), capturedRequestContextKey{}, true))
+	captured.Body = nil
+	captured.GetBody = nil
+	return captured
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -811,6 +1350,30 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 	return nil
 }
 
+// ServerURL0 returns the URL of server 0 in the spec, "http://strict.swagger.io/api".
+// The server has no variables, so vars must be empty.
+func ServerURL0(vars map[string]string) (string, error) {
+	return runtime.ServerURL("http://strict.swagger.io/api", vars, nil)
+}
+
+// serverURLs holds the URLs of the servers in the spec, in order, with their
+// variables set to their defaults.
+var serverURLs = []string{
+	"http://strict.swagger.io/api",
+}
+
+// WithBaseURLIndex sets the base URL to that of the i-th server in the spec,
+// with its variables set to their defaults.
+func WithBaseURLIndex(i int) ClientOption {
+	return func(c *Client) error {
+		if i < 0 || i >= len(serverURLs) {
+			return fmt.Errorf("server index %d out of range, the spec has %d servers", i, len(serverURLs))
+		}
+		c.Server = serverURLs[i]
+		return nil
+	}
+}
+
 // ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
@@ -840,11 +1403,24 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// CSVExample request
+	CSVExampleWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CSVExampleResponse, error)
+
+	// EventsExample request
+	EventsExampleWithResponse(ctx context.Context, params *EventsExampleParams, reqEditors ...RequestEditorFn) (*EventsExampleResponse, error)
+
+	// EventsExampleEventStream request returning a reader for the events in the response
+	EventsExampleEventStream(ctx context.Context, params *EventsExampleParams, reqEditors ...RequestEditorFn) (*runtime.EventStreamReader, error)
 	// JSONExample request with any body
 	JSONExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*JSONExampleResponse, error)
 
 	JSONExampleWithResponse(ctx context.Context, body JSONExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*JSONExampleResponse, error)
 
+	// MergePatchExample request with any body
+	MergePatchExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergePatchExampleResponse, error)
+
+	MergePatchExampleWithResponse(ctx context.Context, body MergePatchExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*MergePatchExampleResponse, error)
+
 	// MultipartExample request with any body
 	MultipartExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MultipartExampleResponse, error)
 
@@ -857,6 +1433,11 @@ type ClientWithResponsesInterface interface {
 
 	MultipleRequestAndResponseTypesWithTextBodyWithResponse(ctx context.Context, body MultipleRequestAndResponseTypesTextRequestBody, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error)
 
+	// NegotiatedExample request with any body
+	NegotiatedExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NegotiatedExampleResponse, error)
+
+	NegotiatedExampleWithResponse(ctx context.Context, body NegotiatedExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*NegotiatedExampleResponse, error)
+
 	// ReservedGoKeywordParameters request
 	ReservedGoKeywordParametersWithResponse(ctx context.Context, pType string, reqEditors ...RequestEditorFn) (*ReservedGoKeywordParametersResponse, error)
 
@@ -865,6 +1446,9 @@ type ClientWithResponsesInterface interface {
 
 	ReusableResponsesWithResponse(ctx context.Context, body ReusableResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReusableResponsesResponse, error)
 
+	// WebSocketExample request
+	WebSocketExampleWithResponse(ctx context.Context, room string, params *WebSocketExampleParams, reqEditors ...RequestEditorFn) (*WebSocketExampleResponse, error)
+
 	// TextExample request with any body
 	TextExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TextExampleResponse, error)
 
@@ -887,9 +1471,109 @@ type ClientWithResponsesInterface interface {
 	HeadersExampleWithResponse(ctx context.Context, params *HeadersExampleParams, body HeadersExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*HeadersExampleResponse, error)
 }
 
+var _ ClientWithResponsesInterface = (*ClientWithResponses)(nil)
+
+type CSVExampleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+	CSV200       *[]ReportRow
+}
+
+// Status returns HTTPResponse.Status
+func (r CSVExampleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CSVExampleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r CSVExampleResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of CSVExample, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r CSVExampleResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200
+}
+
+// CSVExampleExpectedStatusCodes returns the status codes which CSVExample has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func CSVExampleExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// CSVExampleHasDefaultResponse is whether CSVExample has a default response,
+// for status codes which aren't in CSVExampleExpectedStatusCodes.
+const CSVExampleHasDefaultResponse = true
+
+type EventsExampleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+}
+
+// Status returns HTTPResponse.Status
+func (r EventsExampleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventsExampleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r EventsExampleResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of EventsExample, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r EventsExampleResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200
+}
+
+// EventsExampleExpectedStatusCodes returns the status codes which EventsExample has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func EventsExampleExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// EventsExampleHasDefaultResponse is whether EventsExample has a default response,
+// for status codes which aren't in EventsExampleExpectedStatusCodes.
+const EventsExampleHasDefaultResponse = true
+
 type JSONExampleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *Example
 }
 
@@ -909,9 +1593,85 @@ func (r JSONExampleResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r JSONExampleResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of JSONExample, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r JSONExampleResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200 && r.HTTPResponse.StatusCode != 400
+}
+
+// JSONExampleExpectedStatusCodes returns the status codes which JSONExample has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func JSONExampleExpectedStatusCodes() []int {
+	return []int{200, 400}
+}
+
+// JSONExampleHasDefaultResponse is whether JSONExample has a default response,
+// for status codes which aren't in JSONExampleExpectedStatusCodes.
+const JSONExampleHasDefaultResponse = true
+
+type MergePatchExampleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+	JSON200      *Example
+}
+
+// Status returns HTTPResponse.Status
+func (r MergePatchExampleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MergePatchExampleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r MergePatchExampleResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of MergePatchExample, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r MergePatchExampleResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200 && r.HTTPResponse.StatusCode != 400
+}
+
+// MergePatchExampleExpectedStatusCodes returns the status codes which MergePatchExample has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func MergePatchExampleExpectedStatusCodes() []int {
+	return []int{200, 400}
+}
+
+// MergePatchExampleHasDefaultResponse is whether MergePatchExample has a default response,
+// for status codes which aren't in MergePatchExampleExpectedStatusCodes.
+const MergePatchExampleHasDefaultResponse = true
+
 type MultipartExampleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -930,9 +1690,36 @@ func (r MultipartExampleResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r MultipartExampleResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of MultipartExample, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r MultipartExampleResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200 && r.HTTPResponse.StatusCode != 400
+}
+
+// MultipartExampleExpectedStatusCodes returns the status codes which MultipartExample has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func MultipartExampleExpectedStatusCodes() []int {
+	return []int{200, 400}
+}
+
+// MultipartExampleHasDefaultResponse is whether MultipartExample has a default response,
+// for status codes which aren't in MultipartExampleExpectedStatusCodes.
+const MultipartExampleHasDefaultResponse = true
+
 type MultipleRequestAndResponseTypesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *Example
 }
 
@@ -952,9 +1739,80 @@ func (r MultipleRequestAndResponseTypesResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r MultipleRequestAndResponseTypesResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// MultipleRequestAndResponseTypesExpectedStatusCodes returns the status codes which MultipleRequestAndResponseTypes has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func MultipleRequestAndResponseTypesExpectedStatusCodes() []int {
+	return []int{200, 400}
+}
+
+// MultipleRequestAndResponseTypesHasDefaultResponse is whether MultipleRequestAndResponseTypes has a default response,
+// for status codes which aren't in MultipleRequestAndResponseTypesExpectedStatusCodes.
+const MultipleRequestAndResponseTypesHasDefaultResponse = false
+
+type NegotiatedExampleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+	JSON200      *Example
+	XML200       *Example
+	YAML200      *Example
+}
+
+// Status returns HTTPResponse.Status
+func (r NegotiatedExampleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NegotiatedExampleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r NegotiatedExampleResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of NegotiatedExample, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r NegotiatedExampleResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200
+}
+
+// NegotiatedExampleExpectedStatusCodes returns the status codes which NegotiatedExample has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func NegotiatedExampleExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// NegotiatedExampleHasDefaultResponse is whether NegotiatedExample has a default response,
+// for status codes which aren't in NegotiatedExampleExpectedStatusCodes.
+const NegotiatedExampleHasDefaultResponse = true
+
 type ReservedGoKeywordParametersResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -973,9 +1831,29 @@ func (r ReservedGoKeywordParametersResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r ReservedGoKeywordParametersResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// ReservedGoKeywordParametersExpectedStatusCodes returns the status codes which ReservedGoKeywordParameters has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func ReservedGoKeywordParametersExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// ReservedGoKeywordParametersHasDefaultResponse is whether ReservedGoKeywordParameters has a default response,
+// for status codes which aren't in ReservedGoKeywordParametersExpectedStatusCodes.
+const ReservedGoKeywordParametersHasDefaultResponse = false
+
 type ReusableResponsesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *Example
 }
 
@@ -995,9 +1873,77 @@ func (r ReusableResponsesResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r ReusableResponsesResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of ReusableResponses, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r ReusableResponsesResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200 && r.HTTPResponse.StatusCode != 400
+}
+
+// ReusableResponsesExpectedStatusCodes returns the status codes which ReusableResponses has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func ReusableResponsesExpectedStatusCodes() []int {
+	return []int{200, 400}
+}
+
+// ReusableResponsesHasDefaultResponse is whether ReusableResponses has a default response,
+// for status codes which aren't in ReusableResponsesExpectedStatusCodes.
+const ReusableResponsesHasDefaultResponse = true
+
+type WebSocketExampleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+}
+
+// Status returns HTTPResponse.Status
+func (r WebSocketExampleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebSocketExampleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r WebSocketExampleResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// WebSocketExampleExpectedStatusCodes returns the status codes which WebSocketExample has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func WebSocketExampleExpectedStatusCodes() []int {
+	return []int{101}
+}
+
+// WebSocketExampleHasDefaultResponse is whether WebSocketExample has a default response,
+// for status codes which aren't in WebSocketExampleExpectedStatusCodes.
+const WebSocketExampleHasDefaultResponse = false
+
 type TextExampleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1016,9 +1962,36 @@ func (r TextExampleResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r TextExampleResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of TextExample, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r TextExampleResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200 && r.HTTPResponse.StatusCode != 400
+}
+
+// TextExampleExpectedStatusCodes returns the status codes which TextExample has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func TextExampleExpectedStatusCodes() []int {
+	return []int{200, 400}
+}
+
+// TextExampleHasDefaultResponse is whether TextExample has a default response,
+// for status codes which aren't in TextExampleExpectedStatusCodes.
+const TextExampleHasDefaultResponse = true
+
 type UnknownExampleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1037,9 +2010,36 @@ func (r UnknownExampleResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r UnknownExampleResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of UnknownExample, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r UnknownExampleResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200 && r.HTTPResponse.StatusCode != 400
+}
+
+// UnknownExampleExpectedStatusCodes returns the status codes which UnknownExample has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func UnknownExampleExpectedStatusCodes() []int {
+	return []int{200, 400}
+}
+
+// UnknownExampleHasDefaultResponse is whether UnknownExample has a default response,
+// for status codes which aren't in UnknownExampleExpectedStatusCodes.
+const UnknownExampleHasDefaultResponse = true
+
 type UnspecifiedContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1058,9 +2058,36 @@ func (r UnspecifiedContentTypeResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r UnspecifiedContentTypeResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of UnspecifiedContentType, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r UnspecifiedContentTypeResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200 && r.HTTPResponse.StatusCode != 400 && r.HTTPResponse.StatusCode != 401 && r.HTTPResponse.StatusCode != 403
+}
+
+// UnspecifiedContentTypeExpectedStatusCodes returns the status codes which UnspecifiedContentType has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func UnspecifiedContentTypeExpectedStatusCodes() []int {
+	return []int{200, 400, 401, 403}
+}
+
+// UnspecifiedContentTypeHasDefaultResponse is whether UnspecifiedContentType has a default response,
+// for status codes which aren't in UnspecifiedContentTypeExpectedStatusCodes.
+const UnspecifiedContentTypeHasDefaultResponse = true
+
 type URLEncodedExampleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 }
 
 // Status returns HTTPResponse.Status
@@ -1079,9 +2106,36 @@ func (r URLEncodedExampleResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r URLEncodedExampleResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of URLEncodedExample, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r URLEncodedExampleResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200 && r.HTTPResponse.StatusCode != 400
+}
+
+// URLEncodedExampleExpectedStatusCodes returns the status codes which URLEncodedExample has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func URLEncodedExampleExpectedStatusCodes() []int {
+	return []int{200, 400}
+}
+
+// URLEncodedExampleHasDefaultResponse is whether URLEncodedExample has a default response,
+// for status codes which aren't in URLEncodedExampleExpectedStatusCodes.
+const URLEncodedExampleHasDefaultResponse = true
+
 type HeadersExampleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *Example
 }
 
@@ -1101,6 +2155,64 @@ func (r HeadersExampleResponse) StatusCode() int {
 	return 0
 }
 
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r HeadersExampleResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of HeadersExample, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r HeadersExampleResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200 && r.HTTPResponse.StatusCode != 400
+}
+
+// HeadersExampleExpectedStatusCodes returns the status codes which HeadersExample has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func HeadersExampleExpectedStatusCodes() []int {
+	return []int{200, 400}
+}
+
+// HeadersExampleHasDefaultResponse is whether HeadersExample has a default response,
+// for status codes which aren't in HeadersExampleExpectedStatusCodes.
+const HeadersExampleHasDefaultResponse = true
+
+// CSVExampleWithResponse request returning *CSVExampleResponse
+func (c *ClientWithResponses) CSVExampleWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CSVExampleResponse, error) {
+	rsp, err := c.CSVExample(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCSVExampleResponse(rsp)
+}
+
+// EventsExampleWithResponse request returning *EventsExampleResponse
+func (c *ClientWithResponses) EventsExampleWithResponse(ctx context.Context, params *EventsExampleParams, reqEditors ...RequestEditorFn) (*EventsExampleResponse, error) {
+	rsp, err := c.EventsExample(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventsExampleResponse(rsp)
+}
+
+// EventsExampleEventStream request returning a reader for the events in the
+// response, which the caller must close. Canceling ctx ends the stream.
+func (c *ClientWithResponses) EventsExampleEventStream(ctx context.Context, params *EventsExampleParams, reqEditors ...RequestEditorFn) (*runtime.EventStreamReader, error) {
+	acceptEventStream := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept", "text/event-stream")
+		return nil
+	}
+	rsp, err := c.EventsExample(ctx, params, append([]RequestEditorFn{acceptEventStream}, reqEditors...)...)
+	if err != nil {
+		return nil, err
+	}
+	return runtime.NewEventStreamResponseReader(rsp)
+}
+
 // JSONExampleWithBodyWithResponse request with arbitrary body returning *JSONExampleResponse
 func (c *ClientWithResponses) JSONExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*JSONExampleResponse, error) {
 	rsp, err := c.JSONExampleWithBody(ctx, contentType, body, reqEditors...)
@@ -1118,6 +2230,23 @@ func (c *ClientWithResponses) JSONExampleWithResponse(ctx context.Context, body
 	return ParseJSONExampleResponse(rsp)
 }
 
+// MergePatchExampleWithBodyWithResponse request with arbitrary body returning *MergePatchExampleResponse
+func (c *ClientWithResponses) MergePatchExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergePatchExampleResponse, error) {
+	rsp, err := c.MergePatchExampleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergePatchExampleResponse(rsp)
+}
+
+func (c *ClientWithResponses) MergePatchExampleWithResponse(ctx context.Context, body MergePatchExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*MergePatchExampleResponse, error) {
+	rsp, err := c.MergePatchExample(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergePatchExampleResponse(rsp)
+}
+
 // MultipartExampleWithBodyWithResponse request with arbitrary body returning *MultipartExampleResponse
 func (c *ClientWithResponses) MultipartExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MultipartExampleResponse, error) {
 	rsp, err := c.MultipartExampleWithBody(ctx, contentType, body, reqEditors...)
@@ -1160,6 +2289,23 @@ func (c *ClientWithResponses) MultipleRequestAndResponseTypesWithTextBodyWithRes
 	return ParseMultipleRequestAndResponseTypesResponse(rsp)
 }
 
+// NegotiatedExampleWithBodyWithResponse request with arbitrary body returning *NegotiatedExampleResponse
+func (c *ClientWithResponses) NegotiatedExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NegotiatedExampleResponse, error) {
+	rsp, err := c.NegotiatedExampleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNegotiatedExampleResponse(rsp)
+}
+
+func (c *ClientWithResponses) NegotiatedExampleWithResponse(ctx context.Context, body NegotiatedExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*NegotiatedExampleResponse, error) {
+	rsp, err := c.NegotiatedExample(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNegotiatedExampleResponse(rsp)
+}
+
 // ReservedGoKeywordParametersWithResponse request returning *ReservedGoKeywordParametersResponse
 func (c *ClientWithResponses) ReservedGoKeywordParametersWithResponse(ctx context.Context, pType string, reqEditors ...RequestEditorFn) (*ReservedGoKeywordParametersResponse, error) {
 	rsp, err := c.ReservedGoKeywordParameters(ctx, pType, reqEditors...)
@@ -1186,6 +2332,15 @@ func (c *ClientWithResponses) ReusableResponsesWithResponse(ctx context.Context,
 	return ParseReusableResponsesResponse(rsp)
 }
 
+// WebSocketExampleWithResponse request returning *WebSocketExampleResponse
+func (c *ClientWithResponses) WebSocketExampleWithResponse(ctx context.Context, room string, params *WebSocketExampleParams, reqEditors ...RequestEditorFn) (*WebSocketExampleResponse, error) {
+	rsp, err := c.WebSocketExample(ctx, room, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebSocketExampleResponse(rsp)
+}
+
 // TextExampleWithBodyWithResponse request with arbitrary body returning *TextExampleResponse
 func (c *ClientWithResponses) TextExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TextExampleResponse, error) {
 	rsp, err := c.TextExampleWithBody(ctx, contentType, body, reqEditors...)
@@ -1255,6 +2410,59 @@ func (c *ClientWithResponses) HeadersExampleWithResponse(ctx context.Context, pa
 	return ParseHeadersExampleResponse(rsp)
 }
 
+// capturedRequest returns the request kept in rsp by a client made
+// WithCaptureRequest(true), or nil.
+func capturedRequest(rsp *http.Response) *http.Request {
+	if rsp.Request == nil || rsp.Request.Context().Value(capturedRequestContextKey{}) == nil {
+		return nil
+	}
+	return rsp.Request
+}
+
+// ParseCSVExampleResponse parses an HTTP response from a CSVExampleWithResponse call
+func ParseCSVExampleResponse(rsp *http.Response) (*CSVExampleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CSVExampleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "csv") && rsp.StatusCode == 200:
+		var dest []ReportRow
+		if err := runtime.UnmarshalCSV(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.CSV200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEventsExampleResponse parses an HTTP response from a EventsExampleWithResponse call
+func ParseEventsExampleResponse(rsp *http.Response) (*EventsExampleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EventsExampleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	return response, nil
+}
+
 // ParseJSONExampleResponse parses an HTTP response from a JSONExampleWithResponse call
 func ParseJSONExampleResponse(rsp *http.Response) (*JSONExampleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1266,6 +2474,34 @@ func ParseJSONExampleResponse(rsp *http.Response) (*JSONExampleResponse, error)
 	response := &JSONExampleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Example
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMergePatchExampleResponse parses an HTTP response from a MergePatchExampleWithResponse call
+func ParseMergePatchExampleResponse(rsp *http.Response) (*MergePatchExampleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MergePatchExampleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
@@ -1292,6 +2528,7 @@ func ParseMultipartExampleResponse(rsp *http.Response) (*MultipartExampleRespons
 	response := &MultipartExampleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -1308,6 +2545,7 @@ func ParseMultipleRequestAndResponseTypesResponse(rsp *http.Response) (*Multiple
 	response := &MultipleRequestAndResponseTypesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
@@ -1326,6 +2564,47 @@ func ParseMultipleRequestAndResponseTypesResponse(rsp *http.Response) (*Multiple
 	return response, nil
 }
 
+// ParseNegotiatedExampleResponse parses an HTTP response from a NegotiatedExampleWithResponse call
+func ParseNegotiatedExampleResponse(rsp *http.Response) (*NegotiatedExampleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NegotiatedExampleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Example
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest Example
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest Example
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseReservedGoKeywordParametersResponse parses an HTTP response from a ReservedGoKeywordParametersWithResponse call
 func ParseReservedGoKeywordParametersResponse(rsp *http.Response) (*ReservedGoKeywordParametersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1337,6 +2616,7 @@ func ParseReservedGoKeywordParametersResponse(rsp *http.Response) (*ReservedGoKe
 	response := &ReservedGoKeywordParametersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -1353,6 +2633,7 @@ func ParseReusableResponsesResponse(rsp *http.Response) (*ReusableResponsesRespo
 	response := &ReusableResponsesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
@@ -1368,6 +2649,23 @@ func ParseReusableResponsesResponse(rsp *http.Response) (*ReusableResponsesRespo
 	return response, nil
 }
 
+// ParseWebSocketExampleResponse parses an HTTP response from a WebSocketExampleWithResponse call
+func ParseWebSocketExampleResponse(rsp *http.Response) (*WebSocketExampleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebSocketExampleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	return response, nil
+}
+
 // ParseTextExampleResponse parses an HTTP response from a TextExampleWithResponse call
 func ParseTextExampleResponse(rsp *http.Response) (*TextExampleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1379,6 +2677,7 @@ func ParseTextExampleResponse(rsp *http.Response) (*TextExampleResponse, error)
 	response := &TextExampleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -1395,6 +2694,7 @@ func ParseUnknownExampleResponse(rsp *http.Response) (*UnknownExampleResponse, e
 	response := &UnknownExampleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -1411,6 +2711,7 @@ func ParseUnspecifiedContentTypeResponse(rsp *http.Response) (*UnspecifiedConten
 	response := &UnspecifiedContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -1427,6 +2728,7 @@ func ParseURLEncodedExampleResponse(rsp *http.Response) (*URLEncodedExampleRespo
 	response := &URLEncodedExampleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	return response, nil
@@ -1443,6 +2745,7 @@ func ParseHeadersExampleResponse(rsp *http.Response) (*HeadersExampleResponse, e
 	response := &HeadersExampleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
 	}
 
 	switch {
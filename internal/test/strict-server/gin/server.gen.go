@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -16,6 +17,9 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
@@ -25,21 +29,36 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /csv)
+	CSVExample(c *gin.Context)
+
+	// (GET /events)
+	EventsExample(c *gin.Context, params EventsExampleParams)
+
 	// (POST /json)
 	JSONExample(c *gin.Context)
 
+	// (PATCH /merge-patch)
+	MergePatchExample(c *gin.Context)
+
 	// (POST /multipart)
 	MultipartExample(c *gin.Context)
 
 	// (POST /multiple)
 	MultipleRequestAndResponseTypes(c *gin.Context)
 
+	// (POST /negotiated)
+	NegotiatedExample(c *gin.Context)
+
 	// (GET /reserved-go-keyword-parameters/{type})
 	ReservedGoKeywordParameters(c *gin.Context, pType string)
 
 	// (POST /reusable-responses)
 	ReusableResponses(c *gin.Context)
 
+	// (GET /socket/{room})
+	WebSocketExample(c *gin.Context, room string, params WebSocketExampleParams)
+
 	// (POST /text)
 	TextExample(c *gin.Context)
 
@@ -65,6 +84,52 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// CSVExample operation middleware
+func (siw *ServerInterfaceWrapper) CSVExample(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CSVExample(c)
+}
+
+// EventsExample operation middleware
+func (siw *ServerInterfaceWrapper) EventsExample(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EventsExampleParams
+
+	// ------------- Required query parameter "count" -------------
+
+	if paramValue := c.Query("count"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument count is required, but not found: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.EventsExample(c, params)
+}
+
 // JSONExample operation middleware
 func (siw *ServerInterfaceWrapper) JSONExample(c *gin.Context) {
 
@@ -78,6 +143,19 @@ func (siw *ServerInterfaceWrapper) JSONExample(c *gin.Context) {
 	siw.Handler.JSONExample(c)
 }
 
+// MergePatchExample operation middleware
+func (siw *ServerInterfaceWrapper) MergePatchExample(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MergePatchExample(c)
+}
+
 // MultipartExample operation middleware
 func (siw *ServerInterfaceWrapper) MultipartExample(c *gin.Context) {
 
@@ -104,6 +182,19 @@ func (siw *ServerInterfaceWrapper) MultipleRequestAndResponseTypes(c *gin.Contex
 	siw.Handler.MultipleRequestAndResponseTypes(c)
 }
 
+// NegotiatedExample operation middleware
+func (siw *ServerInterfaceWrapper) NegotiatedExample(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.NegotiatedExample(c)
+}
+
 // ReservedGoKeywordParameters operation middleware
 func (siw *ServerInterfaceWrapper) ReservedGoKeywordParameters(c *gin.Context) {
 
@@ -141,6 +232,48 @@ func (siw *ServerInterfaceWrapper) ReusableResponses(c *gin.Context) {
 	siw.Handler.ReusableResponses(c)
 }
 
+// WebSocketExample operation middleware
+func (siw *ServerInterfaceWrapper) WebSocketExample(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "room" -------------
+	var room string
+
+	err = runtime.BindStyledParameter("simple", false, "room", c.Param("room"), &room)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter room: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebSocketExampleParams
+
+	// ------------- Required query parameter "name" -------------
+
+	if paramValue := c.Query("name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument name is required, but not found: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.WebSocketExample(c, room, params)
+}
+
 // TextExample operation middleware
 func (siw *ServerInterfaceWrapper) TextExample(c *gin.Context) {
 
@@ -281,11 +414,16 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/csv", wrapper.CSVExample)
+	router.GET(options.BaseURL+"/events", wrapper.EventsExample)
 	router.POST(options.BaseURL+"/json", wrapper.JSONExample)
+	router.PATCH(options.BaseURL+"/merge-patch", wrapper.MergePatchExample)
 	router.POST(options.BaseURL+"/multipart", wrapper.MultipartExample)
 	router.POST(options.BaseURL+"/multiple", wrapper.MultipleRequestAndResponseTypes)
+	router.POST(options.BaseURL+"/negotiated", wrapper.NegotiatedExample)
 	router.GET(options.BaseURL+"/reserved-go-keyword-parameters/:type", wrapper.ReservedGoKeywordParameters)
 	router.POST(options.BaseURL+"/reusable-responses", wrapper.ReusableResponses)
+	router.GET(options.BaseURL+"/socket/:room", wrapper.WebSocketExample)
 	router.POST(options.BaseURL+"/text", wrapper.TextExample)
 	router.POST(options.BaseURL+"/unknown", wrapper.UnknownExample)
 	router.POST(options.BaseURL+"/unspecified-content-type", wrapper.UnspecifiedContentType)
@@ -306,6 +444,63 @@ type ReusableresponseJSONResponse struct {
 	Headers ReusableresponseResponseHeaders
 }
 
+type CSVExampleRequestObject struct {
+}
+
+type CSVExampleResponseObject interface {
+	VisitCSVExampleResponse(w http.ResponseWriter) error
+}
+
+type CSVExample200CSVResponse []ReportRow
+
+func (response CSVExample200CSVResponse) VisitCSVExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	w.WriteHeader(200)
+
+	out, err := runtime.MarshalCSV(response)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+type CSVExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response CSVExampledefaultResponse) VisitCSVExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(response.StatusCode)
+	return nil
+}
+
+type EventsExampleRequestObject struct {
+	Params EventsExampleParams
+}
+
+type EventsExampleResponseObject interface {
+	VisitEventsExampleResponse(w http.ResponseWriter) error
+}
+
+type EventsExample200EventStreamResponse func(writer *runtime.EventStreamWriter) error
+
+func (response EventsExample200EventStreamResponse) VisitEventsExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(200)
+
+	return response(runtime.NewEventStreamWriter(w))
+}
+
+type EventsExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response EventsExampledefaultResponse) VisitEventsExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(response.StatusCode)
+	return nil
+}
+
 type JSONExampleRequestObject struct {
 	Body *JSONExampleJSONRequestBody
 }
@@ -339,6 +534,39 @@ func (response JSONExampledefaultResponse) VisitJSONExampleResponse(w http.Respo
 	return nil
 }
 
+type MergePatchExampleRequestObject struct {
+	Body *MergePatchExampleJSONRequestBody
+}
+
+type MergePatchExampleResponseObject interface {
+	VisitMergePatchExampleResponse(w http.ResponseWriter) error
+}
+
+type MergePatchExample200JSONResponse Example
+
+func (response MergePatchExample200JSONResponse) VisitMergePatchExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePatchExample400Response = BadrequestResponse
+
+func (response MergePatchExample400Response) VisitMergePatchExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type MergePatchExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response MergePatchExampledefaultResponse) VisitMergePatchExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(response.StatusCode)
+	return nil
+}
+
 type MultipartExampleRequestObject struct {
 	Body *multipart.Reader
 }
@@ -375,6 +603,7 @@ func (response MultipartExampledefaultResponse) VisitMultipartExampleResponse(w
 }
 
 type MultipleRequestAndResponseTypesRequestObject struct {
+	ContentType   string
 	JSONBody      *MultipleRequestAndResponseTypesJSONRequestBody
 	FormdataBody  *MultipleRequestAndResponseTypesFormdataRequestBody
 	Body          io.Reader
@@ -456,6 +685,103 @@ func (response MultipleRequestAndResponseTypes400Response) VisitMultipleRequestA
 	return nil
 }
 
+type NegotiatedExampleRequestObject struct {
+	Body *NegotiatedExampleJSONRequestBody
+}
+
+type NegotiatedExampleResponseObject interface {
+	VisitNegotiatedExampleResponse(w http.ResponseWriter) error
+}
+
+type NegotiatedExample200JSONResponse Example
+
+func (response NegotiatedExample200JSONResponse) VisitNegotiatedExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NegotiatedExample200ApplicationxmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response NegotiatedExample200ApplicationxmlResponse) VisitNegotiatedExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type NegotiatedExample200ApplicationyamlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response NegotiatedExample200ApplicationyamlResponse) VisitNegotiatedExampleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+// NegotiatedExample200NegotiatedResponse is the 200 response of NegotiatedExample, in whichever of its
+// content types the Accept header of the request prefers.
+type NegotiatedExample200NegotiatedResponse struct {
+	Body Example
+}
+
+// NegotiateNegotiatedExampleResponse writes the response in the content type which
+// accept, the Accept header of the request, prefers.
+func (response NegotiatedExample200NegotiatedResponse) NegotiateNegotiatedExampleResponse(w http.ResponseWriter, accept string) error {
+	contentType := runtime.NegotiateContentType(accept, []string{"application/json", "application/xml", "application/yaml"})
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(200)
+	switch contentType {
+	default:
+		return json.NewEncoder(w).Encode(response.Body)
+	case "application/xml":
+		return xml.NewEncoder(w).Encode(response.Body)
+	case "application/yaml":
+		out, err := yaml.Marshal(response.Body)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+	}
+}
+
+// VisitNegotiatedExampleResponse writes the response in its first content type,
+// application/json.
+func (response NegotiatedExample200NegotiatedResponse) VisitNegotiatedExampleResponse(w http.ResponseWriter) error {
+	return response.NegotiateNegotiatedExampleResponse(w, "")
+}
+
+type NegotiatedExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response NegotiatedExampledefaultResponse) VisitNegotiatedExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(response.StatusCode)
+	return nil
+}
+
 type ReservedGoKeywordParametersRequestObject struct {
 	Type string `json:"type"`
 }
@@ -509,6 +835,23 @@ func (response ReusableResponsesdefaultResponse) VisitReusableResponsesResponse(
 	return nil
 }
 
+type WebSocketExampleRequestObject struct {
+	Room   string `json:"room"`
+	Params WebSocketExampleParams
+}
+
+type WebSocketExampleResponseObject interface {
+	VisitWebSocketExampleResponse(w http.ResponseWriter) error
+}
+
+type WebSocketExample101Response struct {
+}
+
+func (response WebSocketExample101Response) VisitWebSocketExampleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(101)
+	return nil
+}
+
 type TextExampleRequestObject struct {
 	Body *TextExampleTextRequestBody
 }
@@ -732,21 +1075,36 @@ func (response HeadersExampledefaultResponse) VisitHeadersExampleResponse(w http
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
+	// (GET /csv)
+	CSVExample(ctx context.Context, request CSVExampleRequestObject) (CSVExampleResponseObject, error)
+
+	// (GET /events)
+	EventsExample(ctx context.Context, request EventsExampleRequestObject) (EventsExampleResponseObject, error)
+
 	// (POST /json)
 	JSONExample(ctx context.Context, request JSONExampleRequestObject) (JSONExampleResponseObject, error)
 
+	// (PATCH /merge-patch)
+	MergePatchExample(ctx context.Context, request MergePatchExampleRequestObject) (MergePatchExampleResponseObject, error)
+
 	// (POST /multipart)
 	MultipartExample(ctx context.Context, request MultipartExampleRequestObject) (MultipartExampleResponseObject, error)
 
 	// (POST /multiple)
 	MultipleRequestAndResponseTypes(ctx context.Context, request MultipleRequestAndResponseTypesRequestObject) (MultipleRequestAndResponseTypesResponseObject, error)
 
+	// (POST /negotiated)
+	NegotiatedExample(ctx context.Context, request NegotiatedExampleRequestObject) (NegotiatedExampleResponseObject, error)
+
 	// (GET /reserved-go-keyword-parameters/{type})
 	ReservedGoKeywordParameters(ctx context.Context, request ReservedGoKeywordParametersRequestObject) (ReservedGoKeywordParametersResponseObject, error)
 
 	// (POST /reusable-responses)
 	ReusableResponses(ctx context.Context, request ReusableResponsesRequestObject) (ReusableResponsesResponseObject, error)
 
+	// (GET /socket/{room})
+	WebSocketExample(ctx context.Context, w http.ResponseWriter, r *http.Request, request WebSocketExampleRequestObject) error
+
 	// (POST /text)
 	TextExample(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error)
 
@@ -776,12 +1134,62 @@ type strictHandler struct {
 	middlewares []StrictMiddlewareFunc
 }
 
+// CSVExample operation middleware
+func (sh *strictHandler) CSVExample(ctx *gin.Context) {
+	var request CSVExampleRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CSVExample(ctx, request.(CSVExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CSVExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+	} else if validResponse, ok := response.(CSVExampleResponseObject); ok {
+		if err := validResponse.VisitCSVExampleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// EventsExample operation middleware
+func (sh *strictHandler) EventsExample(ctx *gin.Context, params EventsExampleParams) {
+	var request EventsExampleRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventsExample(ctx, request.(EventsExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventsExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+	} else if validResponse, ok := response.(EventsExampleResponseObject); ok {
+		if err := validResponse.VisitEventsExampleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
 // JSONExample operation middleware
 func (sh *strictHandler) JSONExample(ctx *gin.Context) {
 	var request JSONExampleRequestObject
 
 	var body JSONExampleJSONRequestBody
-	if err := ctx.ShouldBind(&body); err != nil {
+	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
 		return
@@ -808,6 +1216,38 @@ func (sh *strictHandler) JSONExample(ctx *gin.Context) {
 	}
 }
 
+// MergePatchExample operation middleware
+func (sh *strictHandler) MergePatchExample(ctx *gin.Context) {
+	var request MergePatchExampleRequestObject
+
+	var body MergePatchExampleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MergePatchExample(ctx, request.(MergePatchExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergePatchExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+	} else if validResponse, ok := response.(MergePatchExampleResponseObject); ok {
+		if err := validResponse.VisitMergePatchExampleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
 // MultipartExample operation middleware
 func (sh *strictHandler) MultipartExample(ctx *gin.Context) {
 	var request MultipartExampleRequestObject
@@ -843,16 +1283,16 @@ func (sh *strictHandler) MultipartExample(ctx *gin.Context) {
 func (sh *strictHandler) MultipleRequestAndResponseTypes(ctx *gin.Context) {
 	var request MultipleRequestAndResponseTypesRequestObject
 
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
+	request.ContentType = ctx.ContentType()
+	if runtime.MatchesContentType(ctx.GetHeader("Content-Type"), "application/json") {
 		var body MultipleRequestAndResponseTypesJSONRequestBody
-		if err := ctx.ShouldBind(&body); err != nil {
+		if err := ctx.ShouldBindJSON(&body); err != nil {
 			ctx.Status(http.StatusBadRequest)
 			ctx.Error(err)
 			return
 		}
 		request.JSONBody = &body
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/x-www-form-urlencoded") {
+	} else if runtime.MatchesContentType(ctx.GetHeader("Content-Type"), "application/x-www-form-urlencoded") {
 		if err := ctx.Request.ParseForm(); err != nil {
 			ctx.Error(err)
 			return
@@ -863,19 +1303,16 @@ func (sh *strictHandler) MultipleRequestAndResponseTypes(ctx *gin.Context) {
 			return
 		}
 		request.FormdataBody = &body
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "image/png") {
+	} else if runtime.MatchesContentType(ctx.GetHeader("Content-Type"), "image/png") {
 		request.Body = ctx.Request.Body
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "multipart/form-data") {
+	} else if runtime.MatchesContentType(ctx.GetHeader("Content-Type"), "multipart/form-data") {
 		if reader, err := ctx.Request.MultipartReader(); err == nil {
 			request.MultipartBody = reader
 		} else {
 			ctx.Error(err)
 			return
 		}
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "text/plain") {
+	} else if runtime.MatchesContentType(ctx.GetHeader("Content-Type"), "text/plain") {
 		data, err := io.ReadAll(ctx.Request.Body)
 		if err != nil {
 			ctx.Error(err)
@@ -883,6 +1320,10 @@ func (sh *strictHandler) MultipleRequestAndResponseTypes(ctx *gin.Context) {
 		}
 		body := MultipleRequestAndResponseTypesTextRequestBody(data)
 		request.TextBody = &body
+	} else if ctx.GetHeader("Content-Type") != "" {
+		ctx.Status(http.StatusUnsupportedMediaType)
+		ctx.Error(fmt.Errorf("unsupported content type %q", ctx.GetHeader("Content-Type")))
+		return
 	}
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
@@ -905,6 +1346,44 @@ func (sh *strictHandler) MultipleRequestAndResponseTypes(ctx *gin.Context) {
 	}
 }
 
+// NegotiatedExample operation middleware
+func (sh *strictHandler) NegotiatedExample(ctx *gin.Context) {
+	var request NegotiatedExampleRequestObject
+
+	var body NegotiatedExampleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.NegotiatedExample(ctx, request.(NegotiatedExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "NegotiatedExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+	} else if negotiated, ok := response.(interface {
+		NegotiateNegotiatedExampleResponse(http.ResponseWriter, string) error
+	}); ok {
+		if err := negotiated.NegotiateNegotiatedExampleResponse(ctx.Writer, ctx.Request.Header.Get("Accept")); err != nil {
+			ctx.Error(err)
+		}
+	} else if validResponse, ok := response.(NegotiatedExampleResponseObject); ok {
+		if err := validResponse.VisitNegotiatedExampleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
 // ReservedGoKeywordParameters operation middleware
 func (sh *strictHandler) ReservedGoKeywordParameters(ctx *gin.Context, pType string) {
 	var request ReservedGoKeywordParametersRequestObject
@@ -936,7 +1415,7 @@ func (sh *strictHandler) ReusableResponses(ctx *gin.Context) {
 	var request ReusableResponsesRequestObject
 
 	var body ReusableResponsesJSONRequestBody
-	if err := ctx.ShouldBind(&body); err != nil {
+	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
 		return
@@ -963,6 +1442,26 @@ func (sh *strictHandler) ReusableResponses(ctx *gin.Context) {
 	}
 }
 
+// WebSocketExample operation middleware
+func (sh *strictHandler) WebSocketExample(ctx *gin.Context, room string, params WebSocketExampleParams) {
+	var request WebSocketExampleRequestObject
+
+	request.Room = room
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return nil, sh.ssi.WebSocketExample(ctx, ctx.Writer, ctx.Request, request.(WebSocketExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebSocketExample")
+	}
+
+	// The handler writes the response itself, usually by upgrading the connection
+	if _, err := handler(ctx, request); err != nil {
+		ctx.Error(err)
+	}
+}
+
 // TextExample operation middleware
 func (sh *strictHandler) TextExample(ctx *gin.Context) {
 	var request TextExampleRequestObject
@@ -1091,7 +1590,7 @@ func (sh *strictHandler) HeadersExample(ctx *gin.Context, params HeadersExampleP
 	request.Params = params
 
 	var body HeadersExampleJSONRequestBody
-	if err := ctx.ShouldBind(&body); err != nil {
+	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
 		return
@@ -1121,23 +1620,28 @@ func (sh *strictHandler) HeadersExample(ctx *gin.Context, params HeadersExampleP
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYX2/bNhD/KgS3p0KynDRPeluDotu6rYOTPg15oMWzzFYiuePJimHouw8UJf+pFS/O",
-	"7BgY+mbRd787/u4Pj1zxzJTWaNDkeLriCM4a7aD9mAqJ8HcFjvyXBJehsqSM5il/J+Sk+6+JOELlxLSA",
-	"Xt3LZ0YT6FZVWFuoTHjV5Ivz+ivusjmUwv/6EWHGU/5DsnElCf+6BB5FaQvgTdNE33jw6SOP+ByEBGy9",
-	"DT+vdrFpaYGn3BEqnXMPEsSuB8WUJsgBvTUv2jnhBXo/0hW3aCwgqcDRQhQVDFvqVsz0C2QUdqD0zOxz",
-	"eWs0CaUdk2o2AwRNrCOPeQzHXGWtQQLJpkvmLWTEHOACkEecFHnH+N32OuscdjziC0AXDF2NxqOxj5ex",
-	"oIVVPOVv26WIW0HzdkPrAFkzFPdf7z79wZRjoiJTClKZKIolKwW6uSgKkExpMt7HKiM34q0pbCP/i+zU",
-	"33dc+rRpM+idkctzZEybmFv5fD0ev1JiNhG/CcaGMNZOJVsV1sLMRFUMkP5Zf9Wm1gwQDXY7S8qqIGUF",
-	"0nawdtn+vRd5DuVrvGRmsIylIHEm1k9l6aLEd81gsEju5qZ2bG5qRoZJEAWrFc1Zr/hNdSvNBHNK5wWw",
-	"3qloMJIFdD33Jy0n3V7uPcbZaynaQXmM67qO2+BVWIDOjAT5MlhVihwSq/NddY8tiKd8uiSftvvd9URJ",
-	"FHGCR0psIZQ+fHS8Ujv5zvTJCjuUK0J7JMo4N/FXWNYGZWwFihII0CUrb73xwDkMlPKfa0mWCc2mwLQo",
-	"QTIxI0D2wbAO0u2V7KSz+8F8DCIbqPa8XX+kf624p6Q9g3nEvQGeBlZCXSv0QSesIDpA28O/5ud/CkDP",
-	"Zpj04h1Tw22wb1Fr6hBmzrfEocgN8BcsTbYkLjMwHM64vdn3Nc4gH8mnz/17eHzWkX/C1vfatX0sYVVY",
-	"fJqzTus5tL2wkz6DxYWSYJLS3hyJfDFSnYVMzRTIuNtFHHx7qiXcGp0h0O4I5O8T2hBbg/lrDs2BBQYi",
-	"5gyrgZWVI2aFc0xR20UKFa5KEvaax+eNZ7fB0v2mnR6K6pszxfTNpSJ6M746XuXtmfNmZ5R5oh4nv70P",
-	"MsfeF082Mx058Z3O7oXK2V9S4q0XleES/jkIbM70DNTCT0RaMgSqUINkCyX6R4C92uwANmEdmoWCG5tp",
-	"qH/dOWYgig5iXfPo0AvQw//4eeKc72bnztMm4uGJKyRLhYWPKJFNkyQ8jY1cLfIccKRMIqzizUPzTwAA",
-	"AP//O0NNuucUAAA=",
+	"H4sIAAAAAAAC/+xZX3PTOBD/Kju6e+LsOgWe8gYdhrvjDpgWuAeGh421SQS2JCQ5rieT734jyW7+OW1S",
+	"knaG4SmJs9pd/fa3q115znJVaiVJOsuGc2bIaiUthR8j5Ia+V2Sd/8XJ5kZoJ5RkQ/YS+WX73yJhhiqL",
+	"o4K65V4+V9KRDEtR60Lk6JdmX61fP2c2n1KJ/tvvhsZsyH7Llq5k8V+b0TWWuiC2WCySDQ/evWEJmxJy",
+	"MsHb+PV8XbdrNLEhs84IOWFeSRR72ismpKMJGW/Ni7ZOeIHOj+GcaaM0GSciRjMsKuq31D5Ro6+Utyhp",
+	"ZdylqrfV5KqKUG16kjCJ5Q4DPjjCEGfDz1EqafV82TLuxYUcq+1AXijpUEgLXIzHZEg6aCMHXocFW2nv",
+	"NnEYNeCt5w4smRkZljAnnEeFXa0+hxYtyxI2I2OjofOzwdnAb0hpkqgFG7Jn4VHCNLppgCHL7cx/TqiH",
+	"ci+MwcaCGkPclQU0BLURzpEEtHBx9SmBWrgpIBhVw1gZIMynrfwZC7ZN4OFf3O/96tOrNrDJOvWfDgYb",
+	"HHZ07Tr3lsQRjkp7F4mXcV+yAv1mdtA6PBtjVfSA8FF+k6qWQMaoSNSEZTTr8rcFbn2br8Lfy51qNFiS",
+	"C3nzec6E1/u9ItOwjm0tj1Yp5kxFyW0p82U/CIOvqXWGsLw9V48CTVdutOqrYn9fvXsLwgJWTpXoRI5F",
+	"0UCJxk6xKIiDkE550le5s9v88ctXCRTq4UvFm1PUv8Wd+J6qzC4S9nww2KXjxqls5bw4OE4lmQmlGl0+",
+	"DeHqvmycOooLCiUAZQMheiVxgaFUhXLAKVecOLgpgcWSoMbGFy6akWna+rQdyH+99ffe5qHhXPH7j1+h",
+	"7Q9tVTih0bjVPNzAvxPZB/4bfdlYmTLl6PBEqB/L0qMCX9Aq7uvLrqaqtjBVNTgFnLCIx2e3cKMTEBIQ",
+	"rJCTgqBzKumNZEFtc/hC8st2Lx+8jpOXyWRNy3Va13UagleZgmSoDfdTK0qcUKblZH25142ODdmoccSS",
+	"ni7tSCRK4vGpCxTyjnPzYcrJL6SPltgxXSVNlBPoiO9O2C6ZbExU6481LDbydLUtFjIchUpS+HyR56Qd",
+	"xCEItKExmZ7G5u2NKz9Ze7NB2rL4cSUN3k/LUdpbQ6Gl4elEpd+oqZXh6bK9z+aeEIudE9X7G0nIUcKI",
+	"QGJJHHDsyMBrBa1Ku0WQy9bua/UmiixV7Rgw/Iy3nC9CJu0xXtyk2p7TxX2TtkMzXmOka6buysQWOkNj",
+	"64/RvmzvwS9aulyReJwEu71KbV3sPETfYlX+jVw2N0qVi51T7X80ugqCtw+267zzKg/iXdI/H4ePH+Hv",
+	"+eC8px2rhcunQk5AG+VUrgrrXWDXaU2jiEq0FGDyhN/dUn+g67266SN2FQ99bB7Kqyo+3I1Zu2of2O7Z",
+	"pOyB4kxwUlmpnx+o+dFAtZpyMRbE03YXafRtV+W8UDI35NanC38LI5WDG2V+aA9DfEAgAaugJigr60Cj",
+	"tSBcKLaFiDeWnLZq7MelZxfR0odG7xPVJyeK6ZPHiujzwfnhS56dmDdrU8KOfLz851WUObQNPdo4cmBf",
+	"ejy7j5TOfqxIV96q9Kfwn1Fg2frkJGa+cZQcDLnKSOIwE9hdnW7lZqvg9qM7urE8dLs3PPc5v/t1PWV3",
+	"X2n/pJe6p3x3dmqeLhIWb3IjWSpT+Ig6p4dZFt9QndkaJxMyZ0JlqAVbfFn8PwCtNdux6xwAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1211,3 +1715,29 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+var (
+	swaggerOnce      sync.Once
+	cachedSwagger    *openapi3.T
+	cachedSwaggerErr error
+)
+
+// GetSwaggerCached is like GetSwagger, but only loads the specification the
+// first time it's called. Every caller gets the same *openapi3.T, which must
+// not be modified.
+func GetSwaggerCached() (*openapi3.T, error) {
+	swaggerOnce.Do(func() {
+		cachedSwagger, cachedSwaggerErr = GetSwagger()
+	})
+	return cachedSwagger, cachedSwaggerErr
+}
+
+// MustGetSwagger is like GetSwaggerCached, but panics if the specification
+// can't be loaded.
+func MustGetSwagger() *openapi3.T {
+	swagger, err := GetSwaggerCached()
+	if err != nil {
+		panic(fmt.Sprintf("error loading embedded swagger spec: %s", err))
+	}
+	return swagger
+}
@@ -5,8 +5,13 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
 
 type StrictServer struct {
@@ -16,6 +21,10 @@ func (s StrictServer) JSONExample(ctx context.Context, request JSONExampleReques
 	return JSONExample200JSONResponse(*request.Body), nil
 }
 
+func (s StrictServer) MergePatchExample(ctx context.Context, request MergePatchExampleRequestObject) (MergePatchExampleResponseObject, error) {
+	return MergePatchExample200JSONResponse(*request.Body), nil
+}
+
 func (s StrictServer) MultipartExample(ctx context.Context, request MultipartExampleRequestObject) (MultipartExampleResponseObject, error) {
 	return MultipartExample200MultipartResponse(func(writer *multipart.Writer) error {
 		for {
@@ -81,6 +90,30 @@ func (s StrictServer) TextExample(ctx context.Context, request TextExampleReques
 	return TextExample200TextResponse(*request.Body), nil
 }
 
+func (s StrictServer) EventsExample(ctx context.Context, request EventsExampleRequestObject) (EventsExampleResponseObject, error) {
+	return EventsExample200EventStreamResponse(func(writer *runtime.EventStreamWriter) error {
+		for i := 0; i < request.Params.Count; i++ {
+			if err := writer.Send(runtime.ServerSentEvent{ID: strconv.Itoa(i), Data: fmt.Sprintf("event %d", i)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+func (s StrictServer) WebSocketExample(ctx context.Context, w http.ResponseWriter, r *http.Request, request WebSocketExampleRequestObject) error {
+	// A real server would hand w and r to a WebSocket library to upgrade the
+	// connection. The test only checks that it has them, along with the
+	// bound parameters.
+	if r.Header.Get("Upgrade") != "websocket" {
+		return fmt.Errorf("expected a WebSocket upgrade request")
+	}
+	w.Header().Set("Upgrade", "websocket")
+	w.Header().Set("X-Room", fmt.Sprintf("%s/%s", request.Room, request.Params.Name))
+	w.WriteHeader(http.StatusSwitchingProtocols)
+	return nil
+}
+
 func (s StrictServer) UnknownExample(ctx context.Context, request UnknownExampleRequestObject) (UnknownExampleResponseObject, error) {
 	return UnknownExample200Videomp4Response{Body: request.Body}, nil
 }
@@ -101,6 +134,17 @@ func (s StrictServer) ReusableResponses(ctx context.Context, request ReusableRes
 	return ReusableResponses200JSONResponse{ReusableresponseJSONResponse: ReusableresponseJSONResponse{Body: *request.Body}}, nil
 }
 
+func (s StrictServer) CSVExample(ctx context.Context, request CSVExampleRequestObject) (CSVExampleResponseObject, error) {
+	return CSVExample200CSVResponse{
+		{Name: "plain", Count: 1},
+		{Name: "with, comma", Count: 2},
+	}, nil
+}
+
+func (s StrictServer) NegotiatedExample(ctx context.Context, request NegotiatedExampleRequestObject) (NegotiatedExampleResponseObject, error) {
+	return NegotiatedExample200NegotiatedResponse{Body: *request.Body}, nil
+}
+
 func (s StrictServer) ReservedGoKeywordParameters(ctx context.Context, request ReservedGoKeywordParametersRequestObject) (ReservedGoKeywordParametersResponseObject, error) {
 	return ReservedGoKeywordParameters200TextResponse(""), nil
 }
@@ -2,11 +2,14 @@ package strictserver
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"encoding/xml"
 	"io"
 	"mime"
 	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
@@ -15,6 +18,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/deepmap/oapi-codegen/internal/test/strict-server/chi"
 	api3 "github.com/deepmap/oapi-codegen/internal/test/strict-server/client"
@@ -49,6 +53,70 @@ func TestGinServer(t *testing.T) {
 	testImpl(t, r)
 }
 
+func TestEventStreamClient(t *testing.T) {
+	strictHandler := api.NewStrictHandler(api.StrictServer{}, nil)
+	server := httptest.NewServer(api.Handler(strictHandler))
+	defer server.Close()
+
+	client, err := api3.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	events, err := client.EventsExampleEventStream(context.Background(), &api3.EventsExampleParams{Count: 2})
+	require.NoError(t, err)
+	defer events.Close()
+
+	event, err := events.Next()
+	require.NoError(t, err)
+	assert.Equal(t, runtime.ServerSentEvent{ID: "0", Data: "event 0"}, event)
+	event, err = events.Next()
+	require.NoError(t, err)
+	assert.Equal(t, runtime.ServerSentEvent{ID: "1", Data: "event 1"}, event)
+	_, err = events.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestCSVClient(t *testing.T) {
+	strictHandler := api.NewStrictHandler(api.StrictServer{}, nil)
+	server := httptest.NewServer(api.Handler(strictHandler))
+	defer server.Close()
+
+	client, err := api3.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.CSVExampleWithResponse(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, rsp.CSV200)
+	assert.Equal(t, []api3.ReportRow{{Name: "plain", Count: 1}, {Name: "with, comma", Count: 2}}, *rsp.CSV200)
+}
+
+func TestSpecHandler(t *testing.T) {
+	handler := api.SpecHandler()
+
+	rr := testutil.NewRequest().Get("/openapi").GoWithHTTPHandler(t, handler).Recorder
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	etag := rr.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	swagger, err := api.GetSwagger()
+	require.NoError(t, err)
+	expected, err := swagger.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), rr.Body.String())
+
+	rr = testutil.NewRequest().Get("/openapi").WithHeader("If-None-Match", etag).GoWithHTTPHandler(t, handler).Recorder
+	assert.Equal(t, http.StatusNotModified, rr.Code)
+	assert.Empty(t, rr.Body.String())
+
+	rr = testutil.NewRequest().Get("/openapi?format=yaml").WithHeader("If-None-Match", etag).GoWithHTTPHandler(t, handler).Recorder
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/yaml", rr.Header().Get("Content-Type"))
+	assert.NotEqual(t, etag, rr.Header().Get("ETag"))
+	assert.Contains(t, rr.Body.String(), "operationId: JSONExample\n")
+
+	rr = testutil.NewRequest().Get("/openapi?format=xml").GoWithHTTPHandler(t, handler).Recorder
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
 func testImpl(t *testing.T, handler http.Handler) {
 	t.Run("JSONExample", func(t *testing.T) {
 		value := "123"
@@ -61,6 +129,45 @@ func testImpl(t *testing.T, handler http.Handler) {
 		assert.NoError(t, err)
 		assert.Equal(t, requestBody, responseBody)
 	})
+	t.Run("MergePatchExample", func(t *testing.T) {
+		value := "789"
+		requestBody := api3.Example{Value: &value}
+		rr := testutil.NewRequest().Patch("/merge-patch").WithJsonBody(requestBody).WithContentType("application/merge-patch+json").GoWithHTTPHandler(t, handler).Recorder
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var responseBody api3.Example
+		assert.NoError(t, json.NewDecoder(rr.Body).Decode(&responseBody))
+		assert.Equal(t, requestBody, responseBody)
+	})
+	t.Run("NegotiatedExample", func(t *testing.T) {
+		value := "negotiated"
+		requestBody := api3.Example{Value: &value}
+
+		// Without an Accept header, the first content type is used
+		rr := testutil.NewRequest().Post("/negotiated").WithJsonBody(requestBody).GoWithHTTPHandler(t, handler).Recorder
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+		var responseBody api3.Example
+		assert.NoError(t, json.NewDecoder(rr.Body).Decode(&responseBody))
+		assert.Equal(t, requestBody, responseBody)
+
+		rr = testutil.NewRequest().Post("/negotiated").WithJsonBody(requestBody).WithAccept("application/json;q=0.5, application/xml").GoWithHTTPHandler(t, handler).Recorder
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/xml", rr.Header().Get("Content-Type"))
+		responseBody = api3.Example{}
+		assert.NoError(t, xml.NewDecoder(rr.Body).Decode(&responseBody))
+		assert.Equal(t, requestBody, responseBody)
+
+		rr = testutil.NewRequest().Post("/negotiated").WithJsonBody(requestBody).WithAccept("application/yaml").GoWithHTTPHandler(t, handler).Recorder
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/yaml", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "value: negotiated\n", rr.Body.String())
+	})
+	t.Run("CSVExample", func(t *testing.T) {
+		rr := testutil.NewRequest().Get("/csv").GoWithHTTPHandler(t, handler).Recorder
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "count,name\n1,plain\n2,\"with, comma\"\n", rr.Body.String())
+	})
 	t.Run("URLEncodedExample", func(t *testing.T) {
 		value := "456"
 		requestBody := api3.Example{Value: &value}
@@ -107,6 +214,25 @@ func testImpl(t *testing.T, handler http.Handler) {
 		assert.Equal(t, "text/plain", rr.Header().Get("Content-Type"))
 		assert.Equal(t, value, rr.Body.String())
 	})
+	t.Run("EventsExample", func(t *testing.T) {
+		rr := testutil.NewRequest().Get("/events?count=2").GoWithHTTPHandler(t, handler).Recorder
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "no-cache", rr.Header().Get("Cache-Control"))
+		assert.True(t, rr.Flushed)
+		assert.Equal(t, "id: 0\ndata: event 0\n\nid: 1\ndata: event 1\n\n", rr.Body.String())
+	})
+	t.Run("WebSocketExample", func(t *testing.T) {
+		rr := testutil.NewRequest().Get("/socket/lobby?name=alex").WithHeader("Upgrade", "websocket").GoWithHTTPHandler(t, handler).Recorder
+		assert.Equal(t, http.StatusSwitchingProtocols, rr.Code)
+		assert.Equal(t, "websocket", rr.Header().Get("Upgrade"))
+		assert.Equal(t, "lobby/alex", rr.Header().Get("X-Room"))
+
+		// Errors from the handler are reported like any other, which differs
+		// between frameworks
+		rr = testutil.NewRequest().Get("/socket/lobby?name=alex").GoWithHTTPHandler(t, handler).Recorder
+		assert.NotEqual(t, http.StatusSwitchingProtocols, rr.Code)
+	})
 	t.Run("UnknownExample", func(t *testing.T) {
 		data := []byte("unknown data")
 		rr := testutil.NewRequest().Post("/unknown").WithContentType("image/png").WithBody(data).GoWithHTTPHandler(t, handler).Recorder
@@ -0,0 +1,862 @@
+// Package unexportedtypes provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package unexportedtypes
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/go-chi/chi/v5"
+)
+
+// Defines values for kind.
+const (
+	cat kind = "cat"
+	dog kind = "dog"
+)
+
+// String returns the kind as a string.
+func (k kind) String() string {
+	return string(k)
+}
+
+// parseKind parses s as a kind, returning an error if it
+// isn't one of the known values.
+func parseKind(s string) (kind, error) {
+	switch e := kind(s); e {
+	case cat, dog:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid Kind value %q", s)
+}
+
+// errorType defines model for errorType.
+type errorType struct {
+	Message string `json:"message"`
+}
+
+// kind defines model for kind.
+type kind string
+
+// pet defines model for pet.
+type pet struct {
+	Kind kind   `json:"kind"`
+	Name string `json:"name"`
+}
+
+// listPetsParams defines parameters for ListPets.
+type listPetsParams struct {
+	Kind *kind `form:"kind,omitempty" json:"kind,omitempty"`
+}
+
+// newError returns a errorType with its required fields set.
+func newError(message string) errorType {
+	return errorType{
+		Message: message,
+	}
+}
+
+// newPet returns a pet with its required fields set.
+func newPet(kind kind, name string) pet {
+	return pet{
+		Kind: kind,
+		Name: name,
+	}
+}
+
+// requestEditorFn  is the function signature for the RequestEditor callback function
+type requestEditorFn func(ctx context.Context, req *http.Request) error
+
+// responseEditorFn is the function signature for the ResponseEditor callback
+// function, which is called with every response received, whatever its status.
+type responseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// operationIDContextKey is the key of the ID of the operation being called in
+// the contexts which the client passes to request and response editors.
+type operationIDContextKey struct{}
+
+// operationID returns the ID of the operation being called, as generated from
+// its operationId, from the context passed to request and response editors, so
+// that they can act differently depending on the operation.
+func operationID(ctx context.Context) (string, bool) {
+	operationID, ok := ctx.Value(operationIDContextKey{}).(string)
+	return operationID, ok
+}
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type httpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// client which conforms to the OpenAPI3 specification for this service.
+type client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client httpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []requestEditorFn
+
+	// A list of callbacks for inspecting or modifying responses, which are
+	// called as soon as they're received. If one returns an error, the
+	// response body is closed and the error is returned instead of the response.
+	ResponseEditors []responseEditorFn
+
+	// The policy for retrying failed requests, set by withRetry.
+	retryPolicy *runtime.RetryPolicy
+
+	// The TLS configuration and proxy of the default http.Client, set by
+	// withTLSConfig and withProxy.
+	tlsConfig *tls.Config
+	proxy     func(*http.Request) (*url.URL, error)
+
+	// The transport of the default http.Client, set by withRoundTripper, and
+	// the functions wrapping it, set by withTransportMiddleware.
+	roundTripper         http.RoundTripper
+	transportMiddlewares []func(http.RoundTripper) http.RoundTripper
+
+	// Whether responses keep the request which was sent, set by
+	// withCaptureRequest.
+	captureRequest bool
+}
+
+// clientOption allows setting custom parameters during construction
+type clientOption func(*client) error
+
+// Creates a new client, with reasonable defaults
+func newClient(server string, opts ...clientOption) (*client, error) {
+	// create a client with sane default values
+	client := client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+		if client.tlsConfig != nil || client.proxy != nil || client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+			var transport http.RoundTripper = http.DefaultTransport
+			if client.roundTripper != nil {
+				transport = client.roundTripper
+			}
+			if client.tlsConfig != nil || client.proxy != nil {
+				base, ok := transport.(*http.Transport)
+				if !ok {
+					return nil, errors.New("WithTLSConfig and WithProxy can only be combined with a WithRoundTripper of an *http.Transport")
+				}
+				t := base.Clone()
+				if client.tlsConfig != nil {
+					t.TLSClientConfig = client.tlsConfig
+				}
+				if client.proxy != nil {
+					t.Proxy = client.proxy
+				}
+				transport = t
+			}
+			for _, wrap := range client.transportMiddlewares {
+				transport = wrap(transport)
+			}
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.tlsConfig != nil || client.proxy != nil {
+		return nil, errors.New("WithTLSConfig and WithProxy only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	} else if client.roundTripper != nil || len(client.transportMiddlewares) != 0 {
+		return nil, errors.New("WithRoundTripper and WithTransportMiddleware only apply to the default http.Client, so can't be combined with WithHTTPClient")
+	}
+	if client.retryPolicy != nil {
+		client.Client = runtime.NewRetryDoer(client.Client, *client.retryPolicy)
+	}
+	return &client, nil
+}
+
+// withHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func withHTTPClient(doer httpRequestDoer) clientOption {
+	return func(c *client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// withTLSConfig sets the TLS configuration, such as the certificates to
+// trust or present, of the http.Client which the client creates. It can't be
+// combined with withHTTPClient, whose Doer should be configured instead.
+func withTLSConfig(config *tls.Config) clientOption {
+	return func(c *client) error {
+		c.tlsConfig = config
+		return nil
+	}
+}
+
+// withProxy sets the function which chooses the proxy for each request made
+// by the http.Client which the client creates, such as http.ProxyURL. It
+// can't be combined with withHTTPClient, whose Doer should be configured
+// instead.
+func withProxy(proxy func(*http.Request) (*url.URL, error)) clientOption {
+	return func(c *client) error {
+		c.proxy = proxy
+		return nil
+	}
+}
+
+// withRoundTripper sets the transport of the http.Client which the client
+// creates, in place of http.DefaultTransport. When it's an *http.Transport,
+// the TLS configuration and proxy set by withTLSConfig and withProxy apply to
+// a copy of it. It can't be combined with withHTTPClient, whose Doer should
+// be configured instead.
+func withRoundTripper(roundTripper http.RoundTripper) clientOption {
+	return func(c *client) error {
+		c.roundTripper = roundTripper
+		return nil
+	}
+}
+
+// withTransportMiddleware wraps the transport of the http.Client which the
+// client creates, including any TLS configuration and proxy set by
+// withTLSConfig and withProxy, with the http.RoundTripper which wrap returns,
+// such as one which logs or traces requests. Each call wraps the transport of
+// the previous ones. It can't be combined with withHTTPClient, whose Doer
+// should be configured instead.
+func withTransportMiddleware(wrap func(next http.RoundTripper) http.RoundTripper) clientOption {
+	return func(c *client) error {
+		c.transportMiddlewares = append(c.transportMiddlewares, wrap)
+		return nil
+	}
+}
+
+// withRetry makes the client retry requests which fail with a network error
+// or a retryable status code, with exponential backoff which honors any
+// Retry-After header. Unless the policy says otherwise, only GET, PUT, DELETE
+// and HEAD requests are retried, on 502, 503 and 504 responses. The retries
+// wrap whichever Doer the client ends up with, including one set by
+// withHTTPClient.
+func withRetry(policy runtime.RetryPolicy) clientOption {
+	return func(c *client) error {
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// withCaptureRequest makes the client keep a copy of the request which each
+// response answers, after any redirects, as its Request, and so as the
+// HTTPRequest of the responses of the WithResponse methods, for debugging
+// retries. The copy has the URL and headers of the request, but not its body,
+// so that the body can be garbage collected.
+func withCaptureRequest(capture bool) clientOption {
+	return func(c *client) error {
+		c.captureRequest = capture
+		return nil
+	}
+}
+
+// withRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func withRequestEditorFn(fn requestEditorFn) clientOption {
+	return func(c *client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// withResponseEditorFn allows setting up a callback function, which will be
+// called right after receiving a response, before it's parsed. This can be
+// used for metrics, or to turn error responses into errors.
+func withResponseEditorFn(fn responseEditorFn) clientOption {
+	return func(c *client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type clientInterface interface {
+	// ListPets request
+	ListPets(ctx context.Context, params *listPetsParams, reqEditors ...requestEditorFn) (*http.Response, error)
+}
+
+func (c *client) ListPets(ctx context.Context, params *listPetsParams, reqEditors ...requestEditorFn) (*http.Response, error) {
+	req, err := newListPetsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, operationIDContextKey{}, "ListPets")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req)
+}
+
+// newListPetsRequest generates requests for ListPets
+func newListPetsRequest(server string, params *listPetsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Kind != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kind", runtime.ParamLocationQuery, *params.Kind); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// do sends the request, then calls the response editors.
+func (c *client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	rsp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.captureRequest {
+		rsp.Request = captureRequest(rsp, req)
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(ctx, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// capturedRequestContextKey marks the copies of requests which clients made
+// withCaptureRequest keep in their responses.
+type capturedRequestContextKey struct{}
+
+// captureRequest returns a copy of the request which rsp answers, falling
+// back to req, without its body.
+func captureRequest(rsp *http.Response, req *http.Request) *http.Request {
+	if rsp.Request != nil {
+		req = rsp.Request
+	}
+	captured := req.Clone(context.WithValue(req.Context(), capturedRequestContextKey{}, true))
+	captured.Body = nil
+	captured.GetBody = nil
+	return captured
+}
+
+func (c *client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []requestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clientWithResponses builds on clientInterface to offer response payloads
+type clientWithResponses struct {
+	clientInterface
+}
+
+// newClientWithResponses creates a new clientWithResponses, which wraps
+// client with return type handling
+func newClientWithResponses(server string, opts ...clientOption) (*clientWithResponses, error) {
+	client, err := newClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &clientWithResponses{client}, nil
+}
+
+// withBaseURL overrides the baseURL.
+func withBaseURL(baseURL string) clientOption {
+	return func(c *client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// clientWithResponsesInterface is the interface specification for the client with responses above.
+type clientWithResponsesInterface interface {
+	// ListPets request
+	ListPetsWithResponse(ctx context.Context, params *listPetsParams, reqEditors ...requestEditorFn) (*listPetsResponse, error)
+}
+
+var _ clientWithResponsesInterface = (*clientWithResponses)(nil)
+
+type listPetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
+	JSON200      *[]pet
+	JSONDefault  *errorType
+}
+
+// Status returns HTTPResponse.Status
+func (r listPetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r listPetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Error returns an error describing the response, including the parsed body
+// when there is one, if the status code isn't 2xx. Otherwise it returns nil.
+func (r listPetsResponse) Error() error {
+	if r.HTTPResponse == nil || (r.HTTPResponse.StatusCode >= 200 && r.HTTPResponse.StatusCode < 300) {
+		return nil
+	}
+	if r.JSONDefault != nil {
+		return fmt.Errorf("%s: %+v", r.HTTPResponse.Status, *r.JSONDefault)
+	}
+	return errors.New(r.HTTPResponse.Status)
+}
+
+// IsDefault returns true if the status code of the response matches none of
+// the other responses of ListPets, so that its body was parsed as the
+// default response, such as into JSONDefault.
+func (r listPetsResponse) IsDefault() bool {
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode != 200
+}
+
+// listPetsExpectedStatusCodes returns the status codes which ListPets has
+// responses for, in order, with ranges like 2XX expanded to the codes in them.
+func listPetsExpectedStatusCodes() []int {
+	return []int{200}
+}
+
+// listPetsHasDefaultResponse is whether ListPets has a default response,
+// for status codes which aren't in listPetsExpectedStatusCodes.
+const listPetsHasDefaultResponse = true
+
+// ListPetsWithResponse request returning *listPetsResponse
+func (c *clientWithResponses) ListPetsWithResponse(ctx context.Context, params *listPetsParams, reqEditors ...requestEditorFn) (*listPetsResponse, error) {
+	rsp, err := c.ListPets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return parseListPetsResponse(rsp)
+}
+
+// capturedRequest returns the request kept in rsp by a client made
+// withCaptureRequest(true), or nil.
+func capturedRequest(rsp *http.Response) *http.Request {
+	if rsp.Request == nil || rsp.Request.Context().Value(capturedRequestContextKey{}) == nil {
+		return nil
+	}
+	return rsp.Request
+}
+
+// parseListPetsResponse parses an HTTP response from a ListPetsWithResponse call
+func parseListPetsResponse(rsp *http.Response) (*listPetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &listPetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+		HTTPRequest:  capturedRequest(rsp),
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []pet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode != 200:
+		var dest errorType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// inProcessDoer is an httpRequestDoer which serves requests with an
+// http.Handler, recording its responses, rather than sending them.
+type inProcessDoer struct {
+	handler http.Handler
+}
+
+// Do serves req with the handler, returning the response it recorded. The
+// handler gets a copy of req which looks like one received by a server, as
+// made by httptest.NewRequest, with a body even if it's empty, a RequestURI
+// and a RemoteAddr.
+func (d inProcessDoer) Do(req *http.Request) (*http.Response, error) {
+	serverReq := req.Clone(req.Context())
+	if serverReq.Body == nil {
+		serverReq.Body = http.NoBody
+	}
+	serverReq.RequestURI = req.URL.RequestURI()
+	serverReq.RemoteAddr = "192.0.2.1:1234"
+	recorder := httptest.NewRecorder()
+	d.handler.ServeHTTP(recorder, serverReq)
+	rsp := recorder.Result()
+	rsp.Request = req
+	return rsp, nil
+}
+
+// newInProcessClient creates a clientWithResponses whose requests are served
+// by handler, such as that of a generated server, in the same process,
+// without a listening socket. The requests are built, and the responses
+// parsed, as usual, so it's a quick way of testing a server with the client.
+// Options like withRequestEditorFn apply as usual, but withHTTPClient would
+// replace handler, and withRoundTripper and withTransportMiddleware can't be
+// used.
+func newInProcessClient(handler http.Handler, opts ...clientOption) (*clientWithResponses, error) {
+	opts = append([]clientOption{withHTTPClient(inProcessDoer{handler: handler})}, opts...)
+	return newClientWithResponses("http://localhost", opts...)
+}
+
+// serverInterface represents all server handlers.
+type serverInterface interface {
+
+	// (GET /pets)
+	ListPets(w http.ResponseWriter, r *http.Request, params listPetsParams)
+}
+
+// serverInterfaceWrapper converts contexts to parameters.
+type serverInterfaceWrapper struct {
+	Handler            serverInterface
+	HandlerMiddlewares []middlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type middlewareFunc func(http.Handler) http.Handler
+
+// ListPets operation middleware
+func (siw *serverInterfaceWrapper) ListPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params listPetsParams
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &invalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	if params.Kind != nil && *params.Kind != "cat" && *params.Kind != "dog" {
+		siw.ErrorHandlerFunc(w, r, &invalidParamFormatError{ParamName: "kind", Err: errors.New("must be one of \"cat\", \"dog\"")})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPets(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type unescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *unescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *unescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type unmarshallingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *unmarshallingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshalling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *unmarshallingParamError) Unwrap() error {
+	return e.Err
+}
+
+type requiredParamError struct {
+	ParamName string
+}
+
+func (e *requiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type requiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *requiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *requiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type invalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *invalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *invalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type tooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *tooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// handler creates http.Handler with routing matching OpenAPI spec.
+func handler(si serverInterface) http.Handler {
+	return handlerWithOptions(si, chiServerOptions{})
+}
+
+type chiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []middlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// handlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func handlerFromMux(si serverInterface, r chi.Router) http.Handler {
+	return handlerWithOptions(si, chiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func handlerFromMuxWithBaseURL(si serverInterface, r chi.Router, baseURL string) http.Handler {
+	return handlerWithOptions(si, chiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// handlerWithOptions creates http.Handler with additional options
+func handlerWithOptions(si serverInterface, options chiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := serverInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pets", wrapper.ListPets)
+	})
+
+	return r
+}
+
+type listPetsRequestObject struct {
+	Params listPetsParams
+}
+
+type listPetsResponseObject interface {
+	VisitListPetsResponse(w http.ResponseWriter) error
+}
+
+type listPets200JSONResponse []pet
+
+func (response listPets200JSONResponse) VisitListPetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type listPetsdefaultJSONResponse struct {
+	Body       errorType
+	StatusCode int
+}
+
+func (response listPetsdefaultJSONResponse) VisitListPetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// strictServerInterface represents all server handlers.
+type strictServerInterface interface {
+
+	// (GET /pets)
+	ListPets(ctx context.Context, request listPetsRequestObject) (listPetsResponseObject, error)
+}
+
+type strictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error)
+
+type strictMiddlewareFunc func(f strictHandlerFunc, operationID string) strictHandlerFunc
+
+type strictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func newStrictHandler(ssi strictServerInterface, middlewares []strictMiddlewareFunc) serverInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: strictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			var contentTypeErr *unsupportedContentTypeError
+			if errors.As(err, &contentTypeErr) {
+				http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func newStrictHandlerWithOptions(ssi strictServerInterface, middlewares []strictMiddlewareFunc, options strictHTTPServerOptions) serverInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+// unsupportedContentTypeError is passed to the RequestErrorHandlerFunc when the
+// Content-Type of a request body matches none of those of its operation.
+type unsupportedContentTypeError struct {
+	ContentType string
+}
+
+func (e *unsupportedContentTypeError) Error() string {
+	return fmt.Sprintf("unsupported content type %q", e.ContentType)
+}
+
+type strictHandler struct {
+	ssi         strictServerInterface
+	middlewares []strictMiddlewareFunc
+	options     strictHTTPServerOptions
+}
+
+// ListPets operation middleware
+func (sh *strictHandler) ListPets(w http.ResponseWriter, r *http.Request, params listPetsParams) {
+	var request listPetsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPets(ctx, request.(listPetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(listPetsResponseObject); ok {
+		if err := validResponse.VisitListPetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
@@ -0,0 +1,53 @@
+package unexportedtypes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type petServer struct {
+	pets []pet
+}
+
+func (s petServer) ListPets(ctx context.Context, request listPetsRequestObject) (listPetsResponseObject, error) {
+	if len(s.pets) == 0 {
+		return listPetsdefaultJSONResponse{Body: newError("there are no pets"), StatusCode: http.StatusNotFound}, nil
+	}
+	pets := []pet{}
+	for _, p := range s.pets {
+		if request.Params.Kind == nil || p.Kind == *request.Params.Kind {
+			pets = append(pets, p)
+		}
+	}
+	return listPets200JSONResponse(pets), nil
+}
+
+func TestUnexportedTypes(t *testing.T) {
+	client, err := newInProcessClient(handler(newStrictHandler(petServer{pets: []pet{newPet(cat, "Tom"), newPet(dog, "Spike")}}, nil)))
+	require.NoError(t, err)
+
+	k, err := parseKind("dog")
+	require.NoError(t, err)
+	rsp, err := client.ListPetsWithResponse(context.Background(), &listPetsParams{Kind: &k})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, []pet{{Name: "Spike", Kind: dog}}, *rsp.JSON200)
+
+	client, err = newInProcessClient(handler(newStrictHandler(petServer{}, nil)))
+	require.NoError(t, err)
+	rsp, err = client.ListPetsWithResponse(context.Background(), &listPetsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rsp.StatusCode())
+	require.NotNil(t, rsp.JSONDefault)
+	assert.Equal(t, "there are no pets", rsp.JSONDefault.Message)
+
+	// JSON names are unchanged
+	out, err := json.Marshal(newPet(cat, "Tom"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Tom","kind":"cat"}`, string(out))
+}
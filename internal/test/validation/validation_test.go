@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (s *server) AddPet(w http.ResponseWriter, r *http.Request, params AddPetParams) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newRouter(t *testing.T, formatter ValidationErrorFormatter) http.Handler {
+	validator, err := NewValidationMiddleware(formatter)
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Use(validator)
+	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return HandlerFromMux(&server{}, r)
+}
+
+func doRequest(handler http.Handler, method, url, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestValidationMiddleware(t *testing.T) {
+	handler := newRouter(t, nil)
+
+	// Valid requests reach the handler, whatever the host and without
+	// checking security requirements
+	rec := doRequest(handler, http.MethodPost, "/pets?limit=5", `{"name": "Fido"}`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = doRequest(handler, http.MethodPost, "/pets", `{"name": ""}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "name")
+
+	rec = doRequest(handler, http.MethodPost, "/pets?limit=50", `{"name": "Fido"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "limit")
+
+	// Routes which aren't in the spec are passed through
+	rec = doRequest(handler, http.MethodGet, "/health", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestValidationMiddlewareFormatter(t *testing.T) {
+	handler := newRouter(t, func(w http.ResponseWriter, r *http.Request, err error) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte("invalid pet"))
+	})
+
+	rec := doRequest(handler, http.MethodPost, "/pets", `{}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, "invalid pet", rec.Body.String())
+}
@@ -0,0 +1,100 @@
+package validators
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayValidation(t *testing.T) {
+	tags := Tags{"a", "b"}
+	path := []Point{{X: ptr(1)}, {X: ptr(2)}}
+	post := Post{Authors: []string{"alex"}, Tags: &tags, Path: &path}
+	assert.NoError(t, post.Validate())
+
+	// Optional properties are only checked when set
+	assert.NoError(t, Post{Authors: []string{"alex"}}.Validate())
+
+	assert.EqualError(t, Post{}.Validate(), "authors must have at least 1 items")
+
+	tags = Tags{"a", "b", "c", "d"}
+	assert.EqualError(t, post.Validate(), "tags must have at most 3 items")
+
+	tags = Tags{"a", "b", "a"}
+	assert.EqualError(t, post.Validate(), "tags must have unique items, but item 2 is a duplicate")
+
+	// Items which aren't comparable in Go are compared by value
+	tags = Tags{"a"}
+	path = []Point{{X: ptr(1)}, {X: ptr(1)}}
+	assert.EqualError(t, post.Validate(), "path must have unique items, but item 1 is a duplicate")
+}
+
+func TestNumericValidation(t *testing.T) {
+	weight := Weight(999.5)
+	product := Product{Quantity: 10, Price: ptr(19.99), Discount: ptr(50), Weight: &weight}
+	assert.NoError(t, product.Validate())
+
+	// Optional properties are only checked when set
+	assert.NoError(t, Product{Quantity: 5}.Validate())
+
+	assert.EqualError(t, Product{Quantity: 0}.Validate(), "quantity must be greater than or equal to 5")
+	assert.EqualError(t, Product{Quantity: 105}.Validate(), "quantity must be less than or equal to 100")
+	assert.EqualError(t, Product{Quantity: 12}.Validate(), "quantity must be a multiple of 5")
+
+	// Exclusive bounds are strict
+	assert.EqualError(t, Product{Quantity: 5, Price: ptr(0.0)}.Validate(), "price must be greater than 0")
+	weight = 1000
+	assert.EqualError(t, Product{Quantity: 5, Weight: &weight}.Validate(), "weight must be less than 1000")
+
+	// Fractional bounds and divisors work for integers and numbers alike
+	assert.EqualError(t, Product{Quantity: 5, Discount: ptr(51)}.Validate(), "discount must be less than or equal to 50.5")
+	assert.EqualError(t, Product{Quantity: 5, Price: ptr(19.995)}.Validate(), "price must be a multiple of 0.01")
+}
+
+func TestPropertiesValidation(t *testing.T) {
+	assert.NoError(t, Scores{"alex": 90}.Validate())
+	assert.EqualError(t, Scores{}.Validate(), "Scores must have at least 1 properties, got 0")
+	assert.EqualError(t, Scores{"a": 1, "b": 2, "c": 3, "d": 4}.Validate(), "Scores must have at most 3 properties, got 4")
+	assert.EqualError(t, Scores{"alex": 101}.Validate(), "each property of Scores must be less than or equal to 100")
+
+	assert.NoError(t, Settings{"a": 1, "b": true}.Validate())
+	assert.EqualError(t, Settings{"a": 1, "b": true, "c": "x"}.Validate(), "Settings must have at most 2 properties, got 3")
+
+	// Maps with patternProperties check their size before their keys
+	assert.EqualError(t, Labels{}.Validate(), "Labels must have at least 1 properties, got 0")
+	var labels Labels
+	assert.Error(t, json.Unmarshal([]byte(`{}`), &labels))
+
+	// Objects count their set properties along with their additional ones
+	profile := Profile{Name: "alex", Nickname: ptr("al")}
+	assert.NoError(t, profile.Validate())
+	assert.EqualError(t, Profile{Name: "alex"}.Validate(), "Profile must have at least 2 properties, got 1")
+	profile.Set("roles", []string{"admin"})
+	profile.Annotations = &map[string]string{"a": "b"}
+	assert.EqualError(t, profile.Validate(), "Profile must have at most 3 properties, got 4")
+
+	profile = Profile{Name: "alex", Annotations: &map[string]string{"a": "b", "c": "d"}}
+	assert.EqualError(t, profile.Validate(), "annotations must have at most 1 properties, got 2")
+
+	profile = Profile{Name: "alex"}
+	profile.Set("roles", []string{"a", "b", "c"})
+	assert.EqualError(t, profile.Validate(), "each additional property of Profile must have at most 2 items")
+
+	// Inline objects count their set fields too
+	team := Team{}
+	assert.NoError(t, team.Validate())
+	team.Contact = &struct {
+		Email *string `json:"email,omitempty"`
+		Phone *string `json:"phone,omitempty"`
+	}{}
+	assert.EqualError(t, team.Validate(), "contact must have at least 1 properties, got 0")
+	team.Contact.Email = ptr("alex@example.com")
+	assert.NoError(t, team.Validate())
+	team.Contact.Phone = ptr("555-0100")
+	assert.EqualError(t, team.Validate(), "contact must have at most 1 properties, got 2")
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
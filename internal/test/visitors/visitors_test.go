@@ -0,0 +1,81 @@
+package visitors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// redactor clears the SSN of every person.
+type redactor struct {
+	BaseVisitor
+	names []string
+}
+
+func (r *redactor) VisitPerson(p *Person) bool {
+	r.names = append(r.names, p.Name)
+	p.Ssn = nil
+	return true
+}
+
+func ssn(s string) *string {
+	return &s
+}
+
+func TestVisit(t *testing.T) {
+	groups := Groups{{{Name: "grouped", Ssn: ssn("5")}}}
+	byRole := map[string]Person{"admin": {Name: "admin", Ssn: ssn("4")}}
+	account := Account{
+		Owner:    Person{Name: "owner", Ssn: ssn("1")},
+		Cosigner: &Person{Name: "cosigner", Ssn: ssn("2")},
+		Contacts: []Person{{Name: "contact", Ssn: ssn("3")}},
+		ByRole:   &byRole,
+		Groups:   &groups,
+		Parent: &Account{
+			Owner: Person{Name: "parent", Ssn: ssn("6")},
+		},
+	}
+	account.Audit = &struct {
+		Approver *Person `json:"approver,omitempty"`
+	}{Approver: &Person{Name: "approver", Ssn: ssn("7")}}
+
+	r := &redactor{}
+	account.Visit(r)
+
+	assert.Equal(t, []string{"approver", "admin", "contact", "cosigner", "grouped", "owner", "parent"}, r.names)
+	assert.Nil(t, account.Owner.Ssn)
+	assert.Nil(t, account.Cosigner.Ssn)
+	assert.Nil(t, account.Contacts[0].Ssn)
+	assert.Nil(t, (*account.ByRole)["admin"].Ssn)
+	assert.Nil(t, (*account.Groups)[0][0].Ssn)
+	assert.Nil(t, account.Parent.Owner.Ssn)
+	assert.Nil(t, account.Audit.Approver.Ssn)
+}
+
+// skipper doesn't visit the fields of the accounts it's given.
+type skipper struct {
+	BaseVisitor
+	visited int
+}
+
+func (s *skipper) VisitAccount(*Account) bool {
+	s.visited++
+	return false
+}
+
+func (s *skipper) VisitPerson(*Person) bool {
+	s.visited++
+	return true
+}
+
+func TestVisitSkip(t *testing.T) {
+	account := &Account{Owner: Person{Name: "owner"}}
+	s := &skipper{}
+	account.Visit(s)
+	assert.Equal(t, 1, s.visited)
+
+	// Nil values aren't visited
+	account = nil
+	account.Visit(s)
+	assert.Equal(t, 1, s.visited)
+}
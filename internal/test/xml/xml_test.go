@@ -0,0 +1,68 @@
+package xmlmetadata
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const zooXML = `<zoo xmlns="https://example.com/zoo" id="7">` +
+	`<animals><animal Species="lion"><nickname>Leo</nickname></animal><animal Species="owl"></animal></animals>` +
+	`<keeper>Ann</keeper><keeper>Bob</keeper>` +
+	`<name>City Zoo</name>` +
+	`<star Species="tiger"></star>` +
+	`</zoo>`
+
+func TestXMLTags(t *testing.T) {
+	leo := "Leo"
+	name := "City Zoo"
+	keepers := []string{"Ann", "Bob"}
+	zoo := Zoo{
+		Id:      7,
+		Name:    &name,
+		Animals: []Animal{{Species: "lion", Nickname: &leo}, {Species: "owl"}},
+		Keepers: &keepers,
+		Star:    &Animal{Species: "tiger"},
+	}
+
+	// The root element, attributes, wrapped and unwrapped arrays are named
+	// as the schema's xml metadata says, while the animal which is the star
+	// is named after its property
+	b, err := xml.Marshal(zoo)
+	require.NoError(t, err)
+	assert.Equal(t, zooXML, string(b))
+
+	var parsed Zoo
+	require.NoError(t, xml.Unmarshal([]byte(zooXML), &parsed))
+	assert.Equal(t, 7, parsed.Id)
+	assert.Equal(t, "City Zoo", *parsed.Name)
+	require.Len(t, parsed.Animals, 2)
+	assert.Equal(t, "lion", parsed.Animals[0].Species)
+	assert.Equal(t, "Leo", *parsed.Animals[0].Nickname)
+	assert.Equal(t, keepers, *parsed.Keepers)
+	require.NotNil(t, parsed.Star)
+	assert.Equal(t, "tiger", parsed.Star.Species)
+
+	// An animal of its own is the root element, named as its schema says
+	b, err = xml.Marshal(&Animal{Species: "owl"})
+	require.NoError(t, err)
+	assert.Equal(t, `<animal Species="owl"></animal>`, string(b))
+}
+
+func TestXMLResponse(t *testing.T) {
+	rsp, err := ParseGetZooResponse(&http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/xml"}},
+		Body:       io.NopCloser(bytes.NewReader([]byte(zooXML))),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.XML200)
+	assert.Equal(t, 7, rsp.XML200.Id)
+	require.Len(t, rsp.XML200.Animals, 2)
+	assert.Equal(t, "owl", rsp.XML200.Animals[1].Species)
+}
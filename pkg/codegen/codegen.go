@@ -15,13 +15,15 @@
 package codegen
 
 import (
-	"bufio"
-	"bytes"
 	"embed"
 	"fmt"
 	"io/fs"
+	"math"
+	"path"
+	"path/filepath"
 	"runtime/debug"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -67,6 +69,37 @@ func (im importMap) GoImports() []string {
 	return goImports
 }
 
+// lookup returns the go package for the external specification at specPath.
+// Paths to files are compared once cleaned, so that "common.yaml",
+// "./common.yaml" and "./schemas/../common.yaml" all find a mapping for any of
+// them, while URLs must match exactly.
+func (im importMap) lookup(specPath string) (goImport, bool) {
+	if gi, ok := im[specPath]; ok {
+		return gi, true
+	}
+	if isURLSpecPath(specPath) {
+		return goImport{}, false
+	}
+	mappedPaths := make([]string, 0, len(im))
+	for mapped := range im {
+		mappedPaths = append(mappedPaths, mapped)
+	}
+	sort.Strings(mappedPaths)
+	cleaned := path.Clean(filepath.ToSlash(specPath))
+	for _, mapped := range mappedPaths {
+		if !isURLSpecPath(mapped) && path.Clean(filepath.ToSlash(mapped)) == cleaned {
+			return im[mapped], true
+		}
+	}
+	return goImport{}, false
+}
+
+// isURLSpecPath returns whether specPath, the file portion of a $ref, is a URL
+// rather than a path to a file.
+func isURLSpecPath(specPath string) bool {
+	return strings.Contains(specPath, "://")
+}
+
 var importMapping importMap
 
 func constructImportMapping(importMapping map[string]string) importMap {
@@ -98,17 +131,58 @@ func constructImportMapping(importMapping map[string]string) importMap {
 // the descriptions we've built up above from the schema objects.
 // opts defines
 func Generate(spec *openapi3.T, opts Configuration) (string, error) {
-	// This is global state
-	globalState.options = opts
-	globalState.spec = spec
+	files, _, err := generate(spec, opts, false)
+	if err != nil {
+		return "", err
+	}
+	return files[singleFileName(opts)], nil
+}
 
-	importMapping = constructImportMapping(opts.ImportMapping)
+// GenerateFiles is like Generate, but splits the generated code by component
+// into types.gen.go, client.gen.go, server.gen.go and spec.gen.go, returning
+// the contents of each keyed by file name. Only the files for the enabled
+// components are returned. Each is a complete Go source file in the same
+// package, with its own header and imports.
+func GenerateFiles(spec *openapi3.T, opts Configuration) (map[string]string, error) {
+	files, _, err := generate(spec, opts, true)
+	return files, err
+}
 
-	filterOperationsByTag(spec, opts)
+// singleFileName is the key of the only file returned by generate when the
+// code isn't split by component.
+func singleFileName(opts Configuration) string {
+	return opts.PackageName + ".go"
+}
+
+// generate does the work for Generate, GenerateFiles and GenerateFromSpec,
+// additionally returning the operations which code was generated for.
+func generate(spec *openapi3.T, opts Configuration, splitByComponent bool) (map[string]string, []OperationDefinition, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if err := filterOperationsByTag(spec, opts); err != nil {
+		return nil, nil, err
+	}
+	var modelsImport *goImport
+	if opts.OutputOptions.ModelsPackage != "" {
+		// Validate has checked that the name can be derived
+		name, _ := packageName(opts.OutputOptions.ModelsPackage)
+		modelsImport = &goImport{Name: name, Path: opts.OutputOptions.ModelsPackage}
+	}
+	// The embedded spec keeps all of its components, including those which
+	// aren't used by the remaining operations, so they're pruned from a copy.
+	embeddedSpec := spec
 	if !opts.OutputOptions.SkipPrune {
+		spec = copyComponents(spec)
 		pruneUnusedComponents(spec)
 	}
 
+	// This is global state
+	globalState.options = opts
+	globalState.spec = spec
+
+	importMapping = constructImportMapping(opts.ImportMapping)
+
 	// if we are provided an override for the response type suffix update it
 	if opts.OutputOptions.ResponseTypeSuffix != "" {
 		responseTypeSuffix = opts.OutputOptions.ResponseTypeSuffix
@@ -118,6 +192,10 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		globalState.options.OutputOptions.ClientTypeName = defaultClientTypeName
 	}
 
+	if globalState.options.OutputOptions.PtrHelperName == "" {
+		globalState.options.OutputOptions.PtrHelperName = defaultPtrHelperName
+	}
+
 	// This creates the golang templates text package
 	TemplateFunctions["opts"] = func() Configuration { return globalState.options }
 	t := template.New("oapi-codegen").Funcs(TemplateFunctions)
@@ -125,7 +203,7 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	// above
 	err := LoadTemplates(templates, t)
 	if err != nil {
-		return "", fmt.Errorf("error parsing oapi-codegen templates: %w", err)
+		return nil, nil, fmt.Errorf("error parsing oapi-codegen templates: %w", err)
 	}
 
 	// Override built-in templates with user-provided versions
@@ -133,36 +211,56 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		if _, ok := opts.OutputOptions.UserTemplates[tpl.Name()]; ok {
 			utpl := t.New(tpl.Name())
 			if _, err := utpl.Parse(opts.OutputOptions.UserTemplates[tpl.Name()]); err != nil {
-				return "", fmt.Errorf("error parsing user-provided template %q: %w", tpl.Name(), err)
+				return nil, nil, fmt.Errorf("error parsing user-provided template %q: %w", tpl.Name(), err)
 			}
 		}
 	}
 
 	ops, err := OperationDefinitions(spec)
 	if err != nil {
-		return "", fmt.Errorf("error creating operation definitions: %w", err)
+		return nil, nil, fmt.Errorf("error creating operation definitions: %w", err)
+	}
+	if err := checkResourcePatternsSupported(ops, opts.Generate); err != nil {
+		return nil, nil, err
+	}
+	if opts.OutputOptions.InterfacePerTag {
+		if _, err := GroupOperationsByTag(ops); err != nil {
+			return nil, nil, fmt.Errorf("error splitting the server interface by tag: %w", err)
+		}
 	}
 
 	xGoTypeImports, err := OperationImports(ops)
 	if err != nil {
-		return "", fmt.Errorf("error getting operation imports: %w", err)
+		return nil, nil, fmt.Errorf("error getting operation imports: %w", err)
+	}
+
+	// Type mappings are imported whether or not they're used, leaving it to
+	// goimports to remove those which aren't.
+	for _, mapping := range opts.OutputOptions.TypeMappings {
+		imprt, _, err := parseTypeMapping(mapping)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing type mappings: %w", err)
+		}
+		if imprt != nil {
+			xGoTypeImports[imprt.String()] = *imprt
+		}
 	}
 
 	var typeDefinitions, constantDefinitions string
 	if opts.Generate.Models {
 		typeDefinitions, err = GenerateTypeDefinitions(t, spec, ops, opts.OutputOptions.ExcludeSchemas)
 		if err != nil {
-			return "", fmt.Errorf("error generating type definitions: %w", err)
+			return nil, nil, fmt.Errorf("error generating type definitions: %w", err)
 		}
 
 		constantDefinitions, err = GenerateConstants(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating constants: %w", err)
+			return nil, nil, fmt.Errorf("error generating constants: %w", err)
 		}
 
 		imprts, err := GetTypeDefinitionsImports(spec, opts.OutputOptions.ExcludeSchemas)
 		if err != nil {
-			return "", fmt.Errorf("error getting type definition imports: %w", err)
+			return nil, nil, fmt.Errorf("error getting type definition imports: %w", err)
 		}
 		MergeImports(xGoTypeImports, imprts)
 	}
@@ -171,7 +269,7 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.EchoServer {
 		echoServerOut, err = GenerateEchoServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
@@ -179,7 +277,7 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.ChiServer {
 		chiServerOut, err = GenerateChiServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
@@ -187,7 +285,7 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.GinServer {
 		ginServerOut, err = GenerateGinServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
@@ -195,7 +293,55 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.GorillaServer {
 		gorillaServerOut, err = GenerateGorillaServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
+		}
+	}
+
+	var serverInterfaceOut string
+	if opts.Generate.ServerInterfaceOnly {
+		serverInterfaceOut, err = GenerateServerInterface(t, ops, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating server interface: %w", err)
+		}
+	}
+
+	var validationMiddlewareOut string
+	if opts.Generate.ValidationMiddleware {
+		validationMiddlewareOut, err = GenerateValidationMiddleware(t, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating validation middleware: %w", err)
+		}
+	}
+
+	var healthEndpointsOut string
+	if opts.Generate.HealthEndpoints {
+		healthEndpointsOut, err = GenerateHealthEndpoints(t, spec, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating health endpoints: %w", err)
+		}
+	}
+
+	var idempotencyKeyOut string
+	if opts.OutputOptions.IdempotencyKeys {
+		idempotencyKeyOut, err = GenerateIdempotencyKeyMiddleware(t, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating idempotency key middleware: %w", err)
+		}
+	}
+
+	var loggingMiddlewareOut string
+	if opts.OutputOptions.LoggingMiddleware {
+		loggingMiddlewareOut, err = GenerateTemplates([]string{"logging-middleware.tmpl"}, t, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating logging middleware: %w", err)
+		}
+	}
+
+	var mockServerOut string
+	if opts.Generate.MockServer {
+		mockServerOut, err = GenerateMockServer(t, ops, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating mock server: %w", err)
 		}
 	}
 
@@ -205,16 +351,16 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		if spec.Components != nil {
 			responses, err = GenerateResponseDefinitions("", spec.Components.Responses)
 			if err != nil {
-				return "", fmt.Errorf("error generation response definitions for schema: %w", err)
+				return nil, nil, fmt.Errorf("error generation response definitions for schema: %w", err)
 			}
 		}
 		strictServerResponses, err := GenerateStrictResponses(t, responses)
 		if err != nil {
-			return "", fmt.Errorf("error generation response definitions for schema: %w", err)
+			return nil, nil, fmt.Errorf("error generation response definitions for schema: %w", err)
 		}
 		strictServerOut, err = GenerateStrictServer(t, ops, opts)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 		strictServerOut = strictServerResponses + strictServerOut
 	}
@@ -223,110 +369,118 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.Client {
 		clientOut, err = GenerateClient(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating client: %w", err)
+			return nil, nil, fmt.Errorf("error generating client: %w", err)
+		}
+		headerEditorsOut, err := GenerateHeaderEditors(t)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating header editors: %w", err)
 		}
+		clientOut += headerEditorsOut
 	}
 
 	var clientWithResponsesOut string
 	if opts.Generate.Client {
 		clientWithResponsesOut, err = GenerateClientWithResponses(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating client with responses: %w", err)
+			return nil, nil, fmt.Errorf("error generating client with responses: %w", err)
 		}
-	}
-
-	var inlinedSpec string
-	if opts.Generate.EmbeddedSpec {
-		inlinedSpec, err = GenerateInlinedSpec(t, importMapping, spec)
+		queryBuildersOut, err := GenerateQueryBuilders(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, nil, fmt.Errorf("error generating query builders: %w", err)
 		}
-	}
-
-	var buf bytes.Buffer
-	w := bufio.NewWriter(&buf)
-
-	externalImports := append(importMapping.GoImports(), importMap(xGoTypeImports).GoImports()...)
-	importsOut, err := GenerateImports(t, externalImports, opts.PackageName)
-	if err != nil {
-		return "", fmt.Errorf("error generating imports: %w", err)
-	}
-
-	_, err = w.WriteString(importsOut)
-	if err != nil {
-		return "", fmt.Errorf("error writing imports: %w", err)
-	}
-
-	_, err = w.WriteString(constantDefinitions)
-	if err != nil {
-		return "", fmt.Errorf("error writing constants: %w", err)
-	}
-
-	_, err = w.WriteString(typeDefinitions)
-	if err != nil {
-		return "", fmt.Errorf("error writing type definitions: %w", err)
-	}
-
-	if opts.Generate.Client {
-		_, err = w.WriteString(clientOut)
+		clientWithResponsesOut += queryBuildersOut
+		inProcessClientOut, err := GenerateInProcessClient(t)
 		if err != nil {
-			return "", fmt.Errorf("error writing client: %w", err)
+			return nil, nil, fmt.Errorf("error generating in-process client: %w", err)
 		}
-		_, err = w.WriteString(clientWithResponsesOut)
+		clientWithResponsesOut += inProcessClientOut
+		paginatorsOut, err := GeneratePaginators(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error writing client: %w", err)
+			return nil, nil, fmt.Errorf("error generating paginators: %w", err)
 		}
+		clientWithResponsesOut += paginatorsOut
 	}
 
-	if opts.Generate.EchoServer {
-		_, err = w.WriteString(echoServerOut)
+	var inlinedSpec string
+	if opts.Generate.EmbeddedSpec {
+		inlinedSpec, err = GenerateInlinedSpec(t, importMapping, embeddedSpec)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
-	if opts.Generate.ChiServer {
-		_, err = w.WriteString(chiServerOut)
+	var operationExtensionsOut string
+	if opts.OutputOptions.GenerateOperationExtensions {
+		operationExtensionsOut, err = GenerateOperationExtensions(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, nil, fmt.Errorf("error generating operation extensions: %w", err)
 		}
 	}
 
-	if opts.Generate.GinServer {
-		_, err = w.WriteString(ginServerOut)
-		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
-		}
+	externalImports := append(importMapping.GoImports(), importMap(xGoTypeImports).GoImports()...)
+	if modelsImport != nil {
+		externalImports = append(externalImports, modelsImport.String())
+	}
+	importsOut, err := GenerateImports(t, externalImports, opts.PackageName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating imports: %w", err)
 	}
 
-	if opts.Generate.GorillaServer {
-		_, err = w.WriteString(gorillaServerOut)
-		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+	componentFiles := []struct {
+		name     string
+		sections []string
+	}{
+		{"types.gen.go", []string{constantDefinitions, typeDefinitions}},
+		{"client.gen.go", []string{clientOut, clientWithResponsesOut}},
+		{"server.gen.go", []string{echoServerOut, chiServerOut, ginServerOut, gorillaServerOut, serverInterfaceOut, strictServerOut, validationMiddlewareOut, healthEndpointsOut, idempotencyKeyOut, loggingMiddlewareOut, mockServerOut}},
+		{"spec.gen.go", []string{inlinedSpec, operationExtensionsOut}},
+	}
+
+	files := make(map[string]string)
+	if !splitByComponent {
+		var code strings.Builder
+		for _, file := range componentFiles {
+			code.WriteString(strings.Join(file.sections, ""))
+		}
+		files[singleFileName(opts)] = SanitizeCode(importsOut + code.String())
+	} else {
+		for _, file := range componentFiles {
+			code := strings.Join(file.sections, "")
+			if code == "" {
+				continue
+			}
+			// Each file gets the full set of imports, and goimports removes
+			// the ones it doesn't use
+			files[file.name] = SanitizeCode(importsOut + code)
 		}
 	}
 
-	if opts.Generate.Strict {
-		_, err = w.WriteString(strictServerOut)
+	if opts.OutputOptions.UnexportedTypes {
+		files, err = unexportIdentifiers(opts.PackageName, files)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, nil, fmt.Errorf("error unexporting types: %w", err)
 		}
 	}
+	files = renameReceivers(opts.PackageName, files)
 
-	if opts.Generate.EmbeddedSpec {
-		_, err = w.WriteString(inlinedSpec)
+	for name, code := range files {
+		goCode, err := formatCode(opts, code)
 		if err != nil {
-			return "", fmt.Errorf("error writing inlined spec: %w", err)
+			if splitByComponent {
+				return nil, nil, fmt.Errorf("error generating %s: %w", name, err)
+			}
+			return nil, nil, err
 		}
+		files[name] = goCode
 	}
+	return files, ops, nil
+}
 
-	err = w.Flush()
-	if err != nil {
-		return "", fmt.Errorf("error flushing output buffer: %w", err)
-	}
-
+// formatCode tidies up generated Go source, formatting it and removing unused
+// imports unless OutputOptions.SkipFmt is set.
+func formatCode(opts Configuration, code string) (string, error) {
 	// remove any byte-order-marks which break Go-Code
-	goCode := SanitizeCode(buf.String())
+	goCode := SanitizeCode(code)
 
 	// The generation code produces unindented horrors. Use the Go Imports
 	// to make it all pretty.
@@ -402,12 +556,90 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []Op
 		return "", fmt.Errorf("error generating union boilerplate: %w", err)
 	}
 
+	tupleBoilerplate, err := GenerateTupleBoilerplate(t, allTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating tuple boilerplate: %w", err)
+	}
+
 	unionAndAdditionalBoilerplate, err := GenerateUnionAndAdditionalProopertiesBoilerplate(t, allTypes)
 	if err != nil {
 		return "", fmt.Errorf("error generating boilerplate for union types with additionalProperties: %w", err)
 	}
 
-	typeDefinitions := strings.Join([]string{enumsOut, typesOut, operationsOut, allOfBoilerplate, unionBoilerplate, unionAndAdditionalBoilerplate}, "")
+	nullableBoilerplate, err := GenerateNullableBoilerplate(t, allTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating boilerplate for nullable properties: %w", err)
+	}
+
+	constructorsOut, err := GenerateConstructors(t, allTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating constructors: %w", err)
+	}
+
+	defaultsOut, err := GenerateDefaults(t, allTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating defaults: %w", err)
+	}
+
+	validatorsOut, err := GenerateValidators(t, allTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating validators: %w", err)
+	}
+
+	patternPropertiesOut, err := GeneratePatternProperties(t, allTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating validators of patternProperties: %w", err)
+	}
+
+	rejectUnknownFieldsOut, err := GenerateRejectUnknownFields(t, allTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating unmarshalers rejecting unknown fields: %w", err)
+	}
+
+	redactedStringersOut, err := GenerateRedactedStringers(t, allTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating String methods redacting sensitive fields: %w", err)
+	}
+
+	xmlNamesOut, err := GenerateXMLNames(t, allTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating MarshalXML methods: %w", err)
+	}
+
+	// A single Visitor covers the types of operations too
+	visitorsOut, err := GenerateVisitors(t, enumTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating visitors: %w", err)
+	}
+
+	equalOut, err := GenerateEqual(t, enumTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating Equal methods: %w", err)
+	}
+
+	var nullableOut string
+	if globalState.options.OutputOptions.NullableType {
+		nullableOut, err = GenerateTemplates([]string{"nullable.tmpl"}, t, nil)
+		if err != nil {
+			return "", fmt.Errorf("error generating Nullable type: %w", err)
+		}
+	}
+
+	var ptrOut string
+	if globalState.options.OutputOptions.GeneratePtrHelpers {
+		name := globalState.options.OutputOptions.PtrHelperName
+		for _, td := range allTypes {
+			if td.TypeName == name {
+				return "", fmt.Errorf("the pointer helper %s has the same name as a generated type, set ptr-helper-name to another name", name)
+			}
+		}
+		ptrOut, err = GenerateTemplates([]string{"ptr.tmpl"}, t, name)
+		if err != nil {
+			return "", fmt.Errorf("error generating pointer helper: %w", err)
+		}
+	}
+
+	typeDefinitions := strings.Join([]string{enumsOut, nullableOut, ptrOut, typesOut, operationsOut, allOfBoilerplate, unionBoilerplate, tupleBoilerplate, unionAndAdditionalBoilerplate, nullableBoilerplate, constructorsOut, defaultsOut, validatorsOut, patternPropertiesOut, rejectUnknownFieldsOut, redactedStringersOut, xmlNamesOut, visitorsOut, equalOut}, "")
 	return typeDefinitions, nil
 }
 
@@ -445,6 +677,9 @@ func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.
 		excludeSchemasMap[schema] = true
 	}
 	types := make([]TypeDefinition, 0)
+	// The schema each Go type name was generated for, as the name normalizer
+	// may turn several schema names into the same identifier
+	typeNames := make(map[string]string)
 	// We're going to define Go types for every object under components/schemas
 	for _, schemaName := range SortedSchemaKeys(schemas) {
 		if _, ok := excludeSchemasMap[schemaName]; ok {
@@ -461,6 +696,11 @@ func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.
 		if err != nil {
 			return nil, fmt.Errorf("error making name for components/schemas/%s: %w", schemaName, err)
 		}
+		if other, found := typeNames[goTypeName]; found {
+			return nil, fmt.Errorf("components/schemas/%s and components/schemas/%s both have the Go type name %s, "+
+				"please use x-go-name to specify your own name for one of them", other, schemaName, goTypeName)
+		}
+		typeNames[goTypeName] = schemaName
 
 		types = append(types, TypeDefinition{
 			JsonName: schemaName,
@@ -706,6 +946,38 @@ func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error)
 	return GenerateTemplates([]string{"constants.tmpl"}, t, Constants{EnumDefinitions: enums})
 }
 
+// buildConstraint combines build tags, each of which may be an expression
+// such as "linux || darwin", into the expression for a //go:build line.
+func buildConstraint(tags []string) string {
+	if len(tags) == 1 {
+		return tags[0]
+	}
+	exprs := make([]string, len(tags))
+	for i, tag := range tags {
+		if strings.ContainsAny(tag, " |&!()") {
+			tag = "(" + tag + ")"
+		}
+		exprs[i] = tag
+	}
+	return strings.Join(exprs, " && ")
+}
+
+// headerComment turns text into a Go comment, leaving lines which are
+// already comments alone.
+func headerComment(text string) string {
+	text = strings.TrimRight(text, "\n")
+	if text == "" {
+		return ""
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "//") {
+			lines[i] = strings.TrimRight("// "+line, " ")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 // GenerateImports generates our import statements and package definition.
 func GenerateImports(t *template.Template, externalImports []string, packageName string) (string, error) {
 	// Read build version for incorporating into generated files
@@ -729,21 +1001,44 @@ func GenerateImports(t *template.Template, externalImports []string, packageName
 		ModuleName        string
 		Version           string
 		AdditionalImports []AdditionalImport
+		BuildConstraint   string
+		HeaderComment     string
 	}{
 		ExternalImports:   externalImports,
 		PackageName:       packageName,
 		ModuleName:        modulePath,
 		Version:           moduleVersion,
-		AdditionalImports: globalState.options.AdditionalImports,
+		AdditionalImports: dedupeAdditionalImports(globalState.options.AdditionalImports, externalImports),
+		BuildConstraint:   buildConstraint(globalState.options.OutputOptions.BuildTags),
+		HeaderComment:     headerComment(globalState.options.OutputOptions.FileHeaderComment),
 	}
 
 	return GenerateTemplates([]string{"imports.tmpl"}, t, context)
 }
 
+// dedupeAdditionalImports leaves out the additional imports which are repeated,
+// or which are among the external imports already, with the same name.
+func dedupeAdditionalImports(additionalImports []AdditionalImport, externalImports []string) []AdditionalImport {
+	seen := make(map[string]bool, len(externalImports))
+	for _, imprt := range externalImports {
+		seen[imprt] = true
+	}
+	var result []AdditionalImport
+	for _, imprt := range additionalImports {
+		key := goImport{Name: imprt.Alias, Path: imprt.Package}.String()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, imprt)
+	}
+	return result
+}
+
 // GenerateAdditionalPropertyBoilerplate generates all the glue code which provides
 // the API for interacting with additional properties and JSON-ification
 func GenerateAdditionalPropertyBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
-	var filteredTypes []TypeDefinition
+	var filteredTypes, mapTypes []TypeDefinition
 
 	m := map[string]bool{}
 
@@ -757,6 +1052,11 @@ func GenerateAdditionalPropertyBoilerplate(t *template.Template, typeDefs []Type
 		if t.Schema.HasAdditionalProperties {
 			filteredTypes = append(filteredTypes, t)
 		}
+		// Aliases can't have methods, so only defined map types get the
+		// Get/Set helpers.
+		if t.Schema.IsAdditionalPropertiesMap && !t.Schema.IsRef() && !t.IsAlias() {
+			mapTypes = append(mapTypes, t)
+		}
 	}
 
 	context := struct {
@@ -765,7 +1065,20 @@ func GenerateAdditionalPropertyBoilerplate(t *template.Template, typeDefs []Type
 		Types: filteredTypes,
 	}
 
-	return GenerateTemplates([]string{"additional-properties.tmpl"}, t, context)
+	out, err := GenerateTemplates([]string{"additional-properties.tmpl"}, t, context)
+	if err != nil {
+		return "", err
+	}
+	if len(mapTypes) == 0 {
+		return out, nil
+	}
+
+	context.Types = mapTypes
+	mapOut, err := GenerateTemplates([]string{"additional-properties-map.tmpl"}, t, context)
+	if err != nil {
+		return "", err
+	}
+	return out + mapOut, nil
 }
 
 func GenerateUnionBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
@@ -789,6 +1102,710 @@ func GenerateUnionBoilerplate(t *template.Template, typeDefs []TypeDefinition) (
 	return GenerateTemplates([]string{"union.tmpl"}, t, context)
 }
 
+// GenerateNullableBoilerplate generates MarshalJSON methods which leave out
+// unset Nullable fields, for the struct types which have any. Types with
+// additionalProperties or unions handle Nullable fields in their own
+// MarshalJSON methods.
+func GenerateNullableBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	if !globalState.options.OutputOptions.NullableType {
+		return "", nil
+	}
+
+	var filteredTypes []TypeDefinition
+	m := map[string]bool{}
+	for _, td := range typeDefs {
+		if m[td.TypeName] || td.IsAlias() || td.Schema.IsRef() || td.Schema.HasAdditionalProperties || len(td.Schema.UnionElements) != 0 {
+			continue
+		}
+		m[td.TypeName] = true
+		for _, p := range td.Schema.Properties {
+			if p.HasNullableType() {
+				filteredTypes = append(filteredTypes, td)
+				break
+			}
+		}
+	}
+
+	if len(filteredTypes) == 0 {
+		return "", nil
+	}
+
+	context := struct {
+		Types []TypeDefinition
+	}{
+		Types: filteredTypes,
+	}
+
+	return GenerateTemplates([]string{"nullable-fields.tmpl"}, t, context)
+}
+
+// ConstructorDefinition describes the NewTypeName function generated for a
+// struct type with required fields.
+type ConstructorDefinition struct {
+	TypeName string
+	Params   []ConstructorParam
+	Defaults []DefaultField // Optional fields set to their defaults
+}
+
+// ConstructorParam is a required field set by a constructor.
+type ConstructorParam struct {
+	Name      string // Name of the constructor's argument
+	TypeDecl  string // Type of the argument, which is never a pointer
+	FieldName string // Name of the struct field it's assigned to
+	Pointer   bool   // Whether the field holds a pointer to the argument
+}
+
+// GenerateConstructors generates a constructor for each of the given types
+// which has required properties, when the generate-constructors option is set.
+func GenerateConstructors(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	if !globalState.options.OutputOptions.GenerateConstructors {
+		return "", nil
+	}
+
+	constructors, err := methodDefinitions(typeDefs, isVisitedStruct, func(td TypeDefinition) (ConstructorDefinition, bool, error) {
+		constructor := ConstructorDefinition{TypeName: td.TypeName, Defaults: defaultFields(td)}
+		for _, p := range td.Schema.Properties {
+			if !p.Required {
+				continue
+			}
+			fieldName := p.GoStructFieldName()
+			name := LowercaseFirstCharacter(fieldName)
+			if IsGoKeyword(name) {
+				name = "p" + fieldName
+			}
+			constructor.Params = append(constructor.Params, ConstructorParam{
+				Name:      name,
+				TypeDecl:  p.Schema.TypeDecl(),
+				FieldName: fieldName,
+				Pointer:   strings.HasPrefix(p.GoTypeDef(), "*"),
+			})
+		}
+		return constructor, len(constructor.Params) != 0, nil
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if len(constructors) == 0 {
+		return "", nil
+	}
+
+	return GenerateTemplates([]string{"constructors.tmpl"}, t, constructors)
+}
+
+// DefaultsDefinition describes the Defaults method generated for a struct
+// type with optional fields which have default values.
+type DefaultsDefinition struct {
+	TypeName string
+	Fields   []DefaultField
+}
+
+// DefaultField is an optional field set to its default value.
+type DefaultField struct {
+	FieldName string // Name of the struct field
+	Value     string // Go expression for the default value
+}
+
+func defaultFields(td TypeDefinition) []DefaultField {
+	var fields []DefaultField
+	for _, p := range td.Schema.Properties {
+		if p.Default != "" {
+			fields = append(fields, DefaultField{FieldName: p.GoStructFieldName(), Value: p.Default})
+		}
+	}
+	return fields
+}
+
+// GenerateDefaults generates a Defaults method for each of the given types
+// which has optional properties with default values, when the
+// use-defaults-for-optional option makes them values rather than pointers.
+func GenerateDefaults(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	if !globalState.options.OutputOptions.UseDefaultsForOptional {
+		return "", nil
+	}
+
+	defaults, err := methodDefinitions(typeDefs, isVisitedStruct, func(td TypeDefinition) (DefaultsDefinition, bool, error) {
+		fields := defaultFields(td)
+		return DefaultsDefinition{TypeName: td.TypeName, Fields: fields}, len(fields) != 0, nil
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if len(defaults) == 0 {
+		return "", nil
+	}
+
+	return GenerateTemplates([]string{"defaults.tmpl"}, t, defaults)
+}
+
+// ValidatorDefinition describes the Validate method generated for a struct
+// type whose properties have constraints to check.
+type ValidatorDefinition struct {
+	TypeName string
+	Fields   []ValidatedField
+}
+
+// ValidatedField is a property checked by a Validate method.
+type ValidatedField struct {
+	JsonName string   // Name of the property, used in errors
+	Guard    string   // Condition for the field to be checked, for optional fields, or empty
+	Range    string   // Expression for a map, each of whose values is checked as Value, or empty
+	Count    []string // Statements counting the properties of a struct into Counter, for its minProperties and maxProperties, or empty
+	Counter  string   // Variable holding the number of properties which Count counts
+	Value    string   // Expression for the field's value
+	Schema   Schema
+}
+
+// PropertyCount returns the expression for the number of properties of the
+// field's value, for its minProperties and maxProperties.
+func (f ValidatedField) PropertyCount() string {
+	if len(f.Count) != 0 {
+		return f.Counter
+	}
+	return "len(" + f.Value + ")"
+}
+
+// NumberValue returns the expression for the field's value to compare with
+// its bounds, converting integers to float64 when a bound has a fractional
+// part, as an integer can't be compared with it directly.
+func (f ValidatedField) NumberValue() string {
+	if f.Schema.IsInteger() && (!isIntegral(f.Schema.Minimum) || !isIntegral(f.Schema.Maximum)) {
+		return "float64(" + f.Value + ")"
+	}
+	return f.Value
+}
+
+// FloatValue returns the expression for the field's value as a float64.
+func (f ValidatedField) FloatValue() string {
+	if f.Schema.GoType == "float64" && !f.Schema.IsRef() {
+		return f.Value
+	}
+	return "float64(" + f.Value + ")"
+}
+
+// IntegerMultipleOf returns true if the field is an integer which has to be
+// a multiple of another integer, which can be checked with the % operator.
+func (f ValidatedField) IntegerMultipleOf() bool {
+	return f.Schema.IsInteger() && f.Schema.MultipleOf != nil && isIntegral(f.Schema.MultipleOf)
+}
+
+// isIntegral returns true if n is nil or a whole number.
+func isIntegral(n *float64) bool {
+	return n == nil || *n == math.Trunc(*n)
+}
+
+// formatNumber formats a number from a schema as a Go constant.
+func formatNumber(n *float64) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*n, 'f', -1, 64)
+}
+
+// GenerateValidators generates a Validate method for each of the given struct
+// and map types which has constraints to check, on its properties, their
+// number, or the values of a map, when the generate-validators option is set.
+func GenerateValidators(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	if !globalState.options.OutputOptions.GenerateValidators {
+		return "", nil
+	}
+
+	// Maps with patternProperties are checked by their own Validate methods
+	isValidated := func(td TypeDefinition) bool {
+		return !td.IsAlias() && !td.Schema.IsRef() && td.Schema.ArrayType == nil && len(td.Schema.PatternProperties) == 0
+	}
+	validators, err := methodDefinitions(typeDefs, isValidated, func(td TypeDefinition) (ValidatorDefinition, bool, error) {
+		validator := ValidatorDefinition{TypeName: td.TypeName}
+		receiver := ReceiverName(td.TypeName)
+		if strings.HasPrefix(td.Schema.GoType, "map[") {
+			validator.Fields = validatedMapFields(td.TypeName, receiver, td.Schema)
+		} else {
+			validator.Fields = validatedStructFields(td.TypeName, receiver, td.Schema)
+		}
+		return validator, len(validator.Fields) != 0, nil
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if len(validators) == 0 {
+		return "", nil
+	}
+
+	return GenerateTemplates([]string{"validators.tmpl"}, t, validators)
+}
+
+// validatedStructFields returns the fields of the struct type typeName, held
+// by receiver, which are checked by its Validate method, followed by its
+// additional properties: their number, and then each of their values.
+func validatedStructFields(typeName, receiver string, s Schema) []ValidatedField {
+	var fields []ValidatedField
+	for _, p := range s.Properties {
+		if !p.Schema.hasConstraints() {
+			continue
+		}
+		field := ValidatedField{
+			JsonName: p.JsonTagName(),
+			Value:    receiver + "." + p.GoStructFieldName(),
+			Schema:   p.Schema,
+		}
+		// Fields are reached through pointers without dereferencing them
+		value := field.Value
+		if p.HasNullableType() {
+			field.Guard = fmt.Sprintf("%s.%s.Set && !%s.%s.Null", receiver, p.GoStructFieldName(), receiver, p.GoStructFieldName())
+			field.Value += ".Value"
+			value = field.Value
+		} else if strings.HasPrefix(p.GoTypeDef(), "*") {
+			field.Guard = field.Value + " != nil"
+			field.Value = "*" + field.Value
+		}
+		// The properties of inline objects are counted like those of the
+		// struct itself, while maps have len
+		if p.Schema.HasPropertiesConstraints() && strings.HasPrefix(p.Schema.GoType, "struct") {
+			field.Counter = LowercaseFirstCharacter(p.GoStructFieldName()) + "Properties"
+			field.Count = countProperties(field.Counter, value, p.Schema)
+		}
+		fields = append(fields, field)
+	}
+	if !s.HasAdditionalProperties {
+		return fields
+	}
+
+	if s.HasPropertiesConstraints() {
+		fields = append(fields, ValidatedField{
+			JsonName: typeName,
+			Count:    countProperties("properties", receiver, s),
+			Counter:  "properties",
+			Schema:   Schema{MinProperties: s.MinProperties, MaxProperties: s.MaxProperties},
+		})
+	}
+	if s.AdditionalPropertiesType != nil && s.AdditionalPropertiesType.hasConstraints() {
+		fields = append(fields, ValidatedField{
+			JsonName: "each additional property of " + typeName,
+			Range:    receiver + ".AdditionalProperties",
+			Value:    "value",
+			Schema:   *s.AdditionalPropertiesType,
+		})
+	}
+	return fields
+}
+
+// countProperties returns the statements counting the properties of the
+// struct s, held by value, into the variable counter. The fields which are
+// set are counted along with the additional properties, as they are when
+// marshaling the struct.
+func countProperties(counter, value string, s Schema) []string {
+	required := 0
+	var count []string
+	for _, p := range s.Properties {
+		field := value + "." + p.GoStructFieldName()
+		typeDef := p.GoTypeDef()
+		switch {
+		case p.HasNullableType():
+			count = append(count, guarded(field+".Set", []string{counter + "++"})...)
+		case strings.HasPrefix(typeDef, "*") || strings.HasPrefix(typeDef, "[]") || strings.HasPrefix(typeDef, "map["):
+			if p.Required {
+				required++
+			} else {
+				count = append(count, guarded(field+" != nil", []string{counter + "++"})...)
+			}
+		default:
+			required++
+		}
+	}
+	total := fmt.Sprintf("%s := %d", counter, required)
+	if s.HasAdditionalProperties {
+		total = fmt.Sprintf("%s := len(%s.AdditionalProperties)", counter, value)
+		if required != 0 {
+			total += fmt.Sprintf(" + %d", required)
+		}
+	}
+	return append([]string{total}, count...)
+}
+
+// validatedMapFields returns what the Validate method of the map type
+// typeName, held by receiver, checks: the number of its properties, and then
+// each of their values.
+func validatedMapFields(typeName, receiver string, s Schema) []ValidatedField {
+	var fields []ValidatedField
+	if s.HasPropertiesConstraints() {
+		fields = append(fields, ValidatedField{
+			JsonName: typeName,
+			Value:    receiver,
+			Schema:   Schema{MinProperties: s.MinProperties, MaxProperties: s.MaxProperties},
+		})
+	}
+	if s.AdditionalPropertiesType != nil && s.AdditionalPropertiesType.hasConstraints() {
+		fields = append(fields, ValidatedField{
+			JsonName: "each property of " + typeName,
+			Range:    receiver,
+			Value:    "value",
+			Schema:   *s.AdditionalPropertiesType,
+		})
+	}
+	return fields
+}
+
+// RejectUnknownFieldsDefinition describes the UnmarshalJSON method generated
+// for a struct type whose schema doesn't allow additional properties.
+type RejectUnknownFieldsDefinition struct {
+	TypeName  string
+	JsonNames []string // Names of the properties which the schema allows
+
+	// The type which TypeName is defined as, whose UnmarshalJSON method it
+	// calls, for types such as request bodies defined as other types.
+	Underlying string
+}
+
+// GenerateRejectUnknownFields generates an UnmarshalJSON method rejecting
+// unknown properties for each of the given struct types whose schemas set
+// additionalProperties to false, when the reject-unknown-fields option is set.
+func GenerateRejectUnknownFields(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	if !globalState.options.OutputOptions.RejectUnknownFields {
+		return "", nil
+	}
+
+	definitions, err := methodDefinitions(typeDefs, isVisitedStruct, func(td TypeDefinition) (RejectUnknownFieldsDefinition, bool, error) {
+		definition := RejectUnknownFieldsDefinition{TypeName: td.TypeName}
+		schema := td.Schema.OAPISchema
+		if len(td.Schema.UnionElements) != 0 || td.Schema.HasAdditionalProperties ||
+			schema == nil || schema.AdditionalProperties.Has == nil || *schema.AdditionalProperties.Has {
+			return definition, false, nil
+		}
+		for _, p := range td.Schema.Properties {
+			definition.JsonNames = append(definition.JsonNames, p.JsonFieldName)
+		}
+		return definition, true, nil
+	}, func(typeName, underlying string, _ RejectUnknownFieldsDefinition) RejectUnknownFieldsDefinition {
+		return RejectUnknownFieldsDefinition{TypeName: typeName, Underlying: underlying}
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(definitions) == 0 {
+		return "", nil
+	}
+
+	return GenerateTemplates([]string{"reject-unknown-fields.tmpl"}, t, definitions)
+}
+
+// RedactedStringerDefinition describes the String and GoString methods
+// generated for a struct type with sensitive fields.
+type RedactedStringerDefinition struct {
+	TypeName string
+	Fields   []RedactedField
+}
+
+// RedactedField is a field printed by the String and GoString methods of a
+// struct type.
+type RedactedField struct {
+	Name      string
+	Pointer   bool // Whether what it points to is printed
+	Sensitive bool // Whether it's printed as "***"
+}
+
+// GenerateRedactedStringers generates String and GoString methods, printing
+// the sensitive fields as "***", for each of the given struct types which has
+// any, when the redact-sensitive option is set.
+func GenerateRedactedStringers(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	if !globalState.options.OutputOptions.RedactSensitive {
+		return "", nil
+	}
+
+	definitions, err := methodDefinitions(typeDefs, isVisitedStruct, func(td TypeDefinition) (RedactedStringerDefinition, bool, error) {
+		if len(td.Schema.UnionElements) != 0 {
+			return RedactedStringerDefinition{}, false, nil
+		}
+		var sensitive bool
+		var tdFields []RedactedField
+		for _, p := range td.Schema.Properties {
+			sensitive = sensitive || p.Sensitive()
+			tdFields = append(tdFields, RedactedField{
+				Name:      p.GoStructFieldName(),
+				Pointer:   strings.HasPrefix(p.GoTypeDef(), "*"),
+				Sensitive: p.Sensitive(),
+			})
+		}
+		if !sensitive {
+			return RedactedStringerDefinition{}, false, nil
+		}
+		if td.Schema.HasAdditionalProperties {
+			tdFields = append(tdFields, RedactedField{Name: "AdditionalProperties"})
+		}
+		for _, field := range tdFields {
+			if field.Name == "String" || field.Name == "GoString" {
+				return RedactedStringerDefinition{}, false, fmt.Errorf("%s has a %s field, so it can't have a %s method redacting its sensitive fields", td.TypeName, field.Name, field.Name)
+			}
+		}
+		return RedactedStringerDefinition{TypeName: td.TypeName, Fields: tdFields}, true, nil
+	}, func(typeName, _ string, d RedactedStringerDefinition) RedactedStringerDefinition {
+		return RedactedStringerDefinition{TypeName: typeName, Fields: d.Fields}
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(definitions) == 0 {
+		return "", nil
+	}
+
+	return GenerateTemplates([]string{"redact-sensitive.tmpl"}, t, definitions)
+}
+
+// XMLNameDefinition describes the MarshalXML method generated for a struct
+// type whose schema names its element.
+type XMLNameDefinition struct {
+	TypeName  string
+	Name      string // The local name of the root element, or empty for the type's name
+	Namespace string
+}
+
+// GenerateXMLNames generates a MarshalXML method, naming the root element of
+// a document as the xml metadata of its schema says, for each of the given
+// struct types whose schemas give a name or namespace. Their elements are
+// named by the properties holding them everywhere else, so an XMLName field,
+// which encoding/xml applies to both, can't name them.
+func GenerateXMLNames(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	definitions, err := methodDefinitions(typeDefs, isVisitedStruct, func(td TypeDefinition) (XMLNameDefinition, bool, error) {
+		meta := schemaXML(td.Schema)
+		if len(td.Schema.UnionElements) != 0 || meta == nil || meta.Name == "" && meta.Namespace == "" {
+			return XMLNameDefinition{}, false, nil
+		}
+		return XMLNameDefinition{TypeName: td.TypeName, Name: meta.Name, Namespace: meta.Namespace}, true, nil
+	}, func(typeName, _ string, d XMLNameDefinition) XMLNameDefinition {
+		return XMLNameDefinition{TypeName: typeName, Name: d.Name, Namespace: d.Namespace}
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(definitions) == 0 {
+		return "", nil
+	}
+
+	return GenerateTemplates([]string{"xml-names.tmpl"}, t, definitions)
+}
+
+// VisitorDefinition describes the Visit method generated for a struct type.
+type VisitorDefinition struct {
+	TypeName   string
+	Statements []string // Go statements visiting the fields of the type
+}
+
+// visitorGenerator writes the statements of Visit methods, recursing into
+// the fields of struct types through pointers, slices and maps.
+type visitorGenerator struct {
+	structs map[string]bool           // Types with Visit methods
+	aliases map[string]TypeDefinition // Aliases of other types, followed by refs
+}
+
+// visitProperties returns the statements visiting the properties of s, a
+// struct held by expr.
+func (g visitorGenerator) visitProperties(expr string, s Schema, depth int) []string {
+	var statements []string
+	for _, p := range s.Properties {
+		field := expr + "." + p.GoStructFieldName()
+		switch {
+		case p.HasNullableType():
+			var inner []string
+			if p.Recursive {
+				inner = g.visitPointer(field+".Value", p.Schema, depth)
+			} else {
+				inner = g.visitValue(field+".Value", p.Schema, depth)
+			}
+			if len(inner) != 0 {
+				statements = append(statements, guarded(fmt.Sprintf("%s.Set && !%s.Null", field, field), inner)...)
+			}
+		case strings.HasPrefix(p.GoTypeDef(), "*"):
+			statements = append(statements, g.visitPointer(field, p.Schema, depth)...)
+		default:
+			statements = append(statements, g.visitValue(field, p.Schema, depth)...)
+		}
+	}
+	if s.HasAdditionalProperties && s.AdditionalPropertiesType != nil && len(s.Properties) != 0 {
+		statements = append(statements, g.visitMap(expr+".AdditionalProperties", *s.AdditionalPropertiesType, depth)...)
+	}
+	return statements
+}
+
+// visitPointer returns the statements visiting what expr, a pointer to a
+// value of the type of s, points to.
+func (g visitorGenerator) visitPointer(expr string, s Schema, depth int) []string {
+	if name, ok := refTypeName(s); ok && g.structs[name] {
+		// Visit methods are called with nil pointers as well
+		return []string{expr + ".Visit(v)"}
+	}
+	var inner []string
+	if _, isRef := refTypeName(s); !isRef && strings.HasPrefix(s.GoType, "struct") {
+		// Fields are selected through the pointer
+		inner = g.visitProperties(expr, s, depth)
+	} else {
+		inner = g.visitValue("(*"+expr+")", s, depth)
+	}
+	if len(inner) == 0 {
+		return nil
+	}
+	return guarded(expr+" != nil", inner)
+}
+
+// visitValue returns the statements visiting expr, a value of the type of s,
+// or none if it holds no struct types with Visit methods.
+func (g visitorGenerator) visitValue(expr string, s Schema, depth int) []string {
+	if name, ok := refTypeName(s); ok {
+		if g.structs[name] {
+			return []string{expr + ".Visit(v)"}
+		}
+		if alias, ok := g.aliases[name]; ok && depth < 8 {
+			return g.visitValue(expr, alias.Schema, depth+1)
+		}
+		return nil
+	}
+	if s.ArrayType != nil {
+		index := fmt.Sprintf("i%d", depth)
+		inner := g.visitValue(expr+"["+index+"]", *s.ArrayType, depth+1)
+		if len(inner) == 0 {
+			return nil
+		}
+		return append(append([]string{fmt.Sprintf("for %s := range %s {", index, expr)}, inner...), "}")
+	}
+	if s.AdditionalPropertiesType != nil && strings.HasPrefix(s.GoType, "map[") {
+		return g.visitMap(expr, *s.AdditionalPropertiesType, depth)
+	}
+	if strings.HasPrefix(s.GoType, "struct") {
+		return g.visitProperties(expr, s, depth)
+	}
+	return nil
+}
+
+// visitMap returns the statements visiting the values of expr, a map of
+// values of the type of s. As map values can't be addressed, each is visited
+// as a copy which is stored back.
+func (g visitorGenerator) visitMap(expr string, s Schema, depth int) []string {
+	key, value := fmt.Sprintf("k%d", depth), fmt.Sprintf("x%d", depth)
+	inner := g.visitValue(value, s, depth+1)
+	if len(inner) == 0 {
+		return nil
+	}
+	statements := []string{fmt.Sprintf("for %s, %s := range %s {", key, value, expr)}
+	statements = append(statements, inner...)
+	return append(statements, fmt.Sprintf("%s[%s] = %s", expr, key, value), "}")
+}
+
+// refTypeName returns the name of the generated type which s refers to.
+func refTypeName(s Schema) (string, bool) {
+	if s.IsRef() {
+		return s.RefType, true
+	}
+	if s.RefOAPISchema != nil {
+		return s.GoType, true
+	}
+	return "", false
+}
+
+// guarded returns statements run only when condition holds.
+func guarded(condition string, statements []string) []string {
+	return append(append([]string{"if " + condition + " {"}, statements...), "}")
+}
+
+// isVisitedStruct returns true if td defines a struct type, other than that
+// of a tuple, which gets a Visit method.
+func isVisitedStruct(td TypeDefinition) bool {
+	_, isRef := refTypeName(td.Schema)
+	return !td.IsAlias() && !isRef && td.Schema.ArrayType == nil && len(td.Schema.TupleItems) == 0 &&
+		!td.Schema.IsAdditionalPropertiesMap && strings.HasPrefix(td.Schema.GoType, "struct")
+}
+
+// methodDefinitions returns the definitions which define gives of the methods
+// generated for the types among typeDefs which is accepts, such as
+// isVisitedStruct, visiting each type once. define returns false for types
+// which don't get any. Types defined as those which do, rather than aliases
+// of them, don't have their methods, so when defineAs isn't nil it gives their
+// definitions too, from the name and definition of the type they're defined
+// as.
+func methodDefinitions[D any](typeDefs []TypeDefinition, is func(TypeDefinition) bool,
+	define func(TypeDefinition) (D, bool, error), defineAs func(typeName, underlying string, d D) D) ([]D, error) {
+	var definitions []D
+	defined := map[string]D{}
+	seen := map[string]bool{}
+	for _, td := range typeDefs {
+		if seen[td.TypeName] || !is(td) {
+			continue
+		}
+		seen[td.TypeName] = true
+		d, ok, err := define(td)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			defined[td.TypeName] = d
+			definitions = append(definitions, d)
+		}
+	}
+
+	if defineAs == nil {
+		return definitions, nil
+	}
+	for _, td := range typeDefs {
+		if _, ok := defined[td.TypeName]; ok || td.IsAlias() {
+			continue
+		}
+		name, ok := refTypeName(td.Schema)
+		if !ok {
+			continue
+		}
+		if underlying, ok := defined[name]; ok {
+			d := defineAs(td.TypeName, name, underlying)
+			defined[td.TypeName] = d
+			definitions = append(definitions, d)
+		}
+	}
+	return definitions, nil
+}
+
+// GenerateVisitors generates a Visitor interface, with a method for each of
+// the given struct types, and a Visit method on each of them calling it for
+// itself and for the values of struct types it holds, when the
+// generate-visitors option is set.
+func GenerateVisitors(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	if !globalState.options.OutputOptions.GenerateVisitors {
+		return "", nil
+	}
+
+	g := visitorGenerator{structs: map[string]bool{}, aliases: map[string]TypeDefinition{}}
+	for _, td := range typeDefs {
+		if td.TypeName == "Visitor" || td.TypeName == "BaseVisitor" {
+			return "", fmt.Errorf("the %s generated for visitors has the same name as a generated type", td.TypeName)
+		}
+		if _, found := g.aliases[td.TypeName]; !found && td.IsAlias() {
+			g.aliases[td.TypeName] = td
+		}
+	}
+	// The statements visiting each struct depend on which others have Visit
+	// methods, so they're all found first
+	structs, err := methodDefinitions(typeDefs, isVisitedStruct, func(td TypeDefinition) (TypeDefinition, bool, error) {
+		g.structs[td.TypeName] = true
+		return td, true, nil
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if len(structs) == 0 {
+		return "", nil
+	}
+
+	visitors := make([]VisitorDefinition, 0, len(structs))
+	for _, td := range structs {
+		visitors = append(visitors, VisitorDefinition{
+			TypeName:   td.TypeName,
+			Statements: g.visitProperties(ReceiverName(td.TypeName), td.Schema, 0),
+		})
+	}
+	return GenerateTemplates([]string{"visitors.tmpl"}, t, visitors)
+}
+
 func GenerateUnionAndAdditionalProopertiesBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
 	var filteredTypes []TypeDefinition
 	for _, t := range typeDefs {
@@ -865,7 +1882,7 @@ func OperationSchemaImports(s *Schema) (map[string]goImport, error) {
 func OperationImports(ops []OperationDefinition) (map[string]goImport, error) {
 	res := map[string]goImport{}
 	for _, op := range ops {
-		for _, pd := range [][]ParameterDefinition{op.PathParams, op.QueryParams} {
+		for _, pd := range [][]ParameterDefinition{op.PathParams, op.QueryParams, op.HeaderParams, op.CookieParams} {
 			for _, p := range pd {
 				imprts, err := OperationSchemaImports(&p.Schema)
 				if err != nil {
@@ -932,39 +1949,41 @@ func GetTypeDefinitionsImports(swagger *openapi3.T, excludeSchemas []string) (ma
 func GoSchemaImports(schemas ...*openapi3.SchemaRef) (map[string]goImport, error) {
 	res := map[string]goImport{}
 	for _, sref := range schemas {
+		// Referenced schemas bring their own imports
 		if sref == nil || sref.Value == nil || IsGoTypeReference(sref.Ref) {
-			return nil, nil
+			continue
 		}
 		if gi, err := ParseGoImportExtension(sref); err != nil {
 			return nil, err
-		} else {
-			if gi != nil {
-				res[gi.String()] = *gi
-			}
+		} else if gi != nil && !isStandardImport(*gi) {
+			res[gi.String()] = *gi
 		}
 		schemaVal := sref.Value
 
-		t := schemaVal.Type
-		switch t {
-		case "", "object":
-			for _, v := range schemaVal.Properties {
-				imprts, err := GoSchemaImports(v)
-				if err != nil {
-					return nil, err
-				}
-				MergeImports(res, imprts)
-			}
-		case "array":
-			imprts, err := GoSchemaImports(schemaVal.Items)
-			if err != nil {
-				return nil, err
-			}
-			MergeImports(res, imprts)
+		subSchemas := []*openapi3.SchemaRef{schemaVal.Items, schemaVal.AdditionalProperties.Schema, schemaVal.Not}
+		for _, name := range SortedSchemaKeys(schemaVal.Properties) {
+			subSchemas = append(subSchemas, schemaVal.Properties[name])
 		}
+		subSchemas = append(subSchemas, schemaVal.AllOf...)
+		subSchemas = append(subSchemas, schemaVal.AnyOf...)
+		subSchemas = append(subSchemas, schemaVal.OneOf...)
+
+		imprts, err := GoSchemaImports(subSchemas...)
+		if err != nil {
+			return nil, err
+		}
+		MergeImports(res, imprts)
 	}
 	return res, nil
 }
 
+// isStandardImport returns true for imports of standard library packages
+// under their own names, which imports.tmpl may import already, and which
+// goimports adds anyway.
+func isStandardImport(gi goImport) bool {
+	return gi.Name == "" && !strings.Contains(strings.Split(gi.Path, "/")[0], ".")
+}
+
 func GetSchemaImports(schemas map[string]*openapi3.SchemaRef, excludeSchemas []string) (map[string]goImport, error) {
 	res := map[string]goImport{}
 	excludeSchemasMap := make(map[string]bool)
@@ -988,10 +2007,8 @@ func GetSchemaImports(schemas map[string]*openapi3.SchemaRef, excludeSchemas []s
 func GetRequestBodiesImports(bodies map[string]*openapi3.RequestBodyRef) (map[string]goImport, error) {
 	res := map[string]goImport{}
 	for _, r := range bodies {
-		response := r.Value
-		jsonBody, found := response.Content["application/json"]
-		if found {
-			imprts, err := GoSchemaImports(jsonBody.Schema)
+		for _, content := range r.Value.Content {
+			imprts, err := GoSchemaImports(content.Schema)
 			if err != nil {
 				return nil, err
 			}
@@ -1004,10 +2021,8 @@ func GetRequestBodiesImports(bodies map[string]*openapi3.RequestBodyRef) (map[st
 func GetResponsesImports(responses map[string]*openapi3.ResponseRef) (map[string]goImport, error) {
 	res := map[string]goImport{}
 	for _, r := range responses {
-		response := r.Value
-		jsonResponse, found := response.Content["application/json"]
-		if found {
-			imprts, err := GoSchemaImports(jsonResponse.Schema)
+		for _, content := range r.Value.Content {
+			imprts, err := GoSchemaImports(content.Schema)
 			if err != nil {
 				return nil, err
 			}
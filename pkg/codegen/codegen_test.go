@@ -6,6 +6,7 @@ import (
 	"go/format"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	examplePetstoreClient "github.com/deepmap/oapi-codegen/examples/petstore-expanded"
@@ -173,6 +174,7 @@ func TestExampleOpenAPICodeGeneration(t *testing.T) {
 type GetTestByNameResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	HTTPRequest  *http.Request
 	JSON200      *[]Test
 	XML200       *[]Test
 	JSON422      *[]interface{}
@@ -201,113 +203,3415 @@ type GetTestByNameResponse struct {
 	checkLint(t, "test.gen.go", []byte(code))
 }
 
+func TestGenerateFromSpec(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	t.Run("generates code and metadata", func(t *testing.T) {
+		generated, err := GenerateFromSpec(swagger, Configuration{
+			PackageName: "testswagger",
+			Generate: GenerateOptions{
+				Client: true,
+				Models: true,
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Contains(t, generated.Code, "package testswagger")
+		assert.Equal(t, 4, generated.OperationCount)
+		assert.Contains(t, generated.TypeNames, "Test")
+		assert.Contains(t, generated.TypeNames, "GetTestByNameParams")
+		assert.Contains(t, generated.TypeNames, "ClientWithResponses")
+	})
+
+	t.Run("validates the configuration", func(t *testing.T) {
+		_, err := GenerateFromSpec(swagger, Configuration{})
+		assert.ErrorContains(t, err, "package name must be specified")
+	})
+}
+
+func TestGenerateFiles(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	files, err := GenerateFiles(swagger, Configuration{
+		PackageName: "testswagger",
+		Generate: GenerateOptions{
+			ChiServer:    true,
+			Client:       true,
+			Models:       true,
+			EmbeddedSpec: true,
+		},
+	})
+	require.NoError(t, err)
+
+	fileNames := make([]string, 0, len(files))
+	sources := make(map[string][]byte, len(files))
+	for name, code := range files {
+		fileNames = append(fileNames, name)
+		sources[name] = []byte(code)
+
+		// Every file is a complete source file
+		assert.Contains(t, code, "DO NOT EDIT.\npackage testswagger\n")
+	}
+	assert.ElementsMatch(t, []string{"types.gen.go", "client.gen.go", "server.gen.go", "spec.gen.go"}, fileNames)
+
+	assert.Contains(t, files["types.gen.go"], "type Test struct {")
+	assert.Contains(t, files["client.gen.go"], "type ClientWithResponses struct {")
+	assert.Contains(t, files["server.gen.go"], "type ServerInterface interface {")
+	assert.Contains(t, files["spec.gen.go"], "func GetSwagger() (")
+
+	// Imports are only those each file uses
+	assert.NotContains(t, files["types.gen.go"], `"github.com/go-chi/chi/v5"`)
+	assert.Contains(t, files["server.gen.go"], `"github.com/go-chi/chi/v5"`)
+
+	linter := new(lint.Linter)
+	problems, err := linter.LintFiles(sources)
+	assert.NoError(t, err)
+	assert.Len(t, problems, 0)
+
+	t.Run("leaves out files for components which aren't generated", func(t *testing.T) {
+		files, err := GenerateFiles(swagger, Configuration{
+			PackageName: "testswagger",
+			Generate: GenerateOptions{
+				Models: true,
+			},
+		})
+		require.NoError(t, err)
+		assert.Len(t, files, 1)
+		assert.Contains(t, files, "types.gen.go")
+	})
+}
+
+func TestFileHeader(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(goMethodNameSpec))
+	require.NoError(t, err)
+
+	cfg := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			FileHeaderComment: "Copyright 2023 Example Corp.\n\nLicensed under the MIT license.\n",
+			BuildTags:         []string{"integration", "linux || darwin"},
+		},
+	}
+	generated, err := GenerateFromSpec(swagger, cfg)
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(generated.Code, `//go:build integration && (linux || darwin)
+
+// Copyright 2023 Example Corp.
+//
+// Licensed under the MIT license.
+
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by`), generated.Code)
+
+	// The header survives gofmt unchanged
+	formatted, err := format.Source([]byte(generated.Code))
+	require.NoError(t, err)
+	assert.Equal(t, generated.Code, string(formatted))
+	checkLint(t, "test.gen.go", []byte(generated.Code))
+
+	cfg.OutputOptions.BuildTags = []string{"linux ||"}
+	_, err = GenerateFromSpec(swagger, cfg)
+	assert.ErrorContains(t, err, "invalid build-tags")
+}
+
+const constructorsSpec = `
+openapi: 3.0.1
+info:
+  title: Constructors
+  version: 1.0.0
+paths:
+  /widgets:
+    get:
+      operationId: listWidgets
+      parameters:
+        - name: owner
+          in: query
+          required: true
+          schema:
+            type: string
+        - name: limit
+          in: query
+          schema:
+            type: integer
+      responses:
+        '200':
+          description: Widgets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Widget'
+components:
+  schemas:
+    Widget:
+      type: object
+      required: [id, type, color, size]
+      properties:
+        id:
+          type: integer
+        type:
+          type: string
+        color:
+          type: string
+          nullable: true
+        size:
+          type: integer
+          x-go-name: Dimension
+        label:
+          $ref: '#/components/schemas/Label'
+    Label:
+      type: object
+      properties:
+        text:
+          type: string
+`
+
+func TestGenerateConstructors(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(constructorsSpec))
+	require.NoError(t, err)
+
+	cfg := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			GenerateConstructors: true,
+		},
+	}
+	generated, err := GenerateFromSpec(swagger, cfg)
+	require.NoError(t, err)
+
+	assert.Contains(t, generated.Code, `func NewWidget(color string, id int, dimension int, pType string) Widget {
+	return Widget{
+		Color:     &color,
+		Id:        id,
+		Dimension: dimension,
+		Type:      pType,
+	}
+}`)
+	assert.Contains(t, generated.Code, `func NewListWidgetsParams(owner string) ListWidgetsParams {
+	return ListWidgetsParams{
+		Owner: owner,
+	}
+}`)
+	// Types without required fields don't get a constructor
+	assert.NotContains(t, generated.Code, "func NewLabel(")
+	checkLint(t, "test.gen.go", []byte(generated.Code))
+
+	cfg.OutputOptions.GenerateConstructors = false
+	generated, err = GenerateFromSpec(swagger, cfg)
+	require.NoError(t, err)
+	assert.NotContains(t, generated.Code, "func NewWidget(")
+}
+
+const jsonTagStyleSpec = `
+openapi: 3.0.1
+info:
+  title: JSON tag styles
+  version: 1.0.0
+paths:
+  /people:
+    get:
+      operationId: listPeople
+      parameters:
+        - name: page_size
+          in: query
+          schema:
+            type: integer
+      responses:
+        '200':
+          description: People
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Person'
+components:
+  schemas:
+    Person:
+      type: object
+      required: [first_name]
+      properties:
+        first_name:
+          type: string
+        lastName:
+          type: string
+`
+
+func TestJSONTagStyle(t *testing.T) {
+	generateWithStyle := func(t *testing.T, spec string, style string) (string, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+
+		return Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate: GenerateOptions{
+				Client: true,
+				Models: true,
+			},
+			OutputOptions: OutputOptions{
+				JSONTagStyle: style,
+			},
+		})
+	}
+
+	t.Run("spec", func(t *testing.T) {
+		code, err := generateWithStyle(t, jsonTagStyleSpec, JSONTagStyleSpec)
+		require.NoError(t, err)
+		assert.Contains(t, code, "FirstName string  `json:\"first_name\"`")
+		assert.Contains(t, code, "LastName  *string `json:\"lastName,omitempty\"`")
+	})
+
+	t.Run("camel", func(t *testing.T) {
+		code, err := generateWithStyle(t, jsonTagStyleSpec, JSONTagStyleCamel)
+		require.NoError(t, err)
+		assert.Contains(t, code, "FirstName string  `json:\"firstName\"`")
+		assert.Contains(t, code, "LastName  *string `json:\"lastName,omitempty\"`")
+		assert.Contains(t, code, "PageSize *int `form:\"page_size,omitempty\" json:\"pageSize,omitempty\"`")
+		checkLint(t, "test.gen.go", []byte(code))
+	})
+
+	t.Run("snake", func(t *testing.T) {
+		code, err := generateWithStyle(t, jsonTagStyleSpec, JSONTagStyleSnake)
+		require.NoError(t, err)
+		assert.Contains(t, code, "FirstName string  `json:\"first_name\"`")
+		assert.Contains(t, code, "LastName  *string `json:\"last_name,omitempty\"`")
+	})
+
+	t.Run("conflicting names", func(t *testing.T) {
+		spec := strings.Replace(jsonTagStyleSpec, "lastName:", "firstName:", 1)
+		_, err := generateWithStyle(t, spec, JSONTagStyleCamel)
+		assert.ErrorContains(t, err, "'firstName' and 'first_name' both become 'firstName' in camel JSON tag style")
+
+		// The tags differ in the spec's style, but the field names don't
+		_, err = generateWithStyle(t, spec, JSONTagStyleSpec)
+		assert.ErrorContains(t, err, "'firstName' and 'first_name' both have the Go field name FirstName")
+	})
+
+	t.Run("unknown style", func(t *testing.T) {
+		_, err := GenerateFromSpec(&openapi3.T{}, Configuration{
+			PackageName:   "api",
+			OutputOptions: OutputOptions{JSONTagStyle: "kebab"},
+		})
+		assert.ErrorContains(t, err, `unknown json-tag-style "kebab"`)
+	})
+}
+
+const nameNormalizerSpec = `
+openapi: 3.0.1
+info:
+  title: Names
+  version: 1.0.0
+paths:
+  /users/{user_id}:
+    get:
+      operationId: get_user_by_id
+      parameters:
+        - name: user_id
+          in: path
+          required: true
+          schema:
+            type: string
+        - name: http_url
+          in: query
+          schema:
+            type: string
+      responses:
+        '200':
+          description: The user
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/v2.user_profile'
+components:
+  schemas:
+    v2.user_profile:
+      type: object
+      properties:
+        id:
+          type: string
+        avatar_url:
+          type: string
+`
+
+func TestNameNormalizer(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(nameNormalizerSpec))
+	require.NoError(t, err)
+
+	generate := func(t *testing.T, normalizer string) (string, error) {
+		code, err := Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate: GenerateOptions{
+				ChiServer: true,
+				Client:    true,
+				Models:    true,
+			},
+			OutputOptions: OutputOptions{
+				NameNormalizer: normalizer,
+			},
+		})
+		if err == nil {
+			checkLint(t, "test.gen.go", []byte(code))
+		}
+		return code, err
+	}
+
+	t.Run("default", func(t *testing.T) {
+		code, err := generate(t, NameNormalizerDefault)
+		require.NoError(t, err)
+		assert.Contains(t, code, "type V2UserProfile struct {")
+		assert.Contains(t, code, "Id        *string `json:\"id,omitempty\"`")
+		assert.Contains(t, code, "GetUserById(w http.ResponseWriter, r *http.Request, userId string, params GetUserByIdParams)")
+	})
+
+	t.Run("with digits", func(t *testing.T) {
+		code, err := generate(t, NameNormalizerCamelCaseWithDigits)
+		require.NoError(t, err)
+		assert.Contains(t, code, "type V2UserProfile struct {")
+	})
+
+	t.Run("with initialisms", func(t *testing.T) {
+		code, err := generate(t, NameNormalizerCamelCaseInitialisms)
+		require.NoError(t, err)
+		// Type, field, parameter and method names are all normalized
+		assert.Contains(t, code, `type V2UserProfile struct {
+	AvatarURL *string `+"`json:\"avatar_url,omitempty\"`"+`
+	ID        *string `+"`json:\"id,omitempty\"`"+`
+}`)
+		assert.Contains(t, code, "HTTPURL *string `form:\"http_url,omitempty\" json:\"http_url,omitempty\"`")
+		assert.Contains(t, code, "GetUserByID(w http.ResponseWriter, r *http.Request, userID string, params GetUserByIDParams)")
+		assert.Contains(t, code, "type GetUserByIDResponse struct {")
+	})
+
+	t.Run("collisions", func(t *testing.T) {
+		spec := strings.Replace(nameNormalizerSpec, "        avatar_url:", "        ID:\n          type: integer\n        avatar_url:", 1)
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+		_, err = Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate:    GenerateOptions{Models: true},
+			OutputOptions: OutputOptions{
+				NameNormalizer: NameNormalizerCamelCaseInitialisms,
+			},
+		})
+		assert.ErrorContains(t, err, "'ID' and 'id' both have the Go field name ID")
+	})
+
+	t.Run("type name collisions", func(t *testing.T) {
+		spec := strings.Replace(nameNormalizerSpec, "    v2.user_profile:", "    v2_user_profile:\n      type: string\n    v2.user_profile:", 1)
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+		_, err = Generate(swagger, Configuration{
+			PackageName:   "api",
+			Generate:      GenerateOptions{Models: true},
+			OutputOptions: OutputOptions{SkipPrune: true},
+		})
+		assert.ErrorContains(t, err, "components/schemas/v2.user_profile and components/schemas/v2_user_profile both have the Go type name V2UserProfile")
+	})
+
+	t.Run("unknown normalizer", func(t *testing.T) {
+		_, err := GenerateFromSpec(&openapi3.T{}, Configuration{
+			PackageName:   "api",
+			OutputOptions: OutputOptions{NameNormalizer: "ToSnakeCase"},
+		})
+		assert.ErrorContains(t, err, `unknown name-normalizer "ToSnakeCase"`)
+	})
+}
+
+const invalidParamLocationSpec = `
+openapi: 3.0.1
+info:
+  title: Invalid parameter
+  version: 1.0.0
+paths:
+  /things:
+    get:
+      operationId: getThings
+      parameters:
+        - name: filter
+          in: body
+          schema:
+            type: string
+      responses:
+        '204':
+          description: No things
+`
+
+func TestInvalidParamLocation(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(invalidParamLocationSpec))
+	require.NoError(t, err)
+
+	_, err = Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			ChiServer: true,
+			Client:    true,
+			Models:    true,
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `parameter filter has unknown location "body"`)
+}
+
+const goMethodNameSpec = `
+openapi: 3.0.1
+info:
+  title: Method names
+  version: 1.0.0
+paths:
+  /widgets:
+    get:
+      operationId: getWidgetsCollection
+      x-go-method-name: listWidgets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+      responses:
+        '200':
+          description: Widgets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  type: string
+  /gadgets:
+    get:
+      operationId: getGadgets
+      responses:
+        '204':
+          description: No gadgets
+`
+
+func TestGoMethodName(t *testing.T) {
+	generateWithSpec := func(t *testing.T, spec string) (string, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+
+		return Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate: GenerateOptions{
+				ChiServer: true,
+				Strict:    true,
+				Client:    true,
+				Models:    true,
+			},
+		})
+	}
+
+	t.Run("overrides method names only", func(t *testing.T) {
+		code, err := generateWithSpec(t, goMethodNameSpec)
+		require.NoError(t, err)
+
+		// Types are still named after the operationId
+		assert.Contains(t, code, "type GetWidgetsCollectionParams struct {")
+		assert.Contains(t, code, "type GetWidgetsCollectionResponse struct {")
+		assert.Contains(t, code, "type GetWidgetsCollectionRequestObject struct {")
+
+		// Methods use the override
+		assert.Contains(t, code, "ListWidgets(w http.ResponseWriter, r *http.Request, params GetWidgetsCollectionParams)")
+		assert.Contains(t, code, "ListWidgets(ctx context.Context, request GetWidgetsCollectionRequestObject) (GetWidgetsCollectionResponseObject, error)")
+		assert.Contains(t, code, "func (c *Client) ListWidgets(ctx context.Context, params *GetWidgetsCollectionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {")
+		assert.Contains(t, code, "func (c *ClientWithResponses) ListWidgetsWithResponse(ctx context.Context, params *GetWidgetsCollectionParams, reqEditors ...RequestEditorFn) (*GetWidgetsCollectionResponse, error) {")
+		assert.Contains(t, code, "func NewListWidgetsRequest(server string, params *GetWidgetsCollectionParams) (*http.Request, error) {")
+		assert.Contains(t, code, "wrapper.ListWidgets)")
+		assert.NotContains(t, code, "GetWidgetsCollection(")
+
+		// Operations without the extension are unaffected
+		assert.Contains(t, code, "GetGadgets(w http.ResponseWriter, r *http.Request)")
+
+		checkLint(t, "test.gen.go", []byte(code))
+	})
+
+	t.Run("conflicting method names", func(t *testing.T) {
+		spec := strings.Replace(goMethodNameSpec, "x-go-method-name: listWidgets", "x-go-method-name: getGadgets", 1)
+		_, err := generateWithSpec(t, spec)
+		assert.ErrorContains(t, err, "operations GET /gadgets and GET /widgets both have the method name GetGadgets")
+	})
+}
+
+const omitEmptySpec = `
+openapi: 3.0.1
+info:
+  title: omitempty
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Owner:
+      type: object
+      properties:
+        name:
+          type: string
+    Pet:
+      type: object
+      required: [id, kind]
+      properties:
+        id:
+          type: integer
+        kind:
+          type: string
+          x-omitempty: true
+        name:
+          type: string
+        born:
+          type: string
+          format: date-time
+        tags:
+          type: array
+          items:
+            type: string
+        labels:
+          type: object
+          additionalProperties:
+            type: string
+        owner:
+          $ref: '#/components/schemas/Owner'
+        notes:
+          type: string
+          x-omitempty: false
+`
+
+func TestOmitEmptyPolicy(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(omitEmptySpec))
+	require.NoError(t, err)
+
+	generate := func(t *testing.T, policy string) string {
+		code, err := Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate: GenerateOptions{
+				Models: true,
+			},
+			OutputOptions: OutputOptions{
+				SkipPrune:       true,
+				OmitEmptyPolicy: policy,
+			},
+		})
+		require.NoError(t, err)
+		checkLint(t, "test.gen.go", []byte(code))
+		return code
+	}
+
+	t.Run("always", func(t *testing.T) {
+		code := generate(t, "")
+		assert.Contains(t, code, "Name   *string            `json:\"name,omitempty\"`")
+		assert.Contains(t, code, "Tags   *[]string          `json:\"tags,omitempty\"`")
+		assert.Contains(t, code, "Labels *map[string]string `json:\"labels,omitempty\"`")
+		assert.Contains(t, code, "Owner  *Owner             `json:\"owner,omitempty\"`")
+		// x-omitempty overrides the defaults either way
+		assert.Contains(t, code, "Kind   string             `json:\"kind,omitempty\"`")
+		assert.Contains(t, code, "Notes  *string            `json:\"notes\"`")
+		assert.Contains(t, code, "Id     int                `json:\"id\"`")
+	})
+
+	t.Run("scalars-only", func(t *testing.T) {
+		code := generate(t, OmitEmptyScalarsOnly)
+		assert.Contains(t, code, "Name   *string            `json:\"name,omitempty\"`")
+		assert.Contains(t, code, "Born   *time.Time         `json:\"born,omitempty\"`")
+		assert.Contains(t, code, "Tags   *[]string          `json:\"tags\"`")
+		assert.Contains(t, code, "Labels *map[string]string `json:\"labels\"`")
+		assert.Contains(t, code, "Owner  *Owner             `json:\"owner\"`")
+		assert.Contains(t, code, "Kind   string             `json:\"kind,omitempty\"`")
+	})
+
+	t.Run("never", func(t *testing.T) {
+		code := generate(t, OmitEmptyNever)
+		// Only the x-omitempty override is left
+		assert.Equal(t, 1, strings.Count(code, "omitempty"))
+		assert.Contains(t, code, "Name   *string            `json:\"name\"`")
+		assert.Contains(t, code, "Born   *time.Time         `json:\"born\"`")
+		assert.Contains(t, code, "Kind   string             `json:\"kind,omitempty\"`")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := GenerateFromSpec(swagger, Configuration{
+			PackageName:   "api",
+			Generate:      GenerateOptions{Models: true},
+			OutputOptions: OutputOptions{OmitEmptyPolicy: "sometimes"},
+		})
+		assert.ErrorContains(t, err, `unknown omit-empty-policy "sometimes"`)
+	})
+}
+
+const serversSpec = `
+openapi: 3.0.1
+info:
+  title: Servers
+  version: 1.0.0
+servers:
+  - url: https://api.example.com/v1
+    description: Production
+  - url: https://{region}.example.com/{version}
+    description: |-
+      Regional servers.
+      */ Pick the nearest region.
+    variables:
+      region:
+        default: eu
+        enum: [eu, us]
+      version:
+        default: v1
+paths:
+  /files:
+    servers:
+      - url: https://uploads.example.com
+    post:
+      operationId: uploadFile
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+      responses:
+        '204':
+          description: Uploaded
+    get:
+      operationId: listFiles
+      servers:
+        - url: /v2
+      responses:
+        '204':
+          description: Listed
+  /ping:
+    get:
+      operationId: ping
+      responses:
+        '204':
+          description: Pong
+`
+
+func TestServers(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(serversSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Client: true,
+		},
+	})
+	require.NoError(t, err)
+
+	// Every server gets a function, which rejects variables it doesn't have
+	assert.Contains(t, code, `// ServerURL0 returns the URL of server 0 in the spec, "https://api.example.com/v1".
+// The server has no variables, so vars must be empty.
+//
+// Production
+func ServerURL0(vars map[string]string) (string, error) {
+	return runtime.ServerURL("https://api.example.com/v1", vars, nil)
+}`)
+	// Multi-line descriptions are commented line by line
+	assert.Contains(t, code, `//
+// Regional servers.
+// */ Pick the nearest region.
+func ServerURL1(vars map[string]string) (string, error) {
+	return runtime.ServerURL("https://{region}.example.com/{version}", vars, map[string]runtime.ServerVariable{
+		"region":  {Default: "eu", Enum: []string{"eu", "us"}},
+		"version": {Default: "v1"},
+	})
+}`)
+	assert.NotContains(t, code, "const ServerURL")
+	assert.Contains(t, code, `var serverURLs = []string{
+	"https://api.example.com/v1",
+	"https://eu.example.com/v1",
+}`)
+	assert.Contains(t, code, "func WithBaseURLIndex(i int) ClientOption {")
+
+	// Servers on operations override those on paths, which override the spec's
+	assert.Contains(t, code, `const UploadFileServerURL = "https://uploads.example.com"`)
+	assert.Contains(t, code, `const ListFilesServerURL = "/v2"`)
+	assert.Contains(t, code, "server, err := runtime.ResolveServerURL(c.Server, UploadFileServerURL)")
+	assert.NotContains(t, code, "PingServerURL")
+	assert.Contains(t, code, "req, err := NewPingRequest(c.Server)")
+
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+func TestValidationMiddleware(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(uuidFormatSpec))
+	require.NoError(t, err)
+
+	generate := func(t *testing.T, generateOptions GenerateOptions) (string, error) {
+		generateOptions.EmbeddedSpec = true
+		generateOptions.ValidationMiddleware = true
+		code, err := Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate:    generateOptions,
+		})
+		if err == nil {
+			checkLint(t, "test.gen.go", []byte(code))
+		}
+		return code, err
+	}
+
+	t.Run("chi", func(t *testing.T) {
+		code, err := generate(t, GenerateOptions{ChiServer: true})
+		require.NoError(t, err)
+		assert.Contains(t, code, "func NewValidationMiddleware(formatter ValidationErrorFormatter) (func(http.Handler) http.Handler, error) {")
+		assert.Contains(t, code, "func validateSpecRequest(router routers.Router, r *http.Request) error {")
+	})
+
+	t.Run("echo", func(t *testing.T) {
+		code, err := generate(t, GenerateOptions{EchoServer: true})
+		require.NoError(t, err)
+		assert.Contains(t, code, "func NewValidationMiddleware(formatter ValidationErrorFormatter) (echo.MiddlewareFunc, error) {")
+	})
+
+	t.Run("gin", func(t *testing.T) {
+		code, err := generate(t, GenerateOptions{GinServer: true})
+		require.NoError(t, err)
+		assert.Contains(t, code, "func NewValidationMiddleware(formatter ValidationErrorFormatter) (gin.HandlerFunc, error) {")
+	})
+
+	t.Run("no server", func(t *testing.T) {
+		_, err := generate(t, GenerateOptions{Client: true})
+		assert.ErrorContains(t, err, "validation middleware can only be generated along with a chi, echo, gin or gorilla server")
+	})
+
+	t.Run("no embedded spec", func(t *testing.T) {
+		opts := Configuration{
+			PackageName: "api",
+			Generate: GenerateOptions{
+				ChiServer:            true,
+				ValidationMiddleware: true,
+			},
+		}
+		assert.EqualError(t, opts.Validate(), "validation-middleware requires embedded-spec")
+		_, err := Generate(swagger, opts)
+		assert.EqualError(t, err, "validation-middleware requires embedded-spec")
+	})
+}
+
+const healthEndpointsSpec = `
+openapi: 3.0.1
+info:
+  title: Health endpoints
+  version: 1.0.0
+paths:
+  /readyz:
+    get:
+      operationId: getReadiness
+      responses:
+        '204':
+          description: Ready
+`
+
+func TestHealthEndpoints(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(healthEndpointsSpec))
+	require.NoError(t, err)
+
+	generate := func(t *testing.T, generateOptions GenerateOptions) (string, error) {
+		generateOptions.HealthEndpoints = true
+		code, err := Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate:    generateOptions,
+		})
+		if err == nil {
+			checkLint(t, "test.gen.go", []byte(code))
+		}
+		return code, err
+	}
+
+	// The spec's own /readyz is left alone
+	t.Run("chi", func(t *testing.T) {
+		code, err := generate(t, GenerateOptions{ChiServer: true})
+		require.NoError(t, err)
+		assert.Contains(t, code, "func RegisterHealthHandlers(r chi.Router, checks HealthChecks) {\n\tr.Get(\"/healthz\", healthHandler(checks.Liveness))\n}")
+		assert.Contains(t, code, "func healthHandler(checks []HealthCheck) http.HandlerFunc {")
+	})
+
+	t.Run("echo", func(t *testing.T) {
+		code, err := generate(t, GenerateOptions{EchoServer: true})
+		require.NoError(t, err)
+		assert.Contains(t, code, "router.GET(\"/healthz\", echo.WrapHandler(healthHandler(checks.Liveness)))")
+		assert.NotContains(t, code, "checks.Readiness")
+	})
+
+	t.Run("gin", func(t *testing.T) {
+		code, err := generate(t, GenerateOptions{GinServer: true})
+		require.NoError(t, err)
+		assert.Contains(t, code, "router.GET(\"/healthz\", gin.WrapF(healthHandler(checks.Liveness)))")
+	})
+
+	t.Run("gorilla", func(t *testing.T) {
+		code, err := generate(t, GenerateOptions{GorillaServer: true})
+		require.NoError(t, err)
+		assert.Contains(t, code, "r.HandleFunc(\"/healthz\", healthHandler(checks.Liveness)).Methods(\"GET\")")
+	})
+
+	t.Run("no server", func(t *testing.T) {
+		_, err := generate(t, GenerateOptions{Models: true})
+		assert.ErrorContains(t, err, "health endpoints can only be generated along with a chi, echo, gin or gorilla server")
+	})
+}
+
+func TestMockServer(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(healthEndpointsSpec))
+	require.NoError(t, err)
+
+	generate := func(t *testing.T, generateOptions GenerateOptions) (string, error) {
+		generateOptions.Models = true
+		generateOptions.MockServer = true
+		code, err := Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate:    generateOptions,
+		})
+		if err == nil {
+			checkLint(t, "test.gen.go", []byte(code))
+		}
+		return code, err
+	}
+
+	for name, generateOptions := range map[string]GenerateOptions{
+		"chi":     {ChiServer: true},
+		"echo":    {EchoServer: true},
+		"gin":     {GinServer: true},
+		"gorilla": {GorillaServer: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			code, err := generate(t, generateOptions)
+			require.NoError(t, err)
+			assert.Contains(t, code, `"GetReadiness": {statusCode: 204},`)
+			assert.Contains(t, code, "func RegisterMockHandlers(")
+		})
+	}
+
+	t.Run("no server", func(t *testing.T) {
+		_, err := generate(t, GenerateOptions{})
+		assert.ErrorContains(t, err, "mock server can only be generated along with a chi, echo, gin or gorilla server")
+	})
+}
+
+const multipleBodiesSpec = `
+openapi: 3.0.1
+info:
+  title: Multiple request bodies
+  version: 1.0.0
+paths:
+  /notes:
+    post:
+      operationId: createNote
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                text:
+                  type: string
+          text/plain:
+            schema:
+              type: string
+      responses:
+        '204':
+          description: Created
+`
+
+func TestClientWithResponsesInterface(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(multipleBodiesSpec))
+	require.NoError(t, err)
+
+	cfg := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Client: true,
+			Models: true,
+		},
+	}
+	code, err := Generate(swagger, cfg)
+	require.NoError(t, err)
+
+	// Every method of ClientWithResponses is in the interface, including
+	// the ones for each request body content type
+	assert.Contains(t, code, "type ClientWithResponsesInterface interface {")
+	assert.Contains(t, code, "CreateNoteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNoteResponse, error)")
+	assert.Contains(t, code, "CreateNoteWithResponse(ctx context.Context, body CreateNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateNoteResponse, error)")
+	assert.Contains(t, code, "CreateNoteWithTextBodyWithResponse(ctx context.Context, body CreateNoteTextRequestBody, reqEditors ...RequestEditorFn) (*CreateNoteResponse, error)")
+	assert.Contains(t, code, "var _ ClientWithResponsesInterface = (*ClientWithResponses)(nil)")
+	checkLint(t, "test.gen.go", []byte(code))
+
+	cfg.OutputOptions.SkipClientWithResponsesInterface = true
+	code, err = Generate(swagger, cfg)
+	require.NoError(t, err)
+	assert.NotContains(t, code, "ClientWithResponsesInterface")
+	assert.Contains(t, code, "func (c *ClientWithResponses) CreateNoteWithTextBodyWithResponse(")
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+const binaryBodySpec = `
+openapi: 3.0.1
+info:
+  title: Binary request bodies
+  version: 1.0.0
+paths:
+  /files:
+    put:
+      operationId: uploadFile
+      requestBody:
+        required: true
+        content:
+          application/octet-stream:
+            schema:
+              type: string
+              format: binary
+      responses:
+        '204':
+          description: Uploaded
+  /notes:
+    post:
+      operationId: createNote
+      requestBody:
+        content:
+          application/octet-stream:
+            schema:
+              type: string
+              format: binary
+          text/plain:
+            schema:
+              type: string
+      responses:
+        '204':
+          description: Created
+`
+
+func TestBinaryRequestBody(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(binaryBodySpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			ChiServer: true,
+			Strict:    true,
+			Client:    true,
+			Models:    true,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "type UploadFileBinaryRequestBody = io.Reader")
+	assert.Contains(t, code, "UploadFileWithBinaryBody(ctx context.Context, body UploadFileBinaryRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)")
+	assert.Contains(t, code, `return NewUploadFileRequestWithBody(server, "application/octet-stream", bodyReader)`)
+
+	// Strict handlers get the body to read themselves
+	assert.Contains(t, code, `type UploadFileRequestObject struct {
+	Body io.Reader
+}`)
+	// A binary body is in Body alongside other bodies, like any other reader
+	assert.Contains(t, code, `type CreateNoteRequestObject struct {
+	ContentType string
+	Body        io.Reader
+	TextBody    *CreateNoteTextRequestBody
+}`)
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+const resourcePatternSpec = `
+openapi: 3.0.1
+info:
+  title: Resource names
+  version: 1.0.0
+paths:
+  /v1/{name=shelves/*/books/*}:
+    get:
+      operationId: getBook
+      parameters:
+        - name: name
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '204':
+          description: Found
+`
+
+func TestResourcePatterns(t *testing.T) {
+	generateWithSpec := func(t *testing.T, spec string, generateOptions GenerateOptions) (string, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+
+		generateOptions.Client = true
+		return Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate:    generateOptions,
+		})
+	}
+
+	t.Run("gorilla", func(t *testing.T) {
+		code, err := generateWithSpec(t, resourcePatternSpec, GenerateOptions{GorillaServer: true})
+		require.NoError(t, err)
+		assert.Contains(t, code, `r.Handle(options.BaseURL+"/v1/{name:shelves/[^/]+/books/[^/]+}", `)
+		assert.Contains(t, code, `pathParam0, err = runtime.ResourceName("name", "shelves/*/books/*", name)`)
+		checkLint(t, "test.gen.go", []byte(code))
+	})
+
+	t.Run("echo", func(t *testing.T) {
+		_, err := generateWithSpec(t, resourcePatternSpec, GenerateOptions{EchoServer: true})
+		assert.EqualError(t, err, "path parameter name of GET /v1/{name=shelves/*/books/*} has a resource name pattern, which echo servers don't support")
+	})
+
+	t.Run("not a string", func(t *testing.T) {
+		spec := strings.Replace(resourcePatternSpec, "type: string", "type: integer", 1)
+		_, err := generateWithSpec(t, spec, GenerateOptions{ChiServer: true})
+		assert.ErrorContains(t, err, "path parameter name in '/v1/{name=shelves/*/books/*}' has a resource name pattern, so must be a string")
+	})
+
+	t.Run("** in the middle", func(t *testing.T) {
+		spec := strings.Replace(resourcePatternSpec, "{name=shelves/*/books/*}", "{name=shelves/**}/books", 1)
+		_, err := generateWithSpec(t, spec, GenerateOptions{ChiServer: true})
+		assert.ErrorContains(t, err, "resource name pattern shelves/** in '/v1/{name=shelves/**}/books' can only have ** at the end of the path")
+	})
+}
+
+const webSocketSpec = `
+openapi: 3.0.1
+info:
+  title: WebSockets
+  version: 1.0.0
+paths:
+  /rooms/{room}:
+    get:
+      operationId: joinRoom
+      x-websocket: true
+      parameters:
+        - name: room
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '101':
+          description: Switching protocols
+`
+
+func TestWebSocketOperations(t *testing.T) {
+	generateWithSpec := func(t *testing.T, spec string) (string, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+
+		return Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate: GenerateOptions{
+				ChiServer: true,
+				Strict:    true,
+				Models:    true,
+			},
+		})
+	}
+
+	t.Run("strict handlers get the raw request", func(t *testing.T) {
+		code, err := generateWithSpec(t, webSocketSpec)
+		require.NoError(t, err)
+		assert.Contains(t, code, "JoinRoom(ctx context.Context, w http.ResponseWriter, r *http.Request, request JoinRoomRequestObject) error")
+		checkLint(t, "test.gen.go", []byte(code))
+	})
+
+	t.Run("only GET can be upgraded", func(t *testing.T) {
+		spec := strings.Replace(webSocketSpec, "    get:", "    post:", 1)
+		_, err := generateWithSpec(t, spec)
+		assert.ErrorContains(t, err, "POST /rooms/{room} can't be a WebSocket operation")
+	})
+
+	t.Run("no request body", func(t *testing.T) {
+		spec := strings.Replace(webSocketSpec, "      responses:", `      requestBody:
+        content:
+          text/plain:
+            schema:
+              type: string
+      responses:`, 1)
+		_, err := generateWithSpec(t, spec)
+		assert.ErrorContains(t, err, "WebSocket operation GET /rooms/{room} can't have a request body")
+	})
+
+	t.Run("invalid extension", func(t *testing.T) {
+		spec := strings.Replace(webSocketSpec, "x-websocket: true", "x-websocket: yes please", 1)
+		_, err := generateWithSpec(t, spec)
+		assert.ErrorContains(t, err, `invalid value for "x-websocket" on GET /rooms/{room}`)
+	})
+}
+
+const readWriteOnlySpec = `
+openapi: 3.0.1
+info:
+  title: Read and write only properties
+  version: 1.0.0
+paths:
+  /pets:
+    post:
+      operationId: createPet
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        '201':
+          description: Created
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [id, name]
+      properties:
+        id:
+          type: integer
+          readOnly: true
+        name:
+          type: string
+        password:
+          type: string
+          writeOnly: true
+`
+
+func TestSplitReadWriteOnly(t *testing.T) {
+	generateWithSplit := func(t *testing.T, split bool) string {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(readWriteOnlySpec))
+		require.NoError(t, err)
+
+		code, err := Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate: GenerateOptions{
+				ChiServer: true,
+				Strict:    true,
+				Client:    true,
+				Models:    true,
+			},
+			OutputOptions: OutputOptions{
+				SplitReadWriteOnly: split,
+			},
+		})
+		require.NoError(t, err)
+		return code
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		code := generateWithSplit(t, false)
+		assert.Contains(t, code, "type CreatePetJSONRequestBody = Pet")
+		assert.Contains(t, code, "JSON201      *Pet")
+		assert.Contains(t, code, "type CreatePet201JSONResponse Pet")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		code := generateWithSplit(t, true)
+
+		// The request body leaves out the readOnly id
+		assert.Contains(t, code, `type CreatePetJSONBody struct {
+	Name     string  `+"`json:\"name\"`"+`
+	Password *string `+"`json:\"password,omitempty\"`"+`
+}`)
+		assert.Contains(t, code, "type CreatePetJSONRequestBody CreatePetJSONBody")
+
+		// The response body leaves out the writeOnly password
+		assert.Contains(t, code, `type CreatePet201JSONResponseBody struct {
+	Id   *int   `+"`json:\"id,omitempty\"`"+`
+	Name string `+"`json:\"name\"`"+`
+}`)
+		assert.Contains(t, code, "JSON201      *CreatePet201JSONResponseBody")
+		assert.Contains(t, code, "type CreatePet201JSONResponse = CreatePet201JSONResponseBody")
+
+		// The component schema is unchanged
+		assert.Contains(t, code, "type Pet struct {\n\tId       *int    `json:\"id,omitempty\"`")
+
+		checkLint(t, "test.gen.go", []byte(code))
+	})
+}
+
+const allOfOverlapSpec = `
+openapi: 3.0.1
+info:
+  title: Overlapping allOf schemas
+  version: 1.0.0
+paths:
+  /pet:
+    get:
+      operationId: getPet
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Named:
+      type: object
+      required: [name]
+      properties:
+        id:
+          type: string
+        name:
+          type: string
+    Tagged:
+      type: object
+      required: [id]
+      properties:
+        id:
+          type: string
+        tag:
+          type: string
+    Counted:
+      type: object
+      properties:
+        id:
+          type: integer
+    Pet:
+      allOf:
+        - $ref: '#/components/schemas/Named'
+        - $ref: '#/components/schemas/Tagged'
+`
+
+func TestAllOfPropertyConflicts(t *testing.T) {
+	generateWithSpec := func(t *testing.T, spec string) (string, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+
+		return Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate: GenerateOptions{
+				Models: true,
+			},
+		})
+	}
+
+	t.Run("identical properties are merged", func(t *testing.T) {
+		code, err := generateWithSpec(t, allOfOverlapSpec)
+		require.NoError(t, err)
+
+		// id is required, since Tagged requires it
+		assert.Contains(t, code, `type Pet struct {
+	Id   string  `+"`json:\"id\"`"+`
+	Name string  `+"`json:\"name\"`"+`
+	Tag  *string `+"`json:\"tag,omitempty\"`"+`
+}`)
+
+		checkLint(t, "test.gen.go", []byte(code))
+	})
+
+	t.Run("conflicting properties", func(t *testing.T) {
+		spec := strings.Replace(allOfOverlapSpec, "- $ref: '#/components/schemas/Tagged'", "- $ref: '#/components/schemas/Counted'", 1)
+		_, err := generateWithSpec(t, spec)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "property 'id' is string in Named but int in Counted")
+	})
+}
+
+const uuidFormatSpec = `
+openapi: 3.0.1
+info:
+  title: UUIDs
+  version: 1.0.0
+paths:
+  /widgets/{id}:
+    put:
+      operationId: putWidget
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+            format: uuid
+        - name: related
+          in: query
+          schema:
+            type: array
+            items:
+              type: string
+              format: uuid
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Widget'
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  type: string
+                  format: uuid
+components:
+  schemas:
+    Widget:
+      type: object
+      required: [id]
+      properties:
+        id:
+          type: string
+          format: uuid
+        parent:
+          type: string
+          format: uuid
+        children:
+          type: array
+          items:
+            type: string
+            format: uuid
+`
+
+func TestUUIDFormat(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(uuidFormatSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			ChiServer: true,
+			Client:    true,
+			Models:    true,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, `openapi_types "github.com/deepmap/oapi-codegen/pkg/types"`)
+
+	// Schemas, including optional fields and arrays
+	assert.Contains(t, code, `type Widget struct {
+	Children *[]openapi_types.UUID `+"`json:\"children,omitempty\"`"+`
+	Id       openapi_types.UUID    `+"`json:\"id\"`"+`
+	Parent   *openapi_types.UUID   `+"`json:\"parent,omitempty\"`"+`
+}`)
+
+	// Parameters
+	assert.Contains(t, code, "Related *[]openapi_types.UUID `form:\"related,omitempty\" json:\"related,omitempty\"`")
+	assert.Contains(t, code, "PutWidget(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutWidgetParams)")
+
+	// Responses
+	assert.Contains(t, code, "JSON200      *[]openapi_types.UUID")
+
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+const dateFormatSpec = `
+openapi: 3.0.1
+info:
+  title: Dates
+  version: 1.0.0
+paths:
+  /events/{day}:
+    put:
+      operationId: putEvent
+      parameters:
+        - name: day
+          in: path
+          required: true
+          schema:
+            type: string
+            format: date
+        - name: since
+          in: query
+          schema:
+            type: string
+            format: date
+        - name: holidays
+          in: query
+          schema:
+            type: array
+            items:
+              type: string
+              format: date
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Event'
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  type: string
+                  format: date
+components:
+  schemas:
+    Event:
+      type: object
+      required: [day]
+      properties:
+        day:
+          type: string
+          format: date
+        until:
+          type: string
+          format: date
+        exceptions:
+          type: array
+          items:
+            type: string
+            format: date
+`
+
+func TestDateFormat(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(dateFormatSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			ChiServer: true,
+			Client:    true,
+			Models:    true,
+		},
+	})
+	require.NoError(t, err)
+
+	// Dates never degrade to time.Time
+	assert.NotContains(t, code, "time.Time")
+
+	// Schemas, including optional fields and arrays
+	assert.Contains(t, code, `type Event struct {
+	Day        openapi_types.Date    `+"`json:\"day\"`"+`
+	Exceptions *[]openapi_types.Date `+"`json:\"exceptions,omitempty\"`"+`
+	Until      *openapi_types.Date   `+"`json:\"until,omitempty\"`"+`
+}`)
+
+	// Parameters
+	assert.Contains(t, code, "Since    *openapi_types.Date   `form:\"since,omitempty\" json:\"since,omitempty\"`")
+	assert.Contains(t, code, "Holidays *[]openapi_types.Date `form:\"holidays,omitempty\" json:\"holidays,omitempty\"`")
+	assert.Contains(t, code, "PutEvent(w http.ResponseWriter, r *http.Request, day openapi_types.Date, params PutEventParams)")
+
+	// Responses
+	assert.Contains(t, code, "JSON200      *[]openapi_types.Date")
+
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+const typeMappingsSpec = `
+openapi: 3.0.1
+info:
+  title: Type mappings
+  version: 1.0.0
+paths:
+  /prices:
+    get:
+      operationId: getPrices
+      parameters:
+        - name: min
+          in: query
+          schema:
+            type: number
+            format: decimal
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Price'
+components:
+  schemas:
+    Price:
+      type: object
+      required: [amount]
+      properties:
+        amount:
+          type: number
+          format: decimal
+        history:
+          type: array
+          items:
+            type: string
+            format: decimal
+        updated:
+          type: string
+          format: date-time
+        code:
+          type: string
+          format: currency
+`
+
+func TestTypeMappings(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(typeMappingsSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Client: true,
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			TypeMappings: map[string]string{
+				"decimal":   "github.com/shopspring/decimal.Decimal",
+				"date-time": "example.com/go-timeutil/v2.Time",
+				"duration":  "example.com/unused.Duration",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Imports are added for the mappings which are used
+	assert.Contains(t, code, `"github.com/shopspring/decimal"`)
+	assert.Contains(t, code, `gotimeutil "example.com/go-timeutil/v2"`)
+	assert.NotContains(t, code, `"example.com/unused"`)
+
+	// Unmapped formats keep their default type
+	assert.Contains(t, code, `type Price struct {
+	Amount  decimal.Decimal    `+"`json:\"amount\"`"+`
+	Code    *string            `+"`json:\"code,omitempty\"`"+`
+	History *[]decimal.Decimal `+"`json:\"history,omitempty\"`"+`
+	Updated *gotimeutil.Time   `+"`json:\"updated,omitempty\"`"+`
+}`)
+	assert.Contains(t, code, "Min *decimal.Decimal `form:\"min,omitempty\" json:\"min,omitempty\"`")
+
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
 func TestGoTypeImport(t *testing.T) {
 	packageName := "api"
 	opts := Configuration{
-		PackageName: packageName,
+		PackageName: packageName,
+		Generate: GenerateOptions{
+			EchoServer:   true,
+			Models:       true,
+			EmbeddedSpec: true,
+		},
+	}
+	spec := "test_specs/x-go-type-import-pet.yaml"
+	swagger, err := util.LoadSwagger(spec)
+	require.NoError(t, err)
+
+	// Run our code generation:
+	code, err := Generate(swagger, opts)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, code)
+
+	// Check that we have valid (formattable) code:
+	_, err = format.Source([]byte(code))
+	assert.NoError(t, err)
+
+	imports := []string{
+		`github.com/CavernaTechnologies/pgext`, // schemas - direct object
+		`myuuid "github.com/google/uuid"`,      // schemas - object
+		`github.com/lib/pq`,                    // schemas - array
+		`github.com/spf13/viper`,               // responses - direct object
+		`golang.org/x/text`,                    // responses - complex object
+		`golang.org/x/email`,                   // requestBodies - in components
+		`github.com/fatih/color`,               // parameters - query
+		`github.com/go-openapi/swag`,           // parameters - path
+		`github.com/jackc/pgtype`,              // direct parameters - path
+		`github.com/mailru/easyjson`,           // direct parameters - query
+		`github.com/subosito/gotenv`,           // direct request body
+	}
+
+	// Check import
+	for _, imp := range imports {
+		assert.Contains(t, code, imp)
+	}
+
+	// Make sure the generated code is valid:
+	checkLint(t, "test.gen.go", []byte(code))
+
+}
+
+func TestOpenAPI31NullableTypeArrays(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+	}
+	spec := "test_specs/openapi-3.1-nullable-types.yaml"
+	swagger, err := util.LoadSwagger(spec)
+	require.NoError(t, err)
+
+	// Run our code generation:
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	// Check that we have valid (formattable) code:
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	// Members of a type array collapse into the same Go types which
+	// `nullable: true` would produce.
+	assert.Contains(t, code, "Count  *int    `json:\"count\"`")
+	assert.Contains(t, code, "Label  *string `json:\"label,omitempty\"`")
+	assert.Contains(t, code, "Name   *string `json:\"name\"`")
+	assert.Contains(t, code, "Nested *struct {")
+	assert.Contains(t, code, "Tags *[]string `json:\"tags\"`")
+
+	// Make sure the generated code is valid:
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+func TestAdditionalPropertiesTypes(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Client: true,
+			Strict: true,
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			SkipPrune: true,
+		},
+	}
+	spec := "test_specs/additional-properties.yaml"
+	swagger, err := util.LoadSwagger(spec)
+	require.NoError(t, err)
+
+	// Run our code generation:
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	// Check that we have valid (formattable) code:
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	// Objects with only additionalProperties are maps of the typed value
+	assert.Contains(t, code, "type IntMap map[string]int")
+	assert.Contains(t, code, "type ThingMap map[string]Thing")
+	assert.Contains(t, code, "JSON200      *map[string]int")
+
+	// and get the helpers for additional properties
+	assert.Contains(t, code, "func (im IntMap) Get(fieldName string) (value int, found bool) {")
+	assert.Contains(t, code, "func (tm *ThingMap) Set(fieldName string, value Thing) {")
+
+	// Inline request bodies keep their JSON marshaling
+	assert.Contains(t, code, "type PostThingsJSONRequestBody = PostThingsJSONBody")
+	assert.Contains(t, code, "func (ptjb PostThingsJSONBody) MarshalJSON() ([]byte, error) {")
+
+	// Inline response objects with properties are named types, so additional
+	// properties are unmarshaled too
+	assert.Contains(t, code, "JSON202      *PostThings202JSONResponseBody")
+	assert.Contains(t, code, "type PostThings202JSONResponse = PostThings202JSONResponseBody")
+	assert.Contains(t, code, "func (ptjrb *PostThings202JSONResponseBody) UnmarshalJSON(b []byte) error {")
+	assert.Contains(t, code, "*PostThings202JSONResponseBody_Nested `json:\"nested,omitempty\"`")
+	assert.Contains(t, code, "func (ptjrbn *PostThings202JSONResponseBody_Nested) UnmarshalJSON(b []byte) error {")
+
+	// Make sure the generated code is valid:
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+func TestRemoteExternalReference(t *testing.T) {
+	packageName := "api"
+	opts := Configuration{
+		PackageName: packageName,
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		ImportMapping: map[string]string{
+			remoteRefFile: remoteRefImport,
+		},
+	}
+	spec := "test_specs/remote-external-reference.yaml"
+	swagger, err := util.LoadSwagger(spec)
+	require.NoError(t, err)
+
+	// Run our code generation:
+	code, err := Generate(swagger, opts)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, code)
+
+	// Check that we have valid (formattable) code:
+	_, err = format.Source([]byte(code))
+	assert.NoError(t, err)
+
+	// Check that we have a package:
+	assert.Contains(t, code, "package api")
+
+	// Check import
+	assert.Contains(t, code, `externalRef0 "github.com/deepmap/oapi-codegen/examples/petstore-expanded"`)
+
+	// Check generated oneOf structure:
+	assert.Contains(t, code, `
+// ExampleSchema_Item defines model for ExampleSchema.Item.
+type ExampleSchema_Item struct {
+	union json.RawMessage
+}
+`)
+
+	// Check generated oneOf structure As method:
+	assert.Contains(t, code, `
+// AsExternalRef0NewPet returns the union data inside the ExampleSchema_Item as a externalRef0.NewPet
+func (esi ExampleSchema_Item) AsExternalRef0NewPet() (externalRef0.NewPet, error) {
+`)
+
+	// Check generated oneOf structure From method:
+	assert.Contains(t, code, `
+// FromExternalRef0NewPet overwrites any union data inside the ExampleSchema_Item as the provided externalRef0.NewPet
+func (esi *ExampleSchema_Item) FromExternalRef0NewPet(v externalRef0.NewPet) error {
+`)
+
+	// Check generated oneOf structure Merge method:
+	assert.Contains(t, code, `
+// FromExternalRef0NewPet overwrites any union data inside the ExampleSchema_Item as the provided externalRef0.NewPet
+func (esi *ExampleSchema_Item) FromExternalRef0NewPet(v externalRef0.NewPet) error {
+`)
+
+	// Make sure the generated code is valid:
+	checkLint(t, "test.gen.go", []byte(code))
+
+}
+
+//go:embed test_spec.yaml
+var testOpenAPIDefinition string
+
+const unsupportedPathStyleSpec = `
+openapi: 3.0.1
+info:
+  title: Path styles
+  version: 1.0.0
+paths:
+  /things/{id}:
+    get:
+      operationId: getThing
+      parameters:
+        - name: id
+          in: path
+          required: true
+          style: form
+          schema:
+            type: string
+      responses:
+        '200':
+          description: OK
+`
+
+func TestUnsupportedPathParameterStyle(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(unsupportedPathStyleSpec))
+	require.NoError(t, err)
+
+	_, err = Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			ChiServer: true,
+			Client:    true,
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "path parameter id has style form, but path parameters can only have the simple, label or matrix styles")
+}
+
+const interfacePerTagSpec = `
+openapi: 3.0.1
+info:
+  title: Interface per tag
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      tags: [pets, animals]
+      responses:
+        '200':
+          description: OK
+  /stores:
+    get:
+      operationId: listStores
+      tags: [stores]
+      responses:
+        '200':
+          description: OK
+  /health:
+    get:
+      operationId: getHealth
+      responses:
+        '200':
+          description: OK
+`
+
+func TestInterfacePerTag(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(interfacePerTagSpec))
+	require.NoError(t, err)
+
+	for _, generate := range []GenerateOptions{
+		{ChiServer: true},
+		{EchoServer: true},
+		{GinServer: true},
+		{GorillaServer: true},
+	} {
+		code, err := Generate(swagger, Configuration{
+			PackageName:   "api",
+			Generate:      generate,
+			OutputOptions: OutputOptions{InterfacePerTag: true},
+		})
+		require.NoError(t, err)
+
+		assert.Contains(t, code, "type ServerInterfacePets interface {")
+		assert.Contains(t, code, "type ServerInterfaceStores interface {")
+		assert.Contains(t, code, "// ServerInterfaceDefault represents the server handlers of the operations without tags.\ntype ServerInterfaceDefault interface {")
+		assert.NotContains(t, code, "ServerInterfaceAnimals")
+		assert.Contains(t, code, `type ServerInterface interface {
+	ServerInterfaceDefault
+	ServerInterfacePets
+	ServerInterfaceStores
+}`)
+		checkLint(t, "test.gen.go", []byte(code))
+	}
+
+	// Tags mustn't share an interface with the operations without tags, or
+	// with each other, or name one after the wrapper of the ServerInterface
+	for tag, want := range map[string]string{
+		"default": `the operations tagged "default" and those without tags would both be in ServerInterfaceDefault`,
+		"Pets":    `the operations tagged "pets" and "Pets" would both be in ServerInterfacePets`,
+		"wrapper": `the operations tagged "wrapper" would be in ServerInterfaceWrapper, which wraps the ServerInterface`,
+	} {
+		spec := strings.Replace(interfacePerTagSpec, "tags: [stores]", "tags: ["+tag+"]", 1)
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+		_, err = Generate(swagger, Configuration{
+			PackageName:   "api",
+			Generate:      GenerateOptions{ChiServer: true},
+			OutputOptions: OutputOptions{InterfacePerTag: true},
+		})
+		assert.ErrorContains(t, err, want)
+	}
+}
+
+const objectQueryParamsSpec = `
+openapi: 3.0.1
+info:
+  title: Object query parameters
+  version: 1.0.0
+paths:
+  /things:
+    get:
+      operationId: listThings
+      parameters:
+        - name: page
+          in: query
+          schema:
+            type: object
+            properties:
+              offset:
+                type: integer
+                format: uint32
+              at:
+                type: string
+                format: date-time
+        - name: tags
+          in: query
+          style: deepObject
+          explode: true
+          schema:
+            type: object
+            properties:
+              names:
+                type: array
+                items:
+                  type: string
+      responses:
+        '200':
+          description: OK
+`
+
+func TestObjectQueryParams(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(objectQueryParamsSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Client: true,
+			Models: true,
+		},
+	})
+	require.NoError(t, err)
+
+	// Exploded form objects add their fields one by one
+	assert.Contains(t, code, `queryValues.Add("offset", strconv.FormatUint(uint64(*params.Page.Offset), 10))`)
+	assert.Contains(t, code, `queryValues.Add("at", (*params.Page.At).Format(time.RFC3339Nano))`)
+
+	// Arrays in deepObjects are left to the runtime
+	assert.Contains(t, code, `runtime.StyleParamWithLocation("deepObject", true, "tags", runtime.ParamLocationQuery, *params.Tags)`)
+
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+func TestRecursiveDeepObjectQueryParam(t *testing.T) {
+	const spec = `
+openapi: 3.0.1
+info:
+  title: Recursive deepObject
+  version: 1.0.0
+paths:
+  /things:
+    get:
+      operationId: listThings
+      parameters:
+        - name: filter
+          in: query
+          style: deepObject
+          explode: true
+          schema:
+            $ref: '#/components/schemas/Filter'
+      responses:
+        '200':
+          description: OK
+components:
+  schemas:
+    Filter:
+      type: object
+      properties:
+        name:
+          type: string
+        not:
+          $ref: '#/components/schemas/Filter'
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	_, err = Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Client: true,
+			Models: true,
+		},
+	})
+	assert.EqualError(t, err, "error creating operation definitions: error generating ListThings: query parameter filter: the deepObject filter[not] holds Filter, which holds itself")
+}
+
+const goTypeImportsSpec = `
+openapi: 3.0.1
+info:
+  title: Go type imports
+  version: 1.0.0
+paths:
+  /jobs:
+    get:
+      operationId: listJobs
+      parameters:
+        - name: X-Timeout
+          in: header
+          schema:
+            type: string
+            x-go-type: time.Duration
+            x-go-type-import: time
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                allOf:
+                  - $ref: '#/components/schemas/Job'
+                  - type: object
+                    properties:
+                      owner:
+                        type: string
+                        x-go-type: github.com/google/uuid.UUID
+components:
+  schemas:
+    Job:
+      type: object
+      properties:
+        timeouts:
+          type: object
+          additionalProperties:
+            type: string
+            x-go-type: time.Duration
+            x-go-type-import:
+              path: time
+`
+
+func TestGoTypeImports(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(goTypeImportsSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Client: true,
+			Models: true,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "Timeouts *map[string]time.Duration")
+	assert.Contains(t, code, "XTimeout *time.Duration")
+	assert.Regexp(t, `Owner +\*uuid\.UUID`, code)
+
+	// Imports are added for types wherever they are, and only once each
+	imports := code[strings.Index(code, "import ("):]
+	imports = imports[:strings.Index(imports, "\n)\n")+1]
+	assert.Equal(t, 1, strings.Count(imports, "\t\"time\"\n"))
+	assert.Equal(t, 1, strings.Count(imports, "\t\"github.com/google/uuid\"\n"))
+	checkLint(t, "test.gen.go", []byte(code))
+
+	// Additional imports aren't repeated, unless they have other names
+	assert.Equal(t, []AdditionalImport{{Alias: "gouuid", Package: "github.com/google/uuid"}},
+		dedupeAdditionalImports([]AdditionalImport{
+			{Package: "github.com/google/uuid"},
+			{Alias: "gouuid", Package: "github.com/google/uuid"},
+			{Alias: "gouuid", Package: "github.com/google/uuid"},
+		}, []string{`"github.com/google/uuid"`}))
+}
+
+const ptrHelpersSpec = `
+openapi: 3.0.1
+info:
+  title: Pointer helpers
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+    Ptr:
+      type: string
+`
+
+func TestPtrHelpers(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(ptrHelpersSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			SkipPrune:          true,
+			GeneratePtrHelpers: true,
+		},
+	}
+
+	// The default name is taken by a schema
+	_, err = Generate(swagger, opts)
+	require.EqualError(t, err, "error generating type definitions: the pointer helper Ptr has the same name as a generated type, set ptr-helper-name to another name")
+
+	opts.OutputOptions.PtrHelperName = "ToPtr"
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(code, "func ToPtr[T any](v T) *T {"))
+	checkLint(t, "test.gen.go", []byte(code))
+
+	opts.OutputOptions.PtrHelperName = "type"
+	assert.EqualError(t, opts.Validate(), `ptr-helper-name "type" isn't a valid Go identifier`)
+}
+
+const recursiveSchemasSpec = `
+openapi: 3.0.1
+info:
+  title: Recursive schemas
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Node:
+      type: object
+      required: [value, children, parent, meta]
+      properties:
+        value:
+          type: string
+        children:
+          type: array
+          items:
+            $ref: '#/components/schemas/Node'
+        parent:
+          $ref: '#/components/schemas/Node'
+        meta:
+          type: object
+          required: [origin]
+          properties:
+            origin:
+              $ref: '#/components/schemas/Node'
+    Husband:
+      type: object
+      required: [wife]
+      properties:
+        wife:
+          $ref: '#/components/schemas/Wife'
+    Wife:
+      type: object
+      required: [husband, name]
+      properties:
+        husband:
+          $ref: '#/components/schemas/Husband'
+        name:
+          $ref: '#/components/schemas/Name'
+    Name:
+      type: object
+      required: [first]
+      properties:
+        first:
+          type: string
+`
+
+func TestRecursiveSchemas(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(recursiveSchemasSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			SkipPrune: true,
+		},
+	}
+
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	// Direct self references are pointers, apart from through arrays
+	assert.Regexp(t, `Children \[\]Node +`+"`json:\"children\"`", code)
+	assert.Regexp(t, `Parent +\*Node +`+"`json:\"parent\"`", code)
+	assert.Regexp(t, `Origin \*Node +`+"`json:\"origin\"`", code)
+
+	// Both sides of a cycle are pointers, but other required properties aren't
+	assert.Regexp(t, `Wife \*Wife +`+"`json:\"wife\"`", code)
+	assert.Regexp(t, `Husband \*Husband +`+"`json:\"husband\"`", code)
+	assert.Regexp(t, `Name +Name +`+"`json:\"name\"`", code)
+
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+const defaultsForOptionalSpec = `
+openapi: 3.0.1
+info:
+  title: Defaults
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Order:
+      type: string
+      enum: [asc, desc]
+      default: asc
+    Query:
+      type: object
+      required: [term]
+      properties:
+        term:
+          type: string
+        kind:
+          type: string
+          default: all
+        limit:
+          type: integer
+          default: 20
+        offset:
+          type: integer
+          default: 0
+        ratio:
+          type: number
+          default: 0.5
+        exact:
+          type: boolean
+          default: true
+        order:
+          $ref: '#/components/schemas/Order'
+        since:
+          type: string
+          format: date
+          default: "2020-01-01"
+        nullable:
+          type: string
+          nullable: true
+          default: none
+    Node:
+      type: object
+      properties:
+        children:
+          type: array
+          items:
+            $ref: '#/components/schemas/Node'
+        parent:
+          $ref: '#/components/schemas/Node'
+        order:
+          $ref: '#/components/schemas/Order'
+`
+
+func TestUseDefaultsForOptional(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(defaultsForOptionalSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			SkipPrune:              true,
+			UseDefaultsForOptional: true,
+		},
+	}
+
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	// Strings, numbers and booleans with defaults are values, which are only
+	// left out when empty if the default is the zero value
+	assert.Regexp(t, `Kind +string +`+"`json:\"kind\"`", code)
+	assert.Regexp(t, `Limit +int +`+"`json:\"limit\"`", code)
+	assert.Regexp(t, `Offset +int +`+"`json:\"offset,omitempty\"`", code)
+	assert.Regexp(t, `Ratio +float32 +`+"`json:\"ratio\"`", code)
+	assert.Regexp(t, `Exact +bool +`+"`json:\"exact\"`", code)
+	assert.Regexp(t, `Order +Order +`+"`json:\"order\"`", code)
+
+	// Others are still pointers
+	assert.Regexp(t, `Since +\*openapi_types.Date +`+"`json:\"since,omitempty\"`", code)
+	assert.Regexp(t, `Nullable +\*string +`+"`json:\"nullable\"`", code)
+
+	assert.Contains(t, code, `func (q *Query) Defaults() {
+	q.Exact = true
+	q.Kind = "all"
+	q.Limit = 20
+	q.Offset = 0
+	q.Order = "asc"
+	q.Ratio = 0.5
+}`)
+	// Recursive schemas get defaults too
+	assert.Regexp(t, `Parent +\*Node +`+"`json:\"parent,omitempty\"`", code)
+	assert.Contains(t, code, `func (n *Node) Defaults() {
+	n.Order = "asc"
+}`)
+	checkLint(t, "test.gen.go", []byte(code))
+
+	opts.OutputOptions.UseDefaultsForOptional = false
+	code, err = Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Regexp(t, `Kind +\*string +`+"`json:\"kind,omitempty\"`", code)
+	assert.NotContains(t, code, "Defaults()")
+}
+
+const specHandlerSpec = `
+openapi: 3.0.1
+info:
+  title: Spec handler
+  version: 1.0.0
+paths:
+  /ping:
+    get:
+      operationId: ping
+      responses:
+        '204':
+          description: Pong
+`
+
+func TestSpecHandlerRequiresEmbeddedSpec(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(specHandlerSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:      true,
+			SpecHandler: true,
+		},
+	}
+	assert.EqualError(t, opts.Validate(), "spec-handler requires embedded-spec")
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, "spec-handler requires embedded-spec")
+
+	opts.Generate.EmbeddedSpec = true
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, "func SpecHandler() http.HandlerFunc {")
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+const operationExtensionsSpec = `
+openapi: 3.0.1
+info:
+  title: Operation extensions
+  version: 1.0.0
+paths:
+  /ping:
+    get:
+      operationId: ping
+      x-rate-limit:
+        requests: 10
+        per: second
+      x-internal: true
+      responses:
+        '204':
+          description: Pong
+  /pong:
+    get:
+      operationId: pong
+      responses:
+        '204':
+          description: Ping
+`
+
+func TestOperationExtensions(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(operationExtensionsSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			ChiServer: true,
+		},
+		OutputOptions: OutputOptions{
+			GenerateOperationExtensions: true,
+		},
+	}
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, `var OperationExtensions = map[string]map[string]json.RawMessage{
+	"Ping": {
+		"x-internal":   json.RawMessage("true"),
+		"x-rate-limit": json.RawMessage("{\"per\":\"second\",\"requests\":10}"),
+	},
+}`)
+	assert.NotContains(t, code, `"Pong"`)
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+const paramCommentsSpec = `
+openapi: 3.0.1
+info:
+  title: Param comments
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: findPets
+      parameters:
+        - name: limit
+          in: query
+          description: |
+            maximum number of results to return, which the server may lower when it's busy.
+
+            Defaults to:
+            - 20 for anonymous users
+            - 100 otherwise
+          schema:
+            type: integer
+      responses:
+        '204':
+          description: Found
+`
+
+func TestParamsFieldComments(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(paramCommentsSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{
+			CommentWidth: 60,
+		},
+	}
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	checkLint(t, "test.gen.go", []byte(code))
+	assert.Contains(t, code, `type FindPetsParams struct {
+	// Limit maximum number of results to return, which the
+	// server may lower when it's busy.
+	//
+	// Defaults to:
+	// - 20 for anonymous users
+	// - 100 otherwise
+	Limit *int `+"`"+`form:"limit,omitempty" json:"limit,omitempty"`+"`"+`
+}`)
+}
+
+func TestDateTimeFormatValidation(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(paramCommentsSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{
+			DateTimeFormat: "YYYY-MM-DD",
+		},
+	}
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, `date-time-format "YYYY-MM-DD" has no date or time elements, such as 2006 or 15:04`)
+	assert.EqualError(t, opts.Validate(), `date-time-format "YYYY-MM-DD" has no date or time elements, such as 2006 or 15:04`)
+
+	opts.OutputOptions.DateTimeFormat = "2006-01-02 15:04:05"
+	assert.NoError(t, opts.Validate())
+}
+
+const visitorsSpec = `
+openapi: 3.0.1
+info:
+  title: Visitors
+  version: 1.0.0
+paths:
+  /nodes:
+    post:
+      operationId: addNode
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                node:
+                  $ref: '#/components/schemas/Node'
+      responses:
+        '204':
+          description: Added
+components:
+  schemas:
+    Node:
+      type: object
+      properties:
+        label:
+          type: string
+        owner:
+          nullable: true
+          allOf:
+            - $ref: '#/components/schemas/Owner'
+        children:
+          nullable: true
+          type: array
+          items:
+            $ref: '#/components/schemas/Node'
+    Owner:
+      type: object
+      properties:
+        name:
+          type: string
+`
+
+func TestVisitors(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(visitorsSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{
+			GenerateVisitors: true,
+			NullableType:     true,
+		},
+	}
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	checkLint(t, "test.gen.go", []byte(code))
+
+	assert.Contains(t, code, `type Visitor interface {
+	VisitNode(v *Node) bool
+	VisitOwner(v *Owner) bool
+	VisitAddNodeJSONBody(v *AddNodeJSONBody) bool
+}`)
+	// Nullable fields are visited when they're set to a value
+	assert.Contains(t, code, `func (n *Node) Visit(v Visitor) {
+	if n == nil || !v.VisitNode(n) {
+		return
+	}
+	if n.Children.Set && !n.Children.Null {
+		for i0 := range n.Children.Value {
+			n.Children.Value[i0].Visit(v)
+		}
+	}
+	if n.Owner.Set && !n.Owner.Null {
+		n.Owner.Value.Visit(v)
+	}
+}`)
+	assert.Contains(t, code, `func (anjb *AddNodeJSONBody) Visit(v Visitor) {
+	if anjb == nil || !v.VisitAddNodeJSONBody(anjb) {
+		return
+	}
+	anjb.Node.Visit(v)
+}`)
+}
+
+func TestEqualFieldConflict(t *testing.T) {
+	const spec = `
+openapi: "3.0.1"
+info:
+  version: 1.0.0
+  title: Equal
+paths: {}
+components:
+  schemas:
+    Range:
+      type: object
+      properties:
+        equal:
+          type: boolean
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{GenerateEqual: true, SkipPrune: true},
+	}
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, `error generating type definitions: error generating Equal methods: the Equal method generated for Range has the same name as its field for property "equal"`)
+}
+
+const serverInterfaceSpec = `
+openapi: "3.0.1"
+info:
+  version: 1.0.0
+  title: Server interface
+paths:
+  /pets/{id}:
+    put:
+      operationId: updatePet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+        - name: mode
+          in: query
+          schema:
+            type: string
+            enum: [merge, replace]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        "204":
+          description: Updated
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+`
+
+func TestServerInterfaceOnly(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(serverInterfaceSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{ServerInterfaceOnly: true},
+	}
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	checkLint(t, "test.gen.go", []byte(code))
+
+	assert.Contains(t, code, `type ServerInterface interface {
+
+	// (PUT /pets/{id})
+	UpdatePet(w http.ResponseWriter, r *http.Request, id int, params UpdatePetParams)
+}`)
+	// The types of the operations come along, but not the models
+	assert.Contains(t, code, "type UpdatePetParams struct {")
+	assert.Contains(t, code, "Merge   UpdatePetParamsMode = \"merge\"")
+	assert.Contains(t, code, "type UpdatePetJSONRequestBody = Pet")
+	assert.NotContains(t, code, "type Pet struct")
+	// There are no wrappers
+	assert.NotContains(t, code, "ServerInterfaceWrapper")
+	assert.NotContains(t, code, "func Handler")
+
+	// With the models, and the strict server's interface
+	opts.Generate.Models = true
+	opts.Generate.Strict = true
+	code, err = Generate(swagger, opts)
+	require.NoError(t, err)
+	checkLint(t, "test.gen.go", []byte(code))
+
+	assert.Contains(t, code, "type Pet struct")
+	assert.Equal(t, 1, strings.Count(code, "type UpdatePetParams struct {"))
+	assert.Contains(t, code, "type StrictServerInterface interface {")
+	assert.Contains(t, code, "type UpdatePetRequestObject struct {")
+	assert.NotContains(t, code, "NewStrictHandler")
+
+	// The wrappers of a framework come with a ServerInterface of their own
+	opts.Generate.ChiServer = true
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, "server-interface can't be generated along with a chi, echo, gin or gorilla server, which has a ServerInterface of its own")
+	assert.EqualError(t, opts.Validate(), "server-interface can't be generated along with a chi, echo, gin or gorilla server, which has a ServerInterface of its own")
+}
+
+func TestGinBindingTagsRequiresGinServer(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(specHandlerSpec))
+	require.NoError(t, err)
+
+	// The models of other servers aren't given tags which nothing checks
+	opts := Configuration{
+		PackageName: "api",
 		Generate: GenerateOptions{
-			EchoServer:   true,
-			Models:       true,
-			EmbeddedSpec: true,
+			Models:    true,
+			ChiServer: true,
+		},
+		OutputOptions: OutputOptions{
+			GinBindingTags: true,
 		},
 	}
-	spec := "test_specs/x-go-type-import-pet.yaml"
-	swagger, err := util.LoadSwagger(spec)
+	assert.EqualError(t, opts.Validate(), "gin-binding-tags requires gin-server")
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, "gin-binding-tags requires gin-server")
+
+	opts.Generate.ChiServer = false
+	opts.Generate.GinServer = true
+	code, err := Generate(swagger, opts)
 	require.NoError(t, err)
+	checkLint(t, "test.gen.go", []byte(code))
+}
 
-	// Run our code generation:
+func TestModelsPackage(t *testing.T) {
+	const spec = `
+openapi: 3.0.1
+info:
+  title: Models package
+  version: 1.0.0
+paths:
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+      responses:
+        '200':
+          description: The pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+			Client: true,
+		},
+		OutputOptions: OutputOptions{
+			ModelsPackage: "github.com/acme/models/v2",
+		},
+	}
+	const msg = "models-package can't be used with models, which it says are generated in another package"
+	assert.EqualError(t, opts.Validate(), msg)
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, msg)
+
+	opts.OutputOptions.ModelsPackage = "github.com/acme/2fa"
+	opts.Generate.Models = false
+	assert.EqualError(t, opts.Validate(), `invalid models-package: can't derive a package name from "github.com/acme/2fa"`)
+
+	opts.OutputOptions.ModelsPackage = "github.com/acme/models/v2"
+	require.NoError(t, opts.Validate())
 	code, err := Generate(swagger, opts)
-	assert.NoError(t, err)
-	assert.NotEmpty(t, code)
+	require.NoError(t, err)
+	assert.Contains(t, code, `"github.com/acme/models/v2"`)
+	assert.NotContains(t, code, "type Pet ")
+	assert.Contains(t, code, "JSON200      *models.Pet")
+	checkLint(t, "test.gen.go", []byte(code))
+}
 
-	// Check that we have valid (formattable) code:
-	_, err = format.Source([]byte(code))
-	assert.NoError(t, err)
+func TestDeprecatedOperations(t *testing.T) {
+	const spec = `
+openapi: 3.0.1
+info:
+  title: Deprecated operations
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      summary: Lists the pets
+      deprecated: true
+      description: Use listAnimals instead.
+      responses:
+        '200':
+          description: The pets
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+    post:
+      operationId: addPet
+      deprecated: true
+      description: Adds a pet
+      x-deprecated-reason: Pets are added with addAnimal.
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        '204':
+          description: Added
+  /animals:
+    get:
+      operationId: listAnimals
+      description: Lists the animals
+      responses:
+        '204':
+          description: The animals
+    delete:
+      operationId: removeAnimals
+      deprecated: true
+      responses:
+        '204':
+          description: Removed
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        tag:
+          type: string
+          deprecated: true
+          description: The pet's tag
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
 
-	imports := []string{
-		`github.com/CavernaTechnologies/pgext`, // schemas - direct object
-		`myuuid "github.com/google/uuid"`,      // schemas - object
-		`github.com/lib/pq`,                    // schemas - array
-		`github.com/spf13/viper`,               // responses - direct object
-		`golang.org/x/text`,                    // responses - complex object
-		`golang.org/x/email`,                   // requestBodies - in components
-		`github.com/fatih/color`,               // parameters - query
-		`github.com/go-openapi/swag`,           // parameters - path
-		`github.com/jackc/pgtype`,              // direct parameters - path
-		`github.com/mailru/easyjson`,           // direct parameters - query
-		`github.com/subosito/gotenv`,           // direct request body
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:    true,
+			Client:    true,
+			ChiServer: true,
+			Strict:    true,
+		},
 	}
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	checkLint(t, "test.gen.go", []byte(code))
 
-	// Check import
-	for _, imp := range imports {
-		assert.Contains(t, code, imp)
+	// The reason is a paragraph of its own, which Go tooling recognizes
+	assert.Contains(t, code, `	// Tag The pet's tag
+	//
+	// Deprecated: this property is deprecated.
+	Tag *string`)
+	assert.Contains(t, code, `	// ListPets request
+	//
+	// Deprecated: Use listAnimals instead.
+	ListPets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)`)
+	assert.Contains(t, code, `	// Deprecated: Pets are added with addAnimal.
+	AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)`)
+	assert.Contains(t, code, `// Deprecated: Use listAnimals instead.
+func (c *Client) ListPets(`)
+	assert.Contains(t, code, `// Deprecated: Pets are added with addAnimal.
+func (c *Client) AddPet(`)
+	assert.Contains(t, code, `// ListPetsWithResponse request returning *ListPetsResponse
+//
+// Deprecated: Use listAnimals instead.
+func (c *ClientWithResponses) ListPetsWithResponse(`)
+	assert.Contains(t, code, `	// Lists the pets
+	// (GET /pets)
+	//
+	// Deprecated: Use listAnimals instead.
+	ListPets(w http.ResponseWriter, r *http.Request)`)
+	assert.Contains(t, code, `	// (GET /pets)
+	//
+	// Deprecated: Use listAnimals instead.
+	ListPets(ctx context.Context, request ListPetsRequestObject) (ListPetsResponseObject, error)`)
+	assert.NotContains(t, code, "Lists the animals")
+
+	// Without a reason, the comment still says what's deprecated
+	assert.Contains(t, code, `// Deprecated: this operation is deprecated.
+func (c *Client) RemoveAnimals(`)
+}
+
+func TestQueryBuildersRequireClient(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(specHandlerSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:    true,
+			ChiServer: true,
+		},
+		OutputOptions: OutputOptions{
+			GenerateQueryBuilders: true,
+		},
 	}
+	assert.EqualError(t, opts.Validate(), "generate-query-builders requires client")
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, "generate-query-builders requires client")
+}
 
-	// Make sure the generated code is valid:
-	checkLint(t, "test.gen.go", []byte(code))
+func TestInProcessClient(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(specHandlerSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:    true,
+			ChiServer: true,
+		},
+		OutputOptions: OutputOptions{
+			GenerateInProcessClient: true,
+		},
+	}
+	assert.EqualError(t, opts.Validate(), "generate-in-process-client requires client")
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, "generate-in-process-client requires client")
 
+	opts.Generate.Client = true
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, "func NewInProcessClient(handler http.Handler, opts ...ClientOption) (*ClientWithResponses, error) {")
+	assert.Contains(t, code, `"net/http/httptest"`)
+	checkLint(t, "test.gen.go", []byte(code))
 }
 
-func TestRemoteExternalReference(t *testing.T) {
-	packageName := "api"
+func TestPaginators(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Paginators
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - name: cursor
+          in: query
+          schema:
+            type: string
+      responses:
+        200:
+          description: A page of pets
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  next:
+                    type: integer
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
 	opts := Configuration{
-		PackageName: packageName,
+		PackageName: "api",
 		Generate: GenerateOptions{
 			Models: true,
 		},
-		ImportMapping: map[string]string{
-			remoteRefFile: remoteRefImport,
+		OutputOptions: OutputOptions{
+			Paginators: []PaginationPattern{{CursorParam: "cursor"}},
 		},
 	}
-	spec := "test_specs/remote-external-reference.yaml"
-	swagger, err := util.LoadSwagger(spec)
+	assert.EqualError(t, opts.Validate(), "paginators requires client")
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, "paginators requires client")
+
+	opts.Generate.Client = true
+	assert.EqualError(t, opts.Validate(), "paginator 0 needs both cursor-param and next-cursor-field")
+
+	// The cursor has to be of the same type in requests and responses
+	opts.OutputOptions.Paginators[0].NextCursorField = "next"
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, "error generating paginators: the cursor parameter of ListPets has type string, but the next property of its response has type int")
+
+	// Operations which don't follow a pattern don't get a paginator
+	opts.OutputOptions.Paginators[0].NextCursorField = "next_cursor"
+	code, err := Generate(swagger, opts)
 	require.NoError(t, err)
+	assert.NotContains(t, code, "Paginator")
 
-	// Run our code generation:
+	opts.OutputOptions.Paginators = append(opts.OutputOptions.Paginators, PaginationPattern{CursorParam: "cursor", NextCursorField: "next"})
+	swagger.Paths["/pets"].Get.Parameters[0].Value.Schema.Value.Type = "integer"
+	code, err = Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, "func (c *ClientWithResponses) ListPetsPaginator(params *ListPetsParams, reqEditors ...RequestEditorFn) *ListPetsPaginator {")
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+func TestUnexportedTypes(t *testing.T) {
+	const spec = `
+openapi: 3.0.1
+info:
+  title: Unexported types
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - name: kind
+          in: query
+          schema:
+            $ref: '#/components/schemas/Kind'
+      responses:
+        '200':
+          description: The pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+        default:
+          description: An error
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Error'
+components:
+  schemas:
+    Kind:
+      type: string
+      enum: [cat, dog]
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        kind:
+          $ref: '#/components/schemas/Kind'
+    Error:
+      type: object
+      properties:
+        message:
+          type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:    true,
+			Client:    true,
+			ChiServer: true,
+			Strict:    true,
+		},
+		OutputOptions: OutputOptions{
+			UnexportedTypes:      true,
+			GenerateConstructors: true,
+			ModelsPackage:        "github.com/acme/api/models",
+		},
+	}
+	opts.Generate.Models = false
+	assert.EqualError(t, opts.Validate(), "unexported-types can't be used with models-package, whose types would have to be exported")
+
+	opts.Generate.Models = true
+	opts.OutputOptions.ModelsPackage = ""
 	code, err := Generate(swagger, opts)
-	assert.NoError(t, err)
-	assert.NotEmpty(t, code)
+	require.NoError(t, err)
 
-	// Check that we have valid (formattable) code:
-	_, err = format.Source([]byte(code))
-	assert.NoError(t, err)
+	// Types, and their doc comments, constants and functions are unexported
+	assert.Contains(t, code, "// pet defines model for pet.\ntype pet struct {")
+	assert.Contains(t, code, "cat kind = \"cat\"")
+	assert.Contains(t, code, "func newPet(name string) pet {")
+	assert.Contains(t, code, "func newClient(server string, opts ...clientOption) (*client, error) {")
+	assert.Contains(t, code, "type listPets200JSONResponse []pet")
+	// with a suffix if they'd become keywords or predeclared identifiers
+	assert.Contains(t, code, "type errorType struct {")
+	assert.Contains(t, code, "JSONDefault  *errorType")
+	// and so are their mentions in comments, but not those of other packages
+	assert.Contains(t, code, "// Creates a new client, with reasonable defaults")
+	assert.Contains(t, code, "// listPetsParams defines parameters for ListPets.")
+	assert.NotRegexp(t, `//.*[^.\w](Pet|Client|NewClient|ServerInterface)\b`, code)
+	assert.Contains(t, code, "// The standard http.Client implements this interface.")
+	assert.Contains(t, code, "// Error returns an error describing the response")
 
-	// Check that we have a package:
-	assert.Contains(t, code, "package api")
+	// Methods, fields and JSON tags are kept
+	assert.Regexp(t, `Kind +\*kind +`+"`json:\"kind,omitempty\"`", code)
+	assert.Contains(t, code, "func (c *clientWithResponses) ListPetsWithResponse(ctx context.Context, params *listPetsParams, reqEditors ...requestEditorFn) (*listPetsResponse, error) {")
+	assert.Contains(t, code, "func (response listPets200JSONResponse) VisitListPetsResponse(w http.ResponseWriter) error {")
+	assert.NotRegexp(t, `(?m)^(type|func) [A-Z]`, code)
 
-	// Check import
-	assert.Contains(t, code, `externalRef0 "github.com/deepmap/oapi-codegen/examples/petstore-expanded"`)
+	// Unexported names can't clash with those of other generated code
+	swagger.Components.Schemas["OperationIDContextKey"] = swagger.Components.Schemas["Error"]
+	opts.OutputOptions.SkipPrune = true
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, "error unexporting types: can't unexport OperationIDContextKey, since operationIDContextKey is already declared")
+}
 
-	// Check generated oneOf structure:
-	assert.Contains(t, code, `
-// ExampleSchema_Item defines model for ExampleSchema.Item.
-type ExampleSchema_Item struct {
-	union json.RawMessage
+func TestLoggingMiddleware(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(specHandlerSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:     true,
+			EchoServer: true,
+		},
+		OutputOptions: OutputOptions{
+			LoggingMiddleware: true,
+		},
+	}
+	assert.EqualError(t, opts.Validate(), "logging-middleware requires chi-server or gorilla-server")
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, "logging-middleware requires chi-server or gorilla-server")
+
+	opts.Generate.EchoServer = false
+	opts.Generate.GorillaServer = true
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, "func LoggingMiddleware(logger *slog.Logger) func(http.Handler) http.Handler {")
+	assert.Contains(t, code, `withOperationMiddlewares(recordOperationID("Ping", wrapper.Ping), options.OperationMiddlewares["Ping"])`)
+	assert.Contains(t, code, `"log/slog"`)
+	checkLint(t, "test.gen.go", []byte(code))
 }
-`)
 
-	// Check generated oneOf structure As method:
-	assert.Contains(t, code, `
-// AsExternalRef0NewPet returns the union data inside the ExampleSchema_Item as a externalRef0.NewPet
-func (t ExampleSchema_Item) AsExternalRef0NewPet() (externalRef0.NewPet, error) {
-`)
+func TestConflictingRoutes(t *testing.T) {
+	const spec = `
+openapi: 3.0.0
+info:
+  title: Conflicting routes
+  version: 1.0.0
+paths:
+  /pets/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '204':
+          description: No content
+  /pets/{petId}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: petId
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '204':
+          description: No content
+    delete:
+      operationId: deletePet
+      parameters:
+        - name: petId
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '204':
+          description: No content
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
 
-	// Check generated oneOf structure From method:
-	assert.Contains(t, code, `
-// FromExternalRef0NewPet overwrites any union data inside the ExampleSchema_Item as the provided externalRef0.NewPet
-func (t *ExampleSchema_Item) FromExternalRef0NewPet(v externalRef0.NewPet) error {
-`)
+	_, err = OperationDefinitions(swagger)
+	assert.EqualError(t, err, "operations GET /pets/{id} and GET /pets/{petId} have the same route, since their paths only differ by the names of their parameters")
 
-	// Check generated oneOf structure Merge method:
-	assert.Contains(t, code, `
-// FromExternalRef0NewPet overwrites any union data inside the ExampleSchema_Item as the provided externalRef0.NewPet
-func (t *ExampleSchema_Item) FromExternalRef0NewPet(v externalRef0.NewPet) error {
-`)
+	// Other methods of the same path don't conflict
+	swagger.Paths["/pets/{id}"].Get = nil
+	ops, err := OperationDefinitions(swagger)
+	require.NoError(t, err)
+	assert.Len(t, ops, 2)
+}
 
-	// Make sure the generated code is valid:
+func TestHeaderEditors(t *testing.T) {
+	const spec = `
+openapi: 3.0.0
+info:
+  title: Header editors
+  version: 1.0.0
+paths:
+  /ping:
+    get:
+      operationId: ping
+      parameters:
+        - $ref: '#/components/parameters/RequestId'
+        - $ref: '#/components/parameters/Verbose'
+      responses:
+        '204':
+          description: No content
+components:
+  parameters:
+    RequestId:
+      name: X-Request-Id
+      in: header
+      schema:
+        type: integer
+    Verbose:
+      name: verbose
+      in: query
+      schema:
+        type: boolean
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			HeaderEditors: true,
+		},
+	}
+	assert.EqualError(t, opts.Validate(), "header-editors requires client")
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, "header-editors requires client")
+
+	opts.Generate.Client = true
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, "func WithXRequestIdHeader(value RequestId) RequestEditorFn {")
+	assert.Contains(t, code, `runtime.StyleParamWithLocation("simple", false, "X-Request-Id", runtime.ParamLocationHeader, value)`)
+	assert.NotContains(t, code, "func WithVerboseHeader(")
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+func TestUnionMethodOrder(t *testing.T) {
+	const spec = `
+openapi: 3.0.0
+info:
+  title: Union method order
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Shape:
+      oneOf:
+        - $ref: '#/components/schemas/Square'
+        - $ref: '#/components/schemas/Circle'
+        - $ref: '#/components/schemas/Rectangle'
+    Circle:
+      type: object
+      properties:
+        radius:
+          type: number
+    Rectangle:
+      type: object
+      properties:
+        width:
+          type: number
+    Square:
+      type: object
+      properties:
+        side:
+          type: number
+`
+	generate := func(spec string) string {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+		code, err := Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate: GenerateOptions{
+				Models: true,
+			},
+			OutputOptions: OutputOptions{
+				SkipPrune: true,
+			},
+		})
+		require.NoError(t, err)
+		return code
+	}
+
+	code := generate(spec)
+	assert.Equal(t, code, generate(spec))
 	checkLint(t, "test.gen.go", []byte(code))
 
+	// The methods follow the names of the elements rather than their order
+	circle := strings.Index(code, "func (s Shape) AsCircle()")
+	rectangle := strings.Index(code, "func (s Shape) AsRectangle()")
+	square := strings.Index(code, "func (s Shape) AsSquare()")
+	require.True(t, circle != -1 && rectangle != -1 && square != -1)
+	assert.True(t, circle < rectangle && rectangle < square)
+
+	reordered := strings.Replace(spec, `
+        - $ref: '#/components/schemas/Square'
+        - $ref: '#/components/schemas/Circle'`, `
+        - $ref: '#/components/schemas/Circle'
+        - $ref: '#/components/schemas/Square'`, 1)
+	require.NotEqual(t, spec, reordered)
+	assert.Equal(t, code, generate(reordered))
 }
 
-//go:embed test_spec.yaml
-var testOpenAPIDefinition string
+func TestRedactSensitive(t *testing.T) {
+	const spec = `
+openapi: 3.0.0
+info:
+  title: Redact sensitive
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Login:
+      type: object
+      properties:
+        user:
+          type: string
+        password:
+          type: string
+          format: password
+          writeOnly: true
+    Token:
+      type: object
+      properties:
+        value:
+          type: string
+          x-go-sensitive: true
+        string:
+          type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			SkipPrune:       true,
+			RedactSensitive: true,
+		},
+	}
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, "error generating type definitions: error generating String methods redacting sensitive fields: Token has a String field, so it can't have a String method redacting its sensitive fields")
+
+	delete(swagger.Components.Schemas["Token"].Value.Properties, "string")
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, "func (l Login) String() string {")
+	assert.Contains(t, code, `b.WriteString("Password:***")`)
+	assert.Contains(t, code, "func (t Token) GoString() string {")
+	assert.Contains(t, code, "b.WriteString(`Value:\"***\"`)")
+	checkLint(t, "test.gen.go", []byte(code))
+
+	// JSON marshaling is unaffected
+	assert.NotContains(t, code, "MarshalJSON")
+}
+
+func TestReceiverCollisions(t *testing.T) {
+	const spec = `
+openapi: 3.0.0
+info:
+  title: Receiver collisions
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Blob:
+      type: object
+      properties:
+        name:
+          type: string
+      additionalProperties:
+        type: string
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+      additionalProperties:
+        type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			SkipPrune: true,
+		},
+	}
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	// UnmarshalJSON takes b, so the receiver of Blob's methods is renamed
+	assert.Contains(t, code, "func (bl *Blob) UnmarshalJSON(b []byte) error {")
+	assert.Contains(t, code, "func (bl Blob) MarshalJSON() ([]byte, error) {")
+	assert.NotContains(t, code, "func (b Blob)")
+	assert.Contains(t, code, "func (p *Pet) UnmarshalJSON(b []byte) error {")
+	checkLint(t, "test.gen.go", []byte(code))
+}
+
+func TestRejectUnknownFieldsDefinedTypes(t *testing.T) {
+	const spec = `
+openapi: 3.0.0
+info:
+  title: Reject unknown fields of defined types
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Card:
+      type: object
+      additionalProperties: false
+      properties:
+        number:
+          type: string
+    Note:
+      type: object
+      properties:
+        text:
+          type: string
+    SavedCard:
+      $ref: '#/components/schemas/Card'
+    SavedNote:
+      $ref: '#/components/schemas/Note'
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		Compatibility: CompatibilityOptions{
+			OldAliasing: true,
+		},
+		OutputOptions: OutputOptions{
+			SkipPrune:           true,
+			RejectUnknownFields: true,
+		},
+	})
+	require.NoError(t, err)
+	checkLint(t, "test.gen.go", []byte(code))
+
+	// Types defined as types which reject unknown fields reject them too,
+	// while those defined as other types have no UnmarshalJSON to call
+	assert.Contains(t, code, "return (*Card)(sc).UnmarshalJSON(b)")
+	assert.NotContains(t, code, "func (n *Note) UnmarshalJSON")
+	assert.NotContains(t, code, "func (sn *SavedNote) UnmarshalJSON")
+}
@@ -2,7 +2,39 @@ package codegen
 
 import (
 	"errors"
+	"fmt"
+	"go/build/constraint"
 	"reflect"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	typeNameRegex     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	majorVersionRegex = regexp.MustCompile(`^v[0-9]+$`)
+)
+
+// Supported values of OutputOptions.JSONTagStyle
+const (
+	JSONTagStyleSpec  = "spec"
+	JSONTagStyleCamel = "camel"
+	JSONTagStyleSnake = "snake"
+)
+
+// Supported values of OutputOptions.OmitEmptyPolicy
+const (
+	OmitEmptyAlways      = "always"
+	OmitEmptyScalarsOnly = "scalars-only"
+	OmitEmptyNever       = "never"
+)
+
+// Supported values of OutputOptions.NameNormalizer
+const (
+	NameNormalizerDefault              = "default"
+	NameNormalizerCamelCaseWithDigits  = "ToCamelCaseWithDigits"
+	NameNormalizerCamelCaseInitialisms = "ToCamelCaseWithInitialisms"
 )
 
 type AdditionalImport struct {
@@ -10,6 +42,14 @@ type AdditionalImport struct {
 	Package string `yaml:"package"`
 }
 
+// PaginationPattern says how the operations which follow it page through their
+// results: each request asks for a page with a query parameter, and each
+// response says which page comes next with a property of its JSON body.
+type PaginationPattern struct {
+	CursorParam     string `yaml:"cursor-param"`      // The query parameter asking for a page, such as cursor or page_token
+	NextCursorField string `yaml:"next-cursor-field"` // The property of the JSON body of the response holding the value of cursor-param for the next page, which is missing or empty on the last one
+}
+
 // Configuration defines code generation customizations
 type Configuration struct {
 	PackageName       string               `yaml:"package"` // PackageName to generate
@@ -30,6 +70,12 @@ type GenerateOptions struct {
 	Client        bool `yaml:"client,omitempty"`         // Client specifies whether to generate client boilerplate
 	Models        bool `yaml:"models,omitempty"`         // Models specifies whether to generate type definitions
 	EmbeddedSpec  bool `yaml:"embedded-spec,omitempty"`  // Whether to embed the swagger spec in the generated code
+	SpecHandler   bool `yaml:"spec-handler,omitempty"`   // Whether to generate an http.HandlerFunc serving the embedded spec, which requires embedded-spec
+
+	ValidationMiddleware bool `yaml:"validation-middleware,omitempty"` // Whether to generate middleware for the server which validates requests against the embedded spec, which requires embedded-spec
+	HealthEndpoints      bool `yaml:"health-endpoints,omitempty"`      // Whether to generate RegisterHealthHandlers, adding /healthz and /readyz endpoints which the spec doesn't have to the server
+	MockServer           bool `yaml:"mock-server,omitempty"`           // Whether to generate MockServer, a ServerInterface responding with the examples of the spec, and RegisterMockHandlers
+	ServerInterfaceOnly  bool `yaml:"server-interface,omitempty"`      // Whether to generate only the ServerInterface of a net/http server, and the strict server's interface with strict-server, without the wrappers of any framework
 }
 
 // CompatibilityOptions specifies backward compatibility settings for the
@@ -74,6 +120,11 @@ type CompatibilityOptions struct {
 	// This resolves the behavior such that middlewares are chained in the order they are invoked.
 	// Please see https://github.com/deepmap/oapi-codegen/issues/841
 	ApplyGorillaMiddlewareFirstToLast bool `yaml:"apply-gorilla-middleware-first-to-last,omitempty"`
+	// The embedded spec has its external references internalized when it's
+	// generated, but GetSwagger still sets up resolving them from the specs
+	// embedded in imported packages. Set DisableEmbeddedSpecExternalRefs to true
+	// to load the embedded spec on its own, without allowing external references.
+	DisableEmbeddedSpecExternalRefs bool `yaml:"disable-embedded-spec-external-refs,omitempty"`
 }
 
 // OutputOptions are used to modify the output code in some way.
@@ -84,9 +135,84 @@ type OutputOptions struct {
 	ExcludeTags   []string          `yaml:"exclude-tags,omitempty"`   // Exclude operations that have one of these tags. Ignored when empty.
 	UserTemplates map[string]string `yaml:"user-templates,omitempty"` // Override built-in templates from user-provided files
 
+	TagFilterExpression string `yaml:"tag-filter-expression,omitempty"` // Only include operations whose tags satisfy this expression, such as "public AND NOT beta", combining tags with AND, OR, NOT and parentheses. Takes precedence over include-tags and exclude-tags.
+
 	ExcludeSchemas     []string `yaml:"exclude-schemas,omitempty"`      // Exclude from generation schemas with given names. Ignored when empty.
 	ResponseTypeSuffix string   `yaml:"response-type-suffix,omitempty"` // The suffix used for responses types
 	ClientTypeName     string   `yaml:"client-type-name,omitempty"`     // Override the default generated client type with the value
+
+	IncludeOperations []string `yaml:"include-operations,omitempty"` // Only include operations with one of these operationIds. Ignored when empty.
+	ExcludeOperations []string `yaml:"exclude-operations,omitempty"` // Exclude operations with one of these operationIds. Ignored when empty.
+
+	JSONTagStyle string `yaml:"json-tag-style,omitempty"` // Casing of the names in generated JSON tags: "spec" (the default) keeps names from the spec, "camel" or "snake" rewrites them
+
+	SplitReadWriteOnly bool `yaml:"split-read-write-only,omitempty"` // Leave readOnly properties out of request body types and writeOnly properties out of response body types
+
+	GenerateEnumHelpers bool `yaml:"generate-enum-helpers,omitempty"` // Generate String methods and Parse functions for string and integer enum types
+
+	TypeMappings map[string]string `yaml:"type-mappings,omitempty"` // Go types to use for schemas with the given formats, as a package path and type name such as "github.com/shopspring/decimal.Decimal"
+
+	NullableType bool `yaml:"nullable-type,omitempty"` // Generate optional, nullable properties as Nullable[T], which tells apart null from a missing value
+
+	FileHeaderComment string   `yaml:"file-header-comment,omitempty"` // Comment, such as a copyright notice, to put at the top of generated files
+	BuildTags         []string `yaml:"build-tags,omitempty"`          // Build constraints for generated files, which must all be satisfied, such as "integration" or "linux || darwin"
+
+	GenerateConstructors bool `yaml:"generate-constructors,omitempty"` // Generate a NewTypeName function taking the required fields of each struct type which has any
+
+	SkipClientWithResponsesInterface bool `yaml:"skip-client-with-responses-interface,omitempty"` // Don't generate ClientWithResponsesInterface, which ClientWithResponses otherwise implements
+
+	GenerateValidators bool `yaml:"generate-validators,omitempty"` // Generate a Validate method checking the constraints of each struct type's properties
+	GenerateVisitors   bool `yaml:"generate-visitors,omitempty"`   // Generate a Visitor interface, with a method for each struct type, and Visit methods walking the values of struct types a value holds without reflection
+	GenerateEqual      bool `yaml:"generate-equal,omitempty"`      // Generate an Equal method for each struct type, comparing what pointers point to, and the elements of slices and maps
+
+	RejectUnknownFields bool `yaml:"reject-unknown-fields,omitempty"` // Generate an UnmarshalJSON method rejecting unknown properties for each struct type whose schema sets additionalProperties to false
+
+	RedactSensitive bool `yaml:"redact-sensitive,omitempty"` // Generate String and GoString methods printing the sensitive fields as "***" for each struct type with properties marked with x-go-sensitive, or which are writeOnly with format password, so that they don't leak into logs. JSON marshaling is unaffected
+
+	OmitEmptyPolicy string `yaml:"omit-empty-policy,omitempty"` // Which optional fields get omitempty in their JSON tags: "always" (the default), "scalars-only" to leave it off arrays and objects, or "never"
+
+	NameNormalizer string `yaml:"name-normalizer,omitempty"` // How names in the spec become Go identifiers: "default", "ToCamelCaseWithDigits" to start a new word after digits, or "ToCamelCaseWithInitialisms" to write initialisms like ID and HTTP in upper case
+
+	InterfacePerTag bool `yaml:"interface-per-tag,omitempty"` // Split the ServerInterface into a ServerInterfaceTagName interface per first tag of the operations, which it embeds
+
+	GeneratePtrHelpers bool   `yaml:"generate-ptr-helpers,omitempty"` // Generate a generic function returning a pointer to its argument, for setting optional fields
+	PtrHelperName      string `yaml:"ptr-helper-name,omitempty"`      // The name of the function generated by generate-ptr-helpers, Ptr by default
+
+	UseDefaultsForOptional bool `yaml:"use-defaults-for-optional,omitempty"` // Generate optional string, number and boolean properties with a default as values rather than pointers, with a Defaults method setting them
+
+	OptionalBodyPointers bool `yaml:"optional-body-pointers,omitempty"` // Take request bodies which aren't required by pointer in the client, sending no body when nil, and leave them nil in strict servers when they're missing
+
+	UseJSONNumber bool `yaml:"use-json-number,omitempty"` // Generate numbers without a format, and integers bounded beyond int64, as json.Number, and decode JSON bodies with UseNumber, so that they keep their precision
+
+	GenerateOperationExtensions bool `yaml:"generate-operation-extensions,omitempty"` // Generate an OperationExtensions map from operation IDs to their x- extensions, for middleware to read at runtime
+
+	CommentWidth int `yaml:"comment-width,omitempty"` // Wrap the paragraphs of the descriptions of struct fields, such as those of Params types, to comments of this many columns; 0 leaves their lines as they are
+
+	MaxBodyBytes int64 `yaml:"max-body-bytes,omitempty"` // The limit of the size of request bodies which servers accept, answering 413 for larger ones, unless an operation's x-max-body-bytes extension sets its own. 0 means no limit
+
+	DateTimeFormat string `yaml:"date-time-format,omitempty"` // The Go time layout, such as "2006-01-02 15:04:05", with which clients format and servers parse date-time path and query parameters, rather than RFC 3339. JSON bodies are unaffected
+
+	StrictErrorResponses bool `yaml:"strict-error-responses,omitempty"` // Give the 4XX, 5XX and default response types of strict servers an Error method, so that handlers can return them as errors, which strict servers then write as the response
+
+	ClientAcceptHeader bool `yaml:"client-accept-header,omitempty"` // Set the Accept header of client requests to the content types of the operation's responses, and generate AcceptEditor to prefer some of them
+
+	IdempotencyKeys bool `yaml:"idempotency-keys,omitempty"` // Generate WithIdempotencyKey and WithAutoIdempotencyKeys for clients to send Idempotency-Key headers with POST and PATCH requests, or those of operations with x-idempotency-key, and an IdempotencyKeyMiddleware for servers to read them
+
+	ModelsPackage string `yaml:"models-package,omitempty"` // The import path of the package which the types were generated in, such as "github.com/acme/api/models", for a client or server generated without models in another package to refer to them
+
+	GenerateQueryBuilders bool `yaml:"generate-query-builders,omitempty"` // Generate a builder for the parameters of each operation with a Params type, returned by a ClientWithResponses method like ListPetsQuery, with a method setting each parameter and Do methods sending the request. Needs client
+
+	GenerateInProcessClient bool `yaml:"generate-in-process-client,omitempty"` // Generate NewInProcessClient, which creates a ClientWithResponses whose requests are served by an http.Handler, such as that of a generated server, without a listening socket, for tests. Needs client
+
+	Paginators []PaginationPattern `yaml:"paginators,omitempty"` // Generate a paginator, fetching one page after another, for each operation with the query parameter and response property of one of these patterns, returned by a ClientWithResponses method like ListPetsPaginator. Needs client
+
+	HeaderEditors bool `yaml:"header-editors,omitempty"` // Generate a request editor, like WithXRequestIdHeader, for each header parameter of #/components/parameters, setting the header to a value of its type, serialized like the header parameters of operations. Needs client
+
+	LoggingMiddleware bool `yaml:"logging-middleware,omitempty"` // Generate LoggingMiddleware, which logs the method, path, operation ID, status and latency of each request with a *slog.Logger. Needs chi-server or gorilla-server, and Go 1.21
+
+	UnexportedTypes bool `yaml:"unexported-types,omitempty"` // Unexport the package-level types, functions, constants and variables which are generated, by lowercasing their first letter, for packages which shouldn't expose them. Methods, struct fields and JSON tags are unchanged. Can't be used with models-package
+
+	GinBindingTags bool `yaml:"gin-binding-tags,omitempty"` // Add binding tags, which gin's validator checks, to the fields of struct types: required for required arrays and maps, and the validator of the format, such as email, of string properties. Needs gin-server
 }
 
 // UpdateDefaults sets reasonable default values for unset fields in Configuration
@@ -107,6 +233,10 @@ func (o Configuration) Validate() error {
 		return errors.New("package name must be specified")
 	}
 
+	if o.Generate.ServerInterfaceOnly && (o.Generate.ChiServer || o.Generate.EchoServer || o.Generate.GinServer || o.Generate.GorillaServer) {
+		return errors.New("server-interface can't be generated along with a chi, echo, gin or gorilla server, which has a ServerInterface of its own")
+	}
+
 	// Only one server type should be specified at a time.
 	nServers := 0
 	if o.Generate.ChiServer {
@@ -118,8 +248,172 @@ func (o Configuration) Validate() error {
 	if o.Generate.GinServer {
 		nServers++
 	}
+	if o.Generate.ServerInterfaceOnly {
+		nServers++
+	}
 	if nServers > 1 {
 		return errors.New("only one server type is supported at a time")
 	}
+
+	if o.Generate.SpecHandler && !o.Generate.EmbeddedSpec {
+		return errors.New("spec-handler requires embedded-spec")
+	}
+	if o.Generate.ValidationMiddleware && !o.Generate.EmbeddedSpec {
+		return errors.New("validation-middleware requires embedded-spec")
+	}
+
+	if o.OutputOptions.ModelsPackage != "" && o.Generate.Models {
+		return errors.New("models-package can't be used with models, which it says are generated in another package")
+	}
+	if o.OutputOptions.ModelsPackage != "" {
+		if _, err := packageName(o.OutputOptions.ModelsPackage); err != nil {
+			return fmt.Errorf("invalid models-package: %w", err)
+		}
+	}
+
+	if o.OutputOptions.GinBindingTags && !o.Generate.GinServer {
+		return errors.New("gin-binding-tags requires gin-server")
+	}
+	if o.OutputOptions.GenerateQueryBuilders && !o.Generate.Client {
+		return errors.New("generate-query-builders requires client")
+	}
+	if o.OutputOptions.GenerateInProcessClient && !o.Generate.Client {
+		return errors.New("generate-in-process-client requires client")
+	}
+	if o.OutputOptions.LoggingMiddleware && !o.Generate.ChiServer && !o.Generate.GorillaServer {
+		return errors.New("logging-middleware requires chi-server or gorilla-server")
+	}
+	if o.OutputOptions.UnexportedTypes && o.OutputOptions.ModelsPackage != "" {
+		return errors.New("unexported-types can't be used with models-package, whose types would have to be exported")
+	}
+	if len(o.OutputOptions.Paginators) != 0 && !o.Generate.Client {
+		return errors.New("paginators requires client")
+	}
+	if o.OutputOptions.HeaderEditors && !o.Generate.Client {
+		return errors.New("header-editors requires client")
+	}
+	for i, pattern := range o.OutputOptions.Paginators {
+		if pattern.CursorParam == "" || pattern.NextCursorField == "" {
+			return fmt.Errorf("paginator %d needs both cursor-param and next-cursor-field", i)
+		}
+	}
+
+	if o.OutputOptions.TagFilterExpression != "" {
+		if _, err := parseTagFilter(o.OutputOptions.TagFilterExpression); err != nil {
+			return err
+		}
+	}
+
+	switch o.OutputOptions.JSONTagStyle {
+	case "", JSONTagStyleSpec, JSONTagStyleCamel, JSONTagStyleSnake:
+	default:
+		return fmt.Errorf("unknown json-tag-style %q, expected one of %q, %q or %q",
+			o.OutputOptions.JSONTagStyle, JSONTagStyleSpec, JSONTagStyleCamel, JSONTagStyleSnake)
+	}
+
+	switch o.OutputOptions.OmitEmptyPolicy {
+	case "", OmitEmptyAlways, OmitEmptyScalarsOnly, OmitEmptyNever:
+	default:
+		return fmt.Errorf("unknown omit-empty-policy %q, expected one of %q, %q or %q",
+			o.OutputOptions.OmitEmptyPolicy, OmitEmptyAlways, OmitEmptyScalarsOnly, OmitEmptyNever)
+	}
+
+	if _, found := NameNormalizers[o.OutputOptions.NameNormalizer]; !found {
+		return fmt.Errorf("unknown name-normalizer %q, expected one of %q, %q or %q",
+			o.OutputOptions.NameNormalizer, NameNormalizerDefault, NameNormalizerCamelCaseWithDigits, NameNormalizerCamelCaseInitialisms)
+	}
+
+	if len(o.OutputOptions.BuildTags) != 0 {
+		if _, err := constraint.Parse("//go:build " + buildConstraint(o.OutputOptions.BuildTags)); err != nil {
+			return fmt.Errorf("invalid build-tags %q: %w", o.OutputOptions.BuildTags, err)
+		}
+	}
+
+	if name := o.OutputOptions.PtrHelperName; name != "" && (!typeNameRegex.MatchString(name) || IsGoKeyword(name)) {
+		return fmt.Errorf("ptr-helper-name %q isn't a valid Go identifier", name)
+	}
+
+	if err := validateDateTimeFormat(o.OutputOptions.DateTimeFormat); err != nil {
+		return err
+	}
+
+	if o.OutputOptions.MaxBodyBytes < 0 {
+		return fmt.Errorf("max-body-bytes must not be negative, got %d", o.OutputOptions.MaxBodyBytes)
+	}
+
+	for _, format := range SortedStringKeys(o.OutputOptions.TypeMappings) {
+		if _, _, err := parseTypeMapping(o.OutputOptions.TypeMappings[format]); err != nil {
+			return fmt.Errorf("invalid type-mappings value for format %q: %w", format, err)
+		}
+	}
 	return nil
 }
+
+// validateDateTimeFormat checks that layout, the date-time-format, is a Go
+// time layout which a time formatted with can be parsed back from.
+func validateDateTimeFormat(layout string) error {
+	if layout == "" {
+		return nil
+	}
+	reference := time.Date(2001, 2, 3, 4, 5, 6, 0, time.UTC)
+	formatted := reference.Format(layout)
+	if formatted == layout {
+		return fmt.Errorf("date-time-format %q has no date or time elements, such as 2006 or 15:04", layout)
+	}
+	if _, err := time.Parse(layout, formatted); err != nil {
+		return fmt.Errorf("invalid date-time-format %q: %w", layout, err)
+	}
+	return nil
+}
+
+// packageName returns the name by which the package at pkgPath is referred
+// to, which is the last element of its path, skipping any major version
+// suffix, with anything which isn't valid in a Go identifier removed.
+func packageName(pkgPath string) (string, error) {
+	elems := strings.Split(pkgPath, "/")
+	pkgName := elems[len(elems)-1]
+	if len(elems) > 1 && majorVersionRegex.MatchString(pkgName) {
+		pkgName = elems[len(elems)-2]
+	}
+	pkgName = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return r
+		}
+		return -1
+	}, pkgName)
+	if pkgName == "" || unicode.IsDigit(rune(pkgName[0])) {
+		return "", fmt.Errorf("can't derive a package name from %q", pkgPath)
+	}
+	return pkgName, nil
+}
+
+// parseTypeMapping splits a type mapping, such as
+// "github.com/shopspring/decimal.Decimal", into the import for its package and
+// the Go type to use in generated code, such as "decimal.Decimal". Types from
+// the standard library aren't imported explicitly, since goimports adds them.
+func parseTypeMapping(mapping string) (*goImport, string, error) {
+	dot := strings.LastIndex(mapping, ".")
+	if dot <= strings.LastIndex(mapping, "/") || dot == len(mapping)-1 {
+		return nil, "", fmt.Errorf("%q isn't a package path and type name, such as github.com/shopspring/decimal.Decimal", mapping)
+	}
+	pkgPath, typeName := mapping[:dot], mapping[dot+1:]
+	if !typeNameRegex.MatchString(typeName) || !unicode.IsUpper(rune(typeName[0])) {
+		return nil, "", fmt.Errorf("%q in %q isn't an exported type name", typeName, mapping)
+	}
+
+	pkgName, err := packageName(pkgPath)
+	if err != nil {
+		return nil, "", err
+	}
+	elems := strings.Split(pkgPath, "/")
+
+	goType := pkgName + "." + typeName
+	if !strings.Contains(elems[0], ".") {
+		return nil, goType, nil
+	}
+	imprt := &goImport{Path: pkgPath}
+	if pkgName != elems[len(elems)-1] {
+		imprt.Name = pkgName
+	}
+	return imprt, goType, nil
+}
@@ -0,0 +1,60 @@
+package codegen
+
+import (
+	"math"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// keywordConst is the JSON Schema keyword, from OpenAPI 3.1, restricting a
+// value to a single one. kin-openapi doesn't know it, so it's kept among the
+// extensions of the schema, and it's generated like an enum of that value.
+const keywordConst = "const"
+
+// constType returns the type of a schema with the given const, guessed from
+// the value when the schema doesn't give it, and whether the const can be
+// generated as a single-value enum. Only scalar values of a plain primitive
+// type can; consts like arrays, objects or formatted strings, such as dates,
+// are left as the ordinary type of the schema.
+func constType(schema *openapi3.Schema, value interface{}) (string, bool) {
+	if schema.Extensions[extPropGoType] != nil {
+		return "", false
+	}
+	var valueType string
+	switch v := value.(type) {
+	case string:
+		valueType = "string"
+	case bool:
+		valueType = "boolean"
+	case float64:
+		valueType = "number"
+		if v == math.Trunc(v) {
+			valueType = "integer"
+		}
+	default:
+		return "", false
+	}
+
+	switch schema.Type {
+	case "":
+		return valueType, true
+	case "string", "boolean":
+		return schema.Type, schema.Type == valueType && schema.Format == ""
+	case "integer":
+		return schema.Type, valueType == "integer" && plainNumberFormat(schema.Format)
+	case "number":
+		return schema.Type, (valueType == "integer" || valueType == "number") && plainNumberFormat(schema.Format)
+	default:
+		return "", false
+	}
+}
+
+// plainNumberFormat returns true if numbers of the given format are generated
+// as one of Go's numeric types.
+func plainNumberFormat(format string) bool {
+	switch format {
+	case "", "int32", "int64", "float", "double":
+		return true
+	}
+	return false
+}
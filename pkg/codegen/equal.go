@@ -0,0 +1,259 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// EqualDefinition describes the Equal method generated for a struct type.
+type EqualDefinition struct {
+	TypeName   string
+	Statements []string // Go statements returning false when a field differs
+	// Underlying is set for types defined as another struct type, whose
+	// method they call.
+	Underlying string
+}
+
+// equalGenerator writes the statements of Equal methods, comparing the fields
+// of struct types through pointers, slices and maps.
+type equalGenerator struct {
+	structs map[string]bool           // Types with Equal methods
+	types   map[string]TypeDefinition // Other types, followed by refs
+}
+
+// equalProperties returns the statements comparing the properties of s, a
+// struct held by a and b.
+func (g equalGenerator) equalProperties(a, b string, s Schema, depth int) []string {
+	var statements []string
+	for _, p := range s.Properties {
+		name := p.GoStructFieldName()
+		fieldA, fieldB := a+"."+name, b+"."+name
+		switch {
+		case p.HasNullableType():
+			statements = append(statements, differ(fmt.Sprintf("%s.Set != %s.Set || %s.Null != %s.Null", fieldA, fieldB, fieldA, fieldB))...)
+			var inner []string
+			if p.Recursive {
+				inner = g.equalPointer(fieldA+".Value", fieldB+".Value", p.Schema, depth)
+			} else {
+				inner = g.equalValue(fieldA+".Value", fieldB+".Value", p.Schema, depth)
+			}
+			statements = append(statements, guarded(fmt.Sprintf("%s.Set && !%s.Null", fieldA, fieldA), inner)...)
+		case strings.HasPrefix(p.GoTypeDef(), "*"):
+			statements = append(statements, g.equalPointer(fieldA, fieldB, p.Schema, depth)...)
+		default:
+			statements = append(statements, g.equalValue(fieldA, fieldB, p.Schema, depth)...)
+		}
+	}
+	if s.HasAdditionalProperties && s.AdditionalPropertiesType != nil && !s.IsAdditionalPropertiesMap {
+		statements = append(statements, g.equalMap(a+".AdditionalProperties", b+".AdditionalProperties", *s.AdditionalPropertiesType, depth)...)
+	}
+	if len(s.UnionElements) != 0 {
+		statements = append(statements, differ(fmt.Sprintf("!bytes.Equal(%s.union, %s.union)", a, b))...)
+	}
+	return statements
+}
+
+// equalTuple returns the statements comparing the items of s, a tuple held by
+// a and b.
+func (g equalGenerator) equalTuple(a, b string, s Schema, depth int) []string {
+	var statements []string
+	for _, item := range s.TupleItems {
+		itemA, itemB := a+"."+item.GoFieldName, b+"."+item.GoFieldName
+		if item.Required {
+			statements = append(statements, g.equalValue(itemA, itemB, item.Schema, depth)...)
+		} else {
+			statements = append(statements, g.equalPointer(itemA, itemB, item.Schema, depth)...)
+		}
+	}
+	if s.TupleRest != nil {
+		statements = append(statements, g.equalSlice(a+".Rest", b+".Rest", *s.TupleRest, depth)...)
+	}
+	return statements
+}
+
+// equalPointer returns the statements comparing a and b, pointers to values
+// of the type of s, which are equal if both are nil, or what they point to is.
+func (g equalGenerator) equalPointer(a, b string, s Schema, depth int) []string {
+	statements := differ(fmt.Sprintf("(%s == nil) != (%s == nil)", a, b))
+	var inner []string
+	if _, isRef := refTypeName(s); !isRef && strings.HasPrefix(s.GoType, "struct") {
+		// Fields are selected through the pointers
+		inner = g.equalProperties(a, b, s, depth)
+	} else {
+		inner = g.equalValue("(*"+a+")", "(*"+b+")", s, depth)
+	}
+	if len(inner) == 3 && strings.HasPrefix(inner[0], "if ") && inner[1] == "return false" {
+		// A single comparison is made in the same condition
+		return append(statements, differ(a+" != nil && "+strings.TrimSuffix(strings.TrimPrefix(inner[0], "if "), " {"))...)
+	}
+	return append(statements, guarded(a+" != nil", inner)...)
+}
+
+// equalValue returns the statements comparing a and b, values of the type of
+// s.
+func (g equalGenerator) equalValue(a, b string, s Schema, depth int) []string {
+	if name, ok := refTypeName(s); ok {
+		if g.structs[name] {
+			return differ(fmt.Sprintf("!%s.Equal(%s)", receiver(a), operand(b)))
+		}
+		if td, ok := g.types[name]; ok && depth < 8 {
+			return g.equalValue(a, b, td.Schema, depth+1)
+		}
+		return equalBasic(a, b, name)
+	}
+	if s.ArrayType != nil {
+		return g.equalSlice(a, b, *s.ArrayType, depth)
+	}
+	if s.AdditionalPropertiesType != nil && strings.HasPrefix(s.GoType, "map[") {
+		return g.equalMap(a, b, *s.AdditionalPropertiesType, depth)
+	}
+	if strings.HasPrefix(s.GoType, "struct") {
+		return g.equalProperties(a, b, s, depth)
+	}
+	return equalBasic(a, b, s.GoType)
+}
+
+// equalSlice returns the statements comparing a and b, slices of values of
+// the type of s, element by element.
+func (g equalGenerator) equalSlice(a, b string, s Schema, depth int) []string {
+	index := fmt.Sprintf("i%d", depth)
+	statements := differ(fmt.Sprintf("len(%s) != len(%s)", operand(a), operand(b)))
+	statements = append(statements, fmt.Sprintf("for %s := range %s {", index, operand(a)))
+	statements = append(statements, g.equalValue(a+"["+index+"]", b+"["+index+"]", s, depth+1)...)
+	return append(statements, "}")
+}
+
+// equalMap returns the statements comparing a and b, maps of values of the
+// type of s, which are equal if they have the same keys, with equal values.
+func (g equalGenerator) equalMap(a, b string, s Schema, depth int) []string {
+	key, valueA, valueB := fmt.Sprintf("k%d", depth), fmt.Sprintf("x%d", depth), fmt.Sprintf("y%d", depth)
+	statements := differ(fmt.Sprintf("len(%s) != len(%s)", operand(a), operand(b)))
+	statements = append(statements, fmt.Sprintf("for %s, %s := range %s {", key, valueA, operand(a)))
+	statements = append(statements, fmt.Sprintf("%s, ok := %s[%s]", valueB, b, key))
+	statements = append(statements, differ("!ok")...)
+	statements = append(statements, g.equalValue(valueA, valueB, s, depth+1)...)
+	return append(statements, "}")
+}
+
+// equalBasic returns the statements comparing a and b, values of goType,
+// which is neither a struct type with an Equal method, nor a slice or map of
+// values of a known type. Values of interface{}, and of types whose structure
+// isn't known, such as those of x-go-type, are compared with reflect.DeepEqual.
+func equalBasic(a, b, goType string) []string {
+	switch goType {
+	case "time.Time":
+		return differ(fmt.Sprintf("!%s.Equal(%s)", receiver(a), operand(b)))
+	case "json.RawMessage", "[]byte":
+		return differ(fmt.Sprintf("!bytes.Equal(%s, %s)", operand(a), operand(b)))
+	case "openapi_types.Date", "openapi_types.Email", "openapi_types.UUID":
+		return differ(fmt.Sprintf("%s != %s", operand(a), operand(b)))
+	}
+	if goType == "interface{}" || strings.ContainsAny(goType, ".[]{") {
+		return differ(fmt.Sprintf("!reflect.DeepEqual(%s, %s)", operand(a), operand(b)))
+	}
+	return differ(fmt.Sprintf("%s != %s", operand(a), operand(b)))
+}
+
+// operand returns expr, which may be a parenthesized dereference of a
+// pointer, such as (*a.Name), without the parentheses, which an operand of
+// a comparison or an argument doesn't need.
+func operand(expr string) string {
+	if inner, ok := dereferenced(expr); ok {
+		return "*" + inner
+	}
+	return expr
+}
+
+// receiver returns the pointer which expr dereferences, if it's a
+// parenthesized dereference, since the methods of values can be called
+// through pointers to them.
+func receiver(expr string) string {
+	if inner, ok := dereferenced(expr); ok {
+		return inner
+	}
+	return expr
+}
+
+// dereferenced returns the pointer which expr dereferences, if it's a
+// parenthesized dereference, such as (*a.Name).
+func dereferenced(expr string) (string, bool) {
+	if !strings.HasPrefix(expr, "(*") || !strings.HasSuffix(expr, ")") {
+		return "", false
+	}
+	inner := expr[2 : len(expr)-1]
+	if strings.ContainsAny(inner, "()") {
+		return "", false
+	}
+	return inner, true
+}
+
+// differ returns statements returning false when condition holds.
+func differ(condition string) []string {
+	return guarded(condition, []string{"return false"})
+}
+
+// GenerateEqual generates an Equal method for each of the given struct types,
+// comparing their fields, when the generate-equal option is set.
+func GenerateEqual(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	if !globalState.options.OutputOptions.GenerateEqual {
+		return "", nil
+	}
+
+	g := equalGenerator{structs: map[string]bool{}, types: map[string]TypeDefinition{}}
+	var structs []TypeDefinition
+	seen := map[string]bool{}
+	for _, td := range typeDefs {
+		if seen[td.TypeName] {
+			continue
+		}
+		seen[td.TypeName] = true
+		if isEqualStruct(td) {
+			for _, p := range td.Schema.Properties {
+				if p.GoStructFieldName() == "Equal" {
+					return "", fmt.Errorf("the Equal method generated for %s has the same name as its field for property %q", td.TypeName, p.JsonFieldName)
+				}
+			}
+			g.structs[td.TypeName] = true
+			structs = append(structs, td)
+		} else {
+			g.types[td.TypeName] = td
+		}
+	}
+
+	// Types defined as these types, rather than aliases of them, don't have
+	// their methods
+	var definitions []EqualDefinition
+	for _, td := range typeDefs {
+		if g.structs[td.TypeName] || td.IsAlias() {
+			continue
+		}
+		if name, ok := refTypeName(td.Schema); ok && g.structs[name] {
+			g.structs[td.TypeName] = true
+			definitions = append(definitions, EqualDefinition{TypeName: td.TypeName, Underlying: name})
+		}
+	}
+
+	for _, td := range structs {
+		var statements []string
+		receiver := ReceiverName(td.TypeName)
+		if len(td.Schema.TupleItems) != 0 {
+			statements = g.equalTuple(receiver, "other", td.Schema, 0)
+		} else {
+			statements = g.equalProperties(receiver, "other", td.Schema, 0)
+		}
+		definitions = append(definitions, EqualDefinition{TypeName: td.TypeName, Statements: statements})
+	}
+	if len(definitions) == 0 {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"equal.tmpl"}, t, definitions)
+}
+
+// isEqualStruct returns true if td defines a struct type, including that of a
+// tuple, which gets an Equal method.
+func isEqualStruct(td TypeDefinition) bool {
+	_, isRef := refTypeName(td.Schema)
+	return !td.IsAlias() && !isRef && td.Schema.ArrayType == nil &&
+		!td.Schema.IsAdditionalPropertiesMap && strings.HasPrefix(td.Schema.GoType, "struct")
+}
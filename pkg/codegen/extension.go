@@ -12,13 +12,28 @@ const (
 	// extGoName is used to override a field name
 	extGoName = "x-go-name"
 	// extGoTypeName is used to override a generated typename for something.
-	extGoTypeName        = "x-go-type-name"
+	extGoTypeName = "x-go-type-name"
+	// extGoMethodName is used to override the generated method name for an
+	// operation, while leaving type names derived from its operationId.
+	extGoMethodName      = "x-go-method-name"
 	extPropGoJsonIgnore  = "x-go-json-ignore"
 	extPropOmitEmpty     = "x-omitempty"
 	extPropExtraTags     = "x-oapi-codegen-extra-tags"
 	extEnumVarNames      = "x-enum-varnames"
 	extEnumNames         = "x-enumNames"
 	extDeprecationReason = "x-deprecated-reason"
+	// extWebSocket marks an operation whose handler upgrades the connection
+	// to a WebSocket.
+	extWebSocket = "x-websocket"
+	// extMaxBodyBytes limits the size of the request bodies of an operation
+	// in servers.
+	extMaxBodyBytes = "x-max-body-bytes"
+	// extIdempotencyKey overrides whether the client sends an Idempotency-Key
+	// header with the requests of an operation.
+	extIdempotencyKey = "x-idempotency-key"
+	// extSensitive marks a property holding a secret, which the
+	// redact-sensitive option keeps out of String and GoString.
+	extSensitive = "x-go-sensitive"
 )
 
 func extString(extPropValue interface{}) (string, error) {
@@ -36,6 +51,10 @@ func extParseGoFieldName(extPropValue interface{}) (string, error) {
 	return extString(extPropValue)
 }
 
+func extParseGoMethodName(extPropValue interface{}) (string, error) {
+	return extString(extPropValue)
+}
+
 func extParseOmitEmpty(extPropValue interface{}) (bool, error) {
 	omitEmpty, ok := extPropValue.(bool)
 	if !ok {
@@ -44,6 +63,33 @@ func extParseOmitEmpty(extPropValue interface{}) (bool, error) {
 	return omitEmpty, nil
 }
 
+func extParseWebSocket(extPropValue interface{}) (bool, error) {
+	webSocket, ok := extPropValue.(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	return webSocket, nil
+}
+
+func extParseIdempotencyKey(extPropValue interface{}) (bool, error) {
+	idempotencyKey, ok := extPropValue.(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	return idempotencyKey, nil
+}
+
+func extParseMaxBodyBytes(extPropValue interface{}) (int64, error) {
+	limit, ok := extPropValue.(float64)
+	if !ok {
+		return 0, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	if limit < 0 || limit != float64(int64(limit)) {
+		return 0, fmt.Errorf("%v isn't a number of bytes", limit)
+	}
+	return int64(limit), nil
+}
+
 func extExtraTags(extPropValue interface{}) (map[string]string, error) {
 	tagsI, ok := extPropValue.(map[string]interface{})
 	if !ok {
@@ -87,3 +133,11 @@ func extParseEnumVarNames(extPropValue interface{}) ([]string, error) {
 func extParseDeprecationReason(extPropValue interface{}) (string, error) {
 	return extString(extPropValue)
 }
+
+func extParseSensitive(extPropValue interface{}) (bool, error) {
+	sensitive, ok := extPropValue.(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	return sensitive, nil
+}
@@ -1,14 +1,192 @@
 package codegen
 
-import "github.com/getkin/kin-openapi/openapi3"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
 
-func filterOperationsByTag(swagger *openapi3.T, opts Configuration) {
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// filterOperationsByTag removes the operations which don't pass the
+// TagFilterExpression output option, when it's set, or otherwise the
+// IncludeTags and ExcludeTags options.
+func filterOperationsByTag(swagger *openapi3.T, opts Configuration) error {
+	if opts.OutputOptions.TagFilterExpression != "" {
+		filter, err := parseTagFilter(opts.OutputOptions.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+		for _, pathItem := range swagger.Paths {
+			for name, op := range pathItem.Operations() {
+				if !filter(op.Tags) {
+					pathItem.SetOperation(name, nil)
+				}
+			}
+		}
+		return nil
+	}
 	if len(opts.OutputOptions.ExcludeTags) > 0 {
 		excludeOperationsWithTags(swagger.Paths, opts.OutputOptions.ExcludeTags)
 	}
 	if len(opts.OutputOptions.IncludeTags) > 0 {
 		includeOperationsWithTags(swagger.Paths, opts.OutputOptions.IncludeTags, false)
 	}
+	return nil
+}
+
+// tagFilter returns true if an operation with tags passes a filter.
+type tagFilter func(tags []string) bool
+
+// parseTagFilter parses a tag filter expression, such as
+// "public AND NOT (beta OR internal)", into a tagFilter. A tag name on its
+// own is true when the operation has that tag, and they can be combined with
+// AND, OR and NOT, which aren't case-sensitive, and grouped with parentheses.
+// NOT binds most tightly, then AND, then OR. Tags containing spaces or
+// parentheses, or named like the operators, can be given in double quotes.
+func parseTagFilter(expression string) (tagFilter, error) {
+	tokens, err := tokenizeTagFilter(expression)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing tag filter expression %q: %w", expression, err)
+	}
+	p := tagFilterParser{tokens: tokens}
+	filter, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %s", p.tokens[p.pos].text)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error parsing tag filter expression %q: %w", expression, err)
+	}
+	return filter, nil
+}
+
+type tagFilterToken struct {
+	text   string
+	quoted bool
+}
+
+// is returns true if the token is the given operator or parenthesis.
+func (t tagFilterToken) is(op string) bool {
+	return !t.quoted && strings.EqualFold(t.text, op)
+}
+
+func tokenizeTagFilter(expression string) ([]tagFilterToken, error) {
+	var tokens []tagFilterToken
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, tagFilterToken{text: string(r)})
+			i++
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, errors.New("unterminated quoted tag")
+			}
+			tokens = append(tokens, tagFilterToken{text: string(runes[i+1 : end]), quoted: true})
+			i = end + 1
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune(`()"`, runes[end]) {
+				end++
+			}
+			tokens = append(tokens, tagFilterToken{text: string(runes[i:end])})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+type tagFilterParser struct {
+	tokens []tagFilterToken
+	pos    int
+}
+
+// accept consumes the next token if it's the given operator.
+func (p *tagFilterParser) accept(op string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].is(op) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *tagFilterParser) parseOr() (tagFilter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(tags []string) bool { return l(tags) || right(tags) }
+	}
+	return left, nil
+}
+
+func (p *tagFilterParser) parseAnd() (tagFilter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(tags []string) bool { return l(tags) && right(tags) }
+	}
+	return left, nil
+}
+
+func (p *tagFilterParser) parseNot() (tagFilter, error) {
+	if p.accept("NOT") {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return func(tags []string) bool { return !operand(tags) }, nil
+	}
+	return p.parseTag()
+}
+
+func (p *tagFilterParser) parseTag() (tagFilter, error) {
+	if p.pos == len(p.tokens) {
+		return nil, errors.New("unexpected end of expression")
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	if token.is("(") {
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, errors.New("missing )")
+		}
+		return inner, nil
+	}
+	if token.is(")") || token.is("AND") || token.is("OR") || token.is("NOT") {
+		return nil, fmt.Errorf("unexpected %s", token.text)
+	}
+	return func(tags []string) bool {
+		for _, tag := range tags {
+			if tag == token.text {
+				return true
+			}
+		}
+		return false
+	}, nil
 }
 
 func excludeOperationsWithTags(paths openapi3.Paths, tags []string) {
@@ -44,3 +222,32 @@ func operationHasTag(op *openapi3.Operation, tags []string) bool {
 	}
 	return false
 }
+
+// operationIsFiltered returns true if the operation should be skipped according
+// to the IncludeOperations and ExcludeOperations output options. Any of
+// operationIDs may match, which allows users to refer to an operation either by
+// the operationId from the spec or by the resolved Go name.
+func operationIsFiltered(opts OutputOptions, operationIDs ...string) bool {
+	if len(opts.ExcludeOperations) > 0 && operationHasID(operationIDs, opts.ExcludeOperations) {
+		return true
+	}
+	if len(opts.IncludeOperations) > 0 && !operationHasID(operationIDs, opts.IncludeOperations) {
+		return true
+	}
+	return false
+}
+
+// operationHasID returns true if any of operationIDs is contained in ids
+func operationHasID(operationIDs []string, ids []string) bool {
+	for _, hasID := range operationIDs {
+		if hasID == "" {
+			continue
+		}
+		for _, wantID := range ids {
+			if hasID == wantID {
+				return true
+			}
+		}
+	}
+	return false
+}
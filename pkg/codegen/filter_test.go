@@ -1,10 +1,12 @@
 package codegen
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFilterOperationsByTag(t *testing.T) {
@@ -67,3 +69,177 @@ func TestFilterOperationsByTag(t *testing.T) {
 		assert.NotContains(t, code, `"/cat"`)
 	})
 }
+
+func TestFilterOperationsByOperationID(t *testing.T) {
+	packageName := "testswagger"
+	t.Run("include operations", func(t *testing.T) {
+		opts := Configuration{
+			PackageName: packageName,
+			Generate: GenerateOptions{
+				EchoServer:   true,
+				Client:       true,
+				Models:       true,
+				EmbeddedSpec: true,
+			},
+			OutputOptions: OutputOptions{
+				IncludeOperations: []string{"getCatStatus", "GetEnum"},
+			},
+		}
+
+		loader := openapi3.NewLoader()
+		loader.IsExternalRefsAllowed = true
+
+		// Get a spec from the test definition in this file:
+		swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+		assert.NoError(t, err)
+
+		// Run our code generation:
+		code, err := Generate(swagger, opts)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, code)
+		assert.NotContains(t, code, `"/test/:name"`)
+		assert.NotContains(t, code, `"/user"`)
+		assert.Contains(t, code, `"/cat"`)
+		assert.Contains(t, code, `"/enum"`)
+	})
+
+	t.Run("exclude operations", func(t *testing.T) {
+		opts := Configuration{
+			PackageName: packageName,
+			Generate: GenerateOptions{
+				EchoServer:   true,
+				Client:       true,
+				Models:       true,
+				EmbeddedSpec: true,
+			},
+			OutputOptions: OutputOptions{
+				ExcludeOperations: []string{"getCatStatus"},
+			},
+		}
+
+		loader := openapi3.NewLoader()
+		loader.IsExternalRefsAllowed = true
+
+		// Get a spec from the test definition in this file:
+		swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+		assert.NoError(t, err)
+
+		// Run our code generation:
+		code, err := Generate(swagger, opts)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, code)
+		assert.Contains(t, code, `"/test/:name"`)
+		assert.NotContains(t, code, `"/cat"`)
+	})
+
+	t.Run("no operations left", func(t *testing.T) {
+		opts := Configuration{
+			PackageName: packageName,
+			Generate: GenerateOptions{
+				EchoServer: true,
+				Models:     true,
+			},
+			OutputOptions: OutputOptions{
+				IncludeOperations: []string{"doesNotExist"},
+			},
+		}
+
+		loader := openapi3.NewLoader()
+		loader.IsExternalRefsAllowed = true
+
+		swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+		assert.NoError(t, err)
+
+		_, err = Generate(swagger, opts)
+		assert.ErrorContains(t, err, "no operations left")
+	})
+}
+
+func TestParseTagFilter(t *testing.T) {
+	operations := map[string][]string{
+		"public":          {"public"},
+		"public beta":     {"public", "beta"},
+		"internal":        {"internal"},
+		"internal beta":   {"internal", "beta"},
+		"untagged":        nil,
+		"with space":      {"with space"},
+		"public internal": {"public", "internal"},
+	}
+
+	tests := []struct {
+		expression string
+		matches    []string
+	}{
+		{"public", []string{"public", "public beta", "public internal"}},
+		{"NOT public", []string{"internal", "internal beta", "untagged", "with space"}},
+		{"public AND beta", []string{"public beta"}},
+		{"public AND NOT beta", []string{"public", "public internal"}},
+		{"public OR internal", []string{"public", "public beta", "internal", "internal beta", "public internal"}},
+		{"NOT (public OR internal)", []string{"untagged", "with space"}},
+		{"NOT public AND NOT internal", []string{"untagged", "with space"}},
+		{"public OR internal AND beta", []string{"public", "public beta", "internal beta", "public internal"}},
+		{"(public OR internal) AND beta", []string{"public beta", "internal beta"}},
+		{"NOT NOT beta", []string{"public beta", "internal beta"}},
+		{"public and not beta", []string{"public", "public internal"}},
+		{`"with space" OR untagged`, []string{"with space"}},
+	}
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			filter, err := parseTagFilter(test.expression)
+			require.NoError(t, err)
+			var matches []string
+			for name, tags := range operations {
+				if filter(tags) {
+					matches = append(matches, name)
+				}
+			}
+			assert.ElementsMatch(t, test.matches, matches)
+		})
+	}
+
+	for expression, message := range map[string]string{
+		"":                "unexpected end of expression",
+		"public AND":      "unexpected end of expression",
+		"(public":         "missing )",
+		"public)":         "unexpected )",
+		"public beta":     "unexpected beta",
+		"OR public":       "unexpected OR",
+		`"public`:         "unterminated quoted tag",
+		"NOT (AND)":       "unexpected AND",
+		"public OR (NOT)": "unexpected )",
+	} {
+		_, err := parseTagFilter(expression)
+		assert.EqualError(t, err, fmt.Sprintf("error parsing tag filter expression %q: %s", expression, message))
+	}
+}
+
+func TestFilterOperationsByTagExpression(t *testing.T) {
+	opts := Configuration{
+		PackageName: "testswagger",
+		Generate: GenerateOptions{
+			EchoServer: true,
+			Models:     true,
+		},
+		OutputOptions: OutputOptions{
+			// The expression takes precedence over the lists
+			IncludeTags:         []string{"test"},
+			TagFilterExpression: "(cat OR enum) AND NOT enum",
+		},
+	}
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, `"/cat"`)
+	assert.NotContains(t, code, `"/enum"`)
+	assert.NotContains(t, code, `"/test/:name"`)
+
+	opts.OutputOptions.TagFilterExpression = "cat AND"
+	assert.EqualError(t, opts.Validate(), `error parsing tag filter expression "cat AND": unexpected end of expression`)
+	_, err = Generate(swagger, opts)
+	assert.EqualError(t, err, `error parsing tag filter expression "cat AND": unexpected end of expression`)
+}
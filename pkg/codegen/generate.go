@@ -0,0 +1,66 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// GeneratedCode is the result of GenerateFromSpec.
+type GeneratedCode struct {
+	Code           string   // The generated Go source
+	TypeNames      []string // Names of the Go types declared in Code, in the order they are declared
+	OperationCount int      // Number of operations code was generated for
+}
+
+// GenerateFromSpec generates code for an OpenAPI spec which has already been
+// loaded, for use by programs which embed oapi-codegen rather than invoking the
+// command line tool. Unset fields of cfg take their defaults, and cfg is
+// validated before generating. Nothing is written to disk; it's up to the
+// caller to decide what to do with the result.
+//
+// Like Generate, this may modify swagger, for instance when filtering
+// operations by tag.
+func GenerateFromSpec(swagger *openapi3.T, cfg Configuration) (GeneratedCode, error) {
+	cfg = cfg.UpdateDefaults()
+	files, ops, err := generate(swagger, cfg, false)
+	if err != nil {
+		return GeneratedCode{}, err
+	}
+	code := files[singleFileName(cfg)]
+
+	typeNames, err := declaredTypeNames(code)
+	if err != nil {
+		return GeneratedCode{}, fmt.Errorf("error parsing generated code: %w", err)
+	}
+
+	return GeneratedCode{
+		Code:           code,
+		TypeNames:      typeNames,
+		OperationCount: len(ops),
+	}, nil
+}
+
+// declaredTypeNames returns the names of the top level types declared in the
+// given Go source.
+func declaredTypeNames(code string) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", code, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	var typeNames []string
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeNames = append(typeNames, spec.(*ast.TypeSpec).Name.Name)
+		}
+	}
+	return typeNames, nil
+}
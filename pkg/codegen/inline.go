@@ -64,8 +64,13 @@ func GenerateInlinedSpec(t *template.Template, importMapping importMap, swagger
 		parts = append(parts, str)
 	}
 
+	templates := []string{"inline.tmpl"}
+	if globalState.options.Generate.SpecHandler {
+		templates = append(templates, "spec-handler.tmpl")
+	}
+
 	return GenerateTemplates(
-		[]string{"inline.tmpl"},
+		templates,
 		t,
 		struct {
 			SpecParts     []string
@@ -26,6 +26,10 @@ func mergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 		return GenerateGoSchema(allOf[0], path)
 	}
 
+	if err := checkAllOfPropertyConflicts(allOf, path); err != nil {
+		return Schema{}, err
+	}
+
 	schema, err := valueWithPropagatedRef(allOf[0])
 	if err != nil {
 		return Schema{}, err
@@ -45,6 +49,50 @@ func mergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 	return GenerateGoSchema(openapi3.NewSchemaRef("", &schema), path)
 }
 
+// checkAllOfPropertyConflicts returns an error if two of the allOf schemas
+// define a property with the same name but different types, since they can't
+// be merged into a single struct field.
+func checkAllOfPropertyConflicts(allOf []*openapi3.SchemaRef, path []string) error {
+	type definition struct {
+		schemaName string
+		typeDecl   string
+	}
+	definitions := make(map[string]definition)
+
+	for i, schemaRef := range allOf {
+		schemaName := fmt.Sprintf("allOf[%d] of %s", i, strings.Join(path, "."))
+		if schemaRef.Ref != "" {
+			schemaName = schemaRef.Ref[strings.LastIndex(schemaRef.Ref, "/")+1:]
+		}
+
+		schema, err := valueWithPropagatedRef(schemaRef)
+		if err != nil {
+			return err
+		}
+		if schema.AllOf != nil {
+			schema, err = mergeAllOf(schema.AllOf)
+			if err != nil {
+				return fmt.Errorf("error merging schemas for AllOf in %s: %w", schemaName, err)
+			}
+		}
+
+		for _, name := range SortedSchemaKeys(schema.Properties) {
+			propertyPath := append(append([]string{}, path...), name)
+			property, err := GenerateGoSchema(schema.Properties[name], propertyPath)
+			if err != nil {
+				return fmt.Errorf("error generating type of property '%s' in %s: %w", name, schemaName, err)
+			}
+			typeDecl := property.TypeDecl()
+			if other, found := definitions[name]; found && other.typeDecl != typeDecl {
+				return fmt.Errorf("property '%s' is %s in %s but %s in %s, allOf schemas must agree on the types of the properties they share",
+					name, other.typeDecl, other.schemaName, typeDecl, schemaName)
+			}
+			definitions[name] = definition{schemaName: schemaName, typeDecl: typeDecl}
+		}
+	}
+	return nil
+}
+
 // valueWithPropagatedRef returns a copy of ref schema with its Properties refs
 // updated if ref itself is external. Otherwise, return ref.Value as-is.
 func valueWithPropagatedRef(ref *openapi3.SchemaRef) (openapi3.Schema, error) {
@@ -196,8 +244,14 @@ func mergeOpenapiSchemas(s1, s2 openapi3.Schema, allOf bool) (openapi3.Schema, e
 	}
 	result.AllowEmptyValue = s1.AllowEmptyValue
 
-	// Required. We merge these.
-	result.Required = append(s1.Required, s2.Required...)
+	// Required. We merge these, so that a property required by either schema
+	// is required by the result.
+	result.Required = append([]string{}, s1.Required...)
+	for _, name := range s2.Required {
+		if !StringInArray(name, result.Required) {
+			result.Required = append(result.Required, name)
+		}
+	}
 
 	// We merge all properties
 	result.Properties = make(map[string]*openapi3.SchemaRef)
@@ -205,7 +259,8 @@ func mergeOpenapiSchemas(s1, s2 openapi3.Schema, allOf bool) (openapi3.Schema, e
 		result.Properties[k] = v
 	}
 	for k, v := range s2.Properties {
-		// TODO: detect conflicts
+		// Conflicting properties in allOf schemas are detected by
+		// checkAllOfPropertyConflicts.
 		result.Properties[k] = v
 	}
 
@@ -16,7 +16,11 @@ package codegen
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
@@ -33,6 +37,14 @@ type ParameterDefinition struct {
 	Required  bool   // Is this a required parameter?
 	Spec      *openapi3.Parameter
 	Schema    Schema
+
+	// ResourcePattern is the resource name pattern of a path parameter like
+	// {name=shelves/*/books/*}, which can span several path segments.
+	ResourcePattern string
+
+	// EnumValues are the Go literals of the values allowed by the enum of a
+	// string, integer or number parameter, which servers check.
+	EnumValues []string
 }
 
 // TypeDef is here as an adapter after a large refactoring so that I don't
@@ -48,9 +60,9 @@ func (pd ParameterDefinition) TypeDef() string {
 // 'json:"foo"'
 func (pd *ParameterDefinition) JsonTag() string {
 	if pd.Required {
-		return fmt.Sprintf("`json:\"%s\"`", pd.ParamName)
+		return fmt.Sprintf("`json:\"%s\"`", jsonTagName(pd.ParamName))
 	} else {
-		return fmt.Sprintf("`json:\"%s,omitempty\"`", pd.ParamName)
+		return fmt.Sprintf("`json:\"%s,omitempty\"`", jsonTagName(pd.ParamName))
 	}
 }
 
@@ -82,35 +94,42 @@ func (pd *ParameterDefinition) IsStyled() bool {
 	return p.Schema != nil
 }
 
-func (pd *ParameterDefinition) Style() string {
+// Style returns the style of the parameter, which defaults to simple for
+// path and header parameters, and to form for query and cookie parameters.
+// It returns an error if the parameter isn't in any of those places.
+func (pd *ParameterDefinition) Style() (string, error) {
 	style := pd.Spec.Style
 	if style == "" {
 		in := pd.Spec.In
 		switch in {
 		case "path", "header":
-			return "simple"
+			return "simple", nil
 		case "query", "cookie":
-			return "form"
+			return "form", nil
 		default:
-			panic("unknown parameter format")
+			return "", fmt.Errorf("parameter %s has unknown location %q", pd.ParamName, in)
 		}
 	}
-	return style
+	return style, nil
 }
 
-func (pd *ParameterDefinition) Explode() bool {
+// Explode returns whether the parameter is exploded, which defaults to false
+// for path and header parameters, and to true for query and cookie
+// parameters. It returns an error if the parameter isn't in any of those
+// places.
+func (pd *ParameterDefinition) Explode() (bool, error) {
 	if pd.Spec.Explode == nil {
 		in := pd.Spec.In
 		switch in {
 		case "path", "header":
-			return false
+			return false, nil
 		case "query", "cookie":
-			return true
+			return true, nil
 		default:
-			panic("unknown parameter format")
+			return false, fmt.Errorf("parameter %s has unknown location %q", pd.ParamName, in)
 		}
 	}
-	return *pd.Spec.Explode
+	return *pd.Spec.Explode, nil
 }
 
 func (pd ParameterDefinition) GoVariableName() string {
@@ -134,10 +153,290 @@ func (pd ParameterDefinition) GoName() string {
 	return SchemaNameToTypeName(goName)
 }
 
+// IndirectOptional returns true if the parameter is optional, and so is a
+// pointer in the Params type of its operation. Types which skip the pointer
+// elsewhere, like []byte and json.RawMessage, whose nil values are absent, do
+// so here too.
 func (pd ParameterDefinition) IndirectOptional() bool {
 	return !pd.Required && !pd.Schema.SkipOptionalPointer
 }
 
+// ParamOptions returns the runtime.ParamOptions literal with which a
+// date-time parameter, an array of them, or an object holding them, is styled
+// and bound when the date-time-format option is set, or "" when it's styled
+// as usual.
+func (pd ParameterDefinition) ParamOptions() string {
+	layout := globalState.options.OutputOptions.DateTimeFormat
+	if layout == "" || pd.Spec == nil || !holdsDateTime(pd.Spec.Schema, nil) {
+		return ""
+	}
+	return fmt.Sprintf("runtime.ParamOptions{TimeFormat: %q}", layout)
+}
+
+// holdsDateTime returns true if sref is a date-time string, or an array or
+// object holding them. The schemas on the way to sref, in enclosing, aren't
+// looked into again.
+func holdsDateTime(sref *openapi3.SchemaRef, enclosing []*openapi3.Schema) bool {
+	if sref == nil || sref.Value == nil {
+		return false
+	}
+	schema := sref.Value
+	if schema.Type == "string" && schema.Format == "date-time" {
+		return true
+	}
+	for _, e := range enclosing {
+		if e == schema {
+			return false
+		}
+	}
+	enclosing = append(enclosing, schema)
+	if schema.Items != nil {
+		return holdsDateTime(schema.Items, enclosing)
+	}
+	for _, name := range SortedSchemaKeys(schema.Properties) {
+		if holdsDateTime(schema.Properties[name], enclosing) {
+			return true
+		}
+	}
+	return false
+}
+
+// EnumViolation returns a Go condition which is true when the value of the
+// parameter, as bound by the server, isn't one of its EnumValues. Optional
+// parameters which weren't given pass.
+func (pd ParameterDefinition) EnumViolation() string {
+	value := "params." + pd.GoName()
+	if pd.In == "path" {
+		value = pd.GoVariableName()
+	}
+	var conditions []string
+	if pd.In != "path" && pd.IndirectOptional() {
+		conditions = append(conditions, value+" != nil")
+		value = "*" + value
+	}
+	for _, enumValue := range pd.EnumValues {
+		conditions = append(conditions, value+" != "+enumValue)
+	}
+	return strings.Join(conditions, " && ")
+}
+
+// EnumError returns the message of the error for a value of the parameter
+// which isn't one of its EnumValues.
+func (pd ParameterDefinition) EnumError() string {
+	return fmt.Sprintf("must be one of %s", strings.Join(pd.EnumValues, ", "))
+}
+
+// paramEnumValues returns the Go literals of the values of the enum of a
+// string, integer or number parameter, or nil if it hasn't got one.
+func paramEnumValues(param *openapi3.Parameter) []string {
+	if param.Schema == nil || param.Schema.Value == nil {
+		return nil
+	}
+	schema := param.Schema.Value
+	var values []string
+	for _, value := range schema.Enum {
+		// Parameters are never null, even when their schema is nullable
+		if value == nil {
+			continue
+		}
+		switch schema.Type {
+		case "string":
+			str, ok := value.(string)
+			if !ok {
+				return nil
+			}
+			values = append(values, strconv.Quote(str))
+		case "integer", "number":
+			number, ok := value.(float64)
+			if !ok || (schema.Type == "integer" && number != math.Trunc(number)) {
+				return nil
+			}
+			values = append(values, strconv.FormatFloat(number, 'f', -1, 64))
+		default:
+			return nil
+		}
+	}
+	return values
+}
+
+// ObjectQueryField is a scalar field of an object query parameter, which the
+// client adds to the query on its own.
+type ObjectQueryField struct {
+	Key        string   // The key of the field in the query, like "filter[size]" for a deepObject
+	Conditions []string // The checks that the pointers leading to the field aren't nil
+	Value      string   // The Go expression formatting the field's value as a string
+}
+
+// Condition returns the checks guarding the field, joined into one expression.
+func (f ObjectQueryField) Condition() string {
+	return strings.Join(f.Conditions, " && ")
+}
+
+// ObjectQueryFields returns the fields of an object query parameter with the
+// deepObject style, or the form style exploded, so that the client can add them
+// to the query one by one rather than styling the parameter by reflection at
+// run time. Fields are keyed by their JSON names, and a deepObject may nest
+// objects. It returns nil for any other parameter, and for objects having
+// fields which can't be added this way, such as arrays, additional properties
+// or custom Go types, which are left to the runtime. A deepObject nesting an
+// object which holds itself has no end, so it's an error.
+func (pd ParameterDefinition) ObjectQueryFields() ([]ObjectQueryField, error) {
+	if pd.In != "query" || !pd.IsStyled() {
+		return nil, nil
+	}
+	style, err := pd.Style()
+	if err != nil {
+		return nil, nil
+	}
+	explode, err := pd.Explode()
+	if err != nil {
+		return nil, nil
+	}
+	deepObject := style == "deepObject"
+	if !deepObject && (style != "form" || !explode) {
+		return nil, nil
+	}
+
+	var fields []ObjectQueryField
+	ok, err := appendObjectQueryFields(&fields, pd.Schema, pd.ParamName, "params."+pd.GoName(), nil, deepObject, nil)
+	if err != nil {
+		return nil, fmt.Errorf("query parameter %s: %w", pd.ParamName, err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// appendObjectQueryFields appends the fields of the object schema s, held in
+// the Go expression expr, to fields. It returns false if any of them can't be
+// added to the query on its own. The schemas referred to on the way to s,
+// which nested objects mustn't refer to again, are in enclosing.
+func appendObjectQueryFields(fields *[]ObjectQueryField, s Schema, key, expr string, conditions []string, deepObject bool, enclosing []*openapi3.Schema) (bool, error) {
+	if s.RefOAPISchema != nil {
+		if strings.Contains(s.GoType, ".") || s.RefOAPISchema.Extensions[extPropGoType] != nil {
+			return false, nil
+		}
+		for _, schema := range enclosing {
+			if schema == s.RefOAPISchema {
+				return false, fmt.Errorf("the deepObject %s holds %s, which holds itself", key, s.GoType)
+			}
+		}
+		enclosing = append(enclosing[:len(enclosing):len(enclosing)], s.RefOAPISchema)
+		// References don't carry the properties of the schemas they refer to
+		resolved, err := GenerateGoSchema(openapi3.NewSchemaRef("", s.RefOAPISchema), []string{s.GoType})
+		if err != nil {
+			return false, nil
+		}
+		s = resolved
+	}
+	if s.OAPISchema == nil || s.OAPISchema.Extensions[extPropGoType] != nil ||
+		s.HasAdditionalProperties || len(s.UnionElements) != 0 || len(s.Properties) == 0 {
+		return false, nil
+	}
+
+	for _, p := range s.Properties {
+		if p.HasNullableType() {
+			return false, nil
+		}
+		fieldExpr := expr + "." + p.GoStructFieldName()
+		fieldConditions := conditions
+		pointer := strings.HasPrefix(p.GoTypeDef(), "*")
+		if pointer {
+			fieldConditions = append(fieldConditions[:len(fieldConditions):len(fieldConditions)], fieldExpr+" != nil")
+		}
+		fieldKey := p.JsonTagName()
+		if deepObject {
+			fieldKey = key + "[" + fieldKey + "]"
+		}
+
+		if !p.Schema.IsScalar() {
+			// Only deepObjects can nest objects
+			if !deepObject {
+				return false, nil
+			}
+			ok, err := appendObjectQueryFields(fields, p.Schema, fieldKey, fieldExpr, fieldConditions, deepObject, enclosing)
+			if !ok || err != nil {
+				return false, err
+			}
+			continue
+		}
+
+		valueExpr := fieldExpr
+		if pointer {
+			valueExpr = "*" + fieldExpr
+		}
+		value, ok := formatQueryValue(p.Schema, valueExpr)
+		if !ok {
+			return false, nil
+		}
+		*fields = append(*fields, ObjectQueryField{
+			Key:        fieldKey,
+			Conditions: fieldConditions,
+			Value:      value,
+		})
+	}
+	return true, nil
+}
+
+// formatQueryValue returns a Go expression formatting the scalar held in the Go
+// expression expr, whose schema is s, as a string, or false if it doesn't know
+// how to.
+func formatQueryValue(s Schema, expr string) (string, bool) {
+	schema := s.OAPISchema
+	ref := s.RefOAPISchema != nil
+	if ref {
+		schema = s.RefOAPISchema
+	}
+	if schema == nil || schema.Extensions[extPropGoType] != nil {
+		return "", false
+	}
+	if _, mapped := globalState.options.OutputOptions.TypeMappings[schema.Format]; mapped && schema.Format != "" {
+		return "", false
+	}
+
+	receiver := expr
+	if strings.HasPrefix(expr, "*") {
+		receiver = "(" + expr + ")"
+	}
+
+	switch schema.Type {
+	case "string":
+		switch {
+		case ref && schema.Format != "" && len(schema.Enum) == 0:
+			// The referenced type may not be a string underneath
+			return "", false
+		case s.GoType == "time.Time":
+			layout := "time.RFC3339Nano"
+			if format := globalState.options.OutputOptions.DateTimeFormat; format != "" {
+				layout = fmt.Sprintf("%q", format)
+			}
+			return receiver + ".Format(" + layout + ")", true
+		case s.GoType == "openapi_types.Date" || s.GoType == "openapi_types.UUID":
+			// Dates are formatted as 2006-01-02, as the runtime styles them
+			return receiver + ".String()", true
+		case schema.Format == "byte" || schema.Format == "binary":
+			return "", false
+		default:
+			return "string(" + expr + ")", true
+		}
+	case "integer":
+		if strings.HasPrefix(schema.Format, "uint") {
+			return "strconv.FormatUint(uint64(" + expr + "), 10)", true
+		}
+		return "strconv.FormatInt(int64(" + expr + "), 10)", true
+	case "number":
+		if schema.Format != "double" {
+			return "strconv.FormatFloat(float64(" + expr + "), 'f', -1, 32)", true
+		}
+		return "strconv.FormatFloat(float64(" + expr + "), 'f', -1, 64)", true
+	case "boolean":
+		return "strconv.FormatBool(bool(" + expr + "))", true
+	default:
+		return "", false
+	}
+}
+
 type ParameterDefinitions []ParameterDefinition
 
 func (p ParameterDefinitions) FindByName(name string) *ParameterDefinition {
@@ -164,11 +463,12 @@ func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterD
 		}
 
 		pd := ParameterDefinition{
-			ParamName: param.Name,
-			In:        param.In,
-			Required:  param.Required,
-			Spec:      param,
-			Schema:    goType,
+			ParamName:  param.Name,
+			In:         param.In,
+			Required:   param.Required,
+			Spec:       param,
+			Schema:     goType,
+			EnumValues: paramEnumValues(param),
 		}
 
 		// If this is a reference to a predefined type, simply use the reference
@@ -182,6 +482,14 @@ func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterD
 			}
 			pd.Schema.GoType = goType
 		}
+		style, err := pd.Style()
+		if err != nil {
+			return nil, err
+		}
+		if param.In == "path" && style != "simple" && style != "label" && style != "matrix" {
+			return nil, fmt.Errorf("path parameter %s has style %s, but path parameters can only have the simple, label or matrix styles",
+				param.Name, style)
+		}
 		outParams = append(outParams, pd)
 	}
 	return outParams, nil
@@ -207,7 +515,8 @@ func DescribeSecurityDefinition(securityRequirements openapi3.SecurityRequiremen
 
 // OperationDefinition describes an Operation
 type OperationDefinition struct {
-	OperationId string // The operation_id description from Swagger, used to generate function names
+	OperationId string // The operation_id description from Swagger, used to generate type names
+	MethodName  string // The name of the generated handler and client methods, which defaults to OperationId
 
 	PathParams          []ParameterDefinition // Parameters in the path, eg, /path/:param
 	HeaderParams        []ParameterDefinition // Parameters in HTTP headers
@@ -221,6 +530,12 @@ type OperationDefinition struct {
 	Summary             string                  // Summary string from Swagger, used to generate a comment
 	Method              string                  // GET, POST, DELETE, etc.
 	Path                string                  // The Swagger path for the operation, like /resource/{id}
+	WebSocket           bool                    // Whether the operation is marked with x-websocket, so its handler upgrades the connection itself
+	MaxBodyBytes        int64                   // The limit of the size of request bodies which servers accept, from x-max-body-bytes or the max-body-bytes option, or 0 for none
+	IdempotencyKey      bool                    // Whether clients generate an Idempotency-Key header for the operation's requests, by default for POST and PATCH, unless x-idempotency-key says otherwise
+	ServerURL           string                  // The URL of the operation's own server, if it overrides the spec's, with default variables
+	Deprecated          bool                    // Whether the operation is deprecated, so its generated methods are marked as such
+	DeprecationReason   string                  // Why the operation is deprecated, from x-deprecated-reason, or its description
 	Spec                *openapi3.Operation
 }
 
@@ -254,6 +569,76 @@ func (o *OperationDefinition) HasBody() bool {
 	return o.Spec.RequestBody != nil
 }
 
+// HasNilableBody returns true if the body isn't required, and
+// optional-body-pointers is set, so that the client only sets the
+// Content-Type header when there is a body.
+func (o *OperationDefinition) HasNilableBody() bool {
+	return o.HasBody() && !o.BodyRequired && globalState.options.OutputOptions.OptionalBodyPointers
+}
+
+// HasEventStreamResponse returns true if any of the operation's responses is
+// a text/event-stream, for which the client gets a method to read the events.
+func (o *OperationDefinition) HasEventStreamResponse() bool {
+	for _, response := range o.Responses {
+		for _, content := range response.Contents {
+			if content.NameTag == "EventStream" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AcceptHeader returns the value of the Accept header with which clients ask
+// for any of the content types of the operation's responses, or an empty
+// string if they have none.
+func (o *OperationDefinition) AcceptHeader() string {
+	var contentTypes []string
+	seen := map[string]bool{}
+	for _, responseName := range SortedResponsesKeys(o.Spec.Responses) {
+		response := o.Spec.Responses[responseName].Value
+		if response == nil {
+			continue
+		}
+		for _, contentType := range SortedContentKeys(response.Content) {
+			if !seen[contentType] {
+				seen[contentType] = true
+				contentTypes = append(contentTypes, contentType)
+			}
+		}
+	}
+	return strings.Join(contentTypes, ", ")
+}
+
+// HasNegotiatedResponses returns true if any of the operation's responses has
+// several contents for strict servers to choose from, see NegotiatedContents.
+func (o *OperationDefinition) HasNegotiatedResponses() bool {
+	for _, response := range o.Responses {
+		if response.NegotiatedContents() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasNonExplodedFormQueryParams returns true if any of the operation's query
+// parameters use the form style without exploding, in which case the client
+// joins their values with unescaped commas.
+func (o *OperationDefinition) HasNonExplodedFormQueryParams() bool {
+	for _, param := range o.QueryParams {
+		if !param.IsStyled() {
+			continue
+		}
+		// The parameters were checked by DescribeParameters, so these can't fail
+		style, _ := param.Style()
+		explode, _ := param.Explode()
+		if style == "form" && !explode {
+			return true
+		}
+	}
+	return false
+}
+
 // SummaryAsComment returns the Operations summary as a multi line comment
 func (o *OperationDefinition) SummaryAsComment() string {
 	if o.Summary == "" {
@@ -267,6 +652,31 @@ func (o *OperationDefinition) SummaryAsComment() string {
 	return strings.Join(parts, "\n")
 }
 
+// DeprecationComment returns the "Deprecated:" comment of the operation's
+// generated methods, which Go tooling recognizes, or nothing if it isn't
+// deprecated. Without a reason, it says that the operation is deprecated.
+func (o *OperationDefinition) DeprecationComment() string {
+	if !o.Deprecated {
+		return ""
+	}
+	if o.DeprecationReason == "" {
+		return DeprecationComment("this operation is deprecated.")
+	}
+	return DeprecationComment(o.DeprecationReason)
+}
+
+// isCSVRowsSchema returns true if a text/csv body with the given schema can be
+// read into, and written from, a slice of structs, one for each row, which is
+// the case when it's an array of objects.
+func isCSVRowsSchema(sref *openapi3.SchemaRef) bool {
+	if sref == nil || sref.Value == nil || sref.Value.Type != "array" {
+		return false
+	}
+	items := sref.Value.Items
+	return items != nil && items.Value != nil && items.Value.Extensions[extPropGoType] == nil &&
+		(items.Value.Type == "object" || (items.Value.Type == "" && len(items.Value.Properties) != 0))
+}
+
 // GetResponseTypeDefinitions produces a list of type definitions for a given Operation for the response
 // types which we know how to parse. These will be turned into fields on a
 // response object for automatic deserialization of responses in the generated
@@ -286,24 +696,49 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 				contentType := responseRef.Value.Content[contentTypeName]
 				// We can only generate a type if we have a schema:
 				if contentType.Schema != nil {
-					responseSchema, err := GenerateGoSchema(contentType.Schema, []string{responseName})
-					if err != nil {
-						return nil, fmt.Errorf("Unable to determine Go type for %s.%s: %w", o.OperationId, contentTypeName, err)
-					}
-
-					var typeName string
+					var tag string
 					switch {
 					case StringInArray(contentTypeName, contentTypesJSON):
-						typeName = fmt.Sprintf("JSON%s", ToCamelCase(responseName))
+						tag = "JSON"
 					// YAML:
 					case StringInArray(contentTypeName, contentTypesYAML):
-						typeName = fmt.Sprintf("YAML%s", ToCamelCase(responseName))
+						tag = "YAML"
 					// XML:
 					case StringInArray(contentTypeName, contentTypesXML):
-						typeName = fmt.Sprintf("XML%s", ToCamelCase(responseName))
+						tag = "XML"
+					// CSV, when its rows can be parsed into structs:
+					case StringInArray(contentTypeName, contentTypesCSV) && isCSVRowsSchema(contentType.Schema):
+						tag = "CSV"
 					default:
 						continue
 					}
+					typeName := tag + normalizeName(responseName)
+
+					bodyTypeName := responseBodyTypeName(o.OperationId, responseName, tag)
+					responseSchema, err := GenerateGoSchema(contentType.Schema, []string{bodyTypeName})
+					if err != nil {
+						return nil, fmt.Errorf("Unable to determine Go type for %s.%s: %w", o.OperationId, contentTypeName, err)
+					}
+
+					splitSchema, split, err := generateSplitBodySchema(contentType.Schema, []string{bodyTypeName}, false)
+					if err != nil {
+						return nil, fmt.Errorf("Unable to determine Go type for %s.%s: %w", o.OperationId, contentTypeName, err)
+					}
+					if split {
+						responseSchema = splitSchema
+					}
+
+					if (split || responseSchema.HasAdditionalProperties) && responseSchema.RefType == "" {
+						// An inline object with additional properties needs its
+						// own JSON marshaling, so it has to be a named type.
+						typeDef := TypeDefinition{
+							TypeName: bodyTypeName,
+							JsonName: bodyTypeName,
+							Schema:   responseSchema,
+						}
+						responseSchema.AdditionalTypes = append(responseSchema.AdditionalTypes, typeDef)
+						responseSchema.RefType = bodyTypeName
+					}
 
 					td := ResponseTypeDefinition{
 						TypeDefinition: TypeDefinition{
@@ -313,7 +748,7 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 						ResponseName:    responseName,
 						ContentTypeName: contentTypeName,
 					}
-					if IsGoTypeReference(contentType.Schema.Ref) {
+					if IsGoTypeReference(contentType.Schema.Ref) && !split {
 						refType, err := RefPathToGoType(contentType.Schema.Ref)
 						if err != nil {
 							return nil, fmt.Errorf("error dereferencing response Ref: %w", err)
@@ -328,6 +763,105 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 	return tds, nil
 }
 
+// generateSplitBodySchema generates the schema of a request or response body
+// without its readOnly or writeOnly properties respectively, when the
+// split-read-write-only output option is set. It returns false if there's
+// nothing to leave out, in which case the body's schema can be used as it is.
+func generateSplitBodySchema(sref *openapi3.SchemaRef, path []string, request bool) (Schema, bool, error) {
+	if !globalState.options.OutputOptions.SplitReadWriteOnly || sref == nil || sref.Value == nil {
+		return Schema{}, false, nil
+	}
+
+	// Generate the schema itself rather than a reference to it, so that its
+	// properties can be filtered.
+	schema, err := GenerateGoSchema(&openapi3.SchemaRef{Value: sref.Value}, path)
+	if err != nil {
+		return Schema{}, false, err
+	}
+
+	var properties []Property
+	for _, p := range schema.Properties {
+		if (request && p.ReadOnly) || (!request && p.WriteOnly) {
+			continue
+		}
+		properties = append(properties, p)
+	}
+	if len(properties) == len(schema.Properties) {
+		return Schema{}, false, nil
+	}
+
+	schema.Properties = properties
+	schema.GoType = GenStructFromSchema(schema)
+	return schema, true, nil
+}
+
+// responseBodyTypeName returns the name of the type which is defined for an
+// inline response schema when it can't be expressed as a type literal.
+func responseBodyTypeName(operationID, responseName, tag string) string {
+	return operationID + normalizeName(responseName) + tag + "ResponseBody"
+}
+
+// ExpectedStatusCodes returns the status codes of the operation's responses,
+// in order and once each. Ranges like 2XX are expanded to every code in them,
+// from 200 to 299. The default response has no status code, see
+// HasDefaultResponse.
+func (o OperationDefinition) ExpectedStatusCodes() []int {
+	expected := make(map[int]bool)
+	for _, response := range o.Responses {
+		statusCode := strings.ToUpper(response.StatusCode)
+		if code, err := strconv.Atoi(statusCode); err == nil {
+			expected[code] = true
+			continue
+		}
+		if len(statusCode) == 3 && strings.HasSuffix(statusCode, "XX") {
+			class, err := strconv.Atoi(statusCode[:1])
+			if err != nil {
+				continue
+			}
+			for code := class * 100; code < (class+1)*100; code++ {
+				expected[code] = true
+			}
+		}
+	}
+	codes := make([]int, 0, len(expected))
+	for code := range expected {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+// HasDefaultResponse returns true if the operation has a default response,
+// for the status codes which it has no other response for.
+func (o OperationDefinition) HasDefaultResponse() bool {
+	for _, response := range o.Responses {
+		if response.StatusCode == "default" {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultResponseCondition returns the Go condition on statusCodeVar which
+// is true for the status codes of the default response of the operation:
+// those matching none of its other responses, whether fixed codes, or
+// ranges like 4XX.
+func (o OperationDefinition) DefaultResponseCondition(statusCodeVar string) string {
+	var conditions []string
+	for _, response := range o.Responses {
+		if response.StatusCode == "default" {
+			continue
+		}
+		condition := getConditionOfResponseName(statusCodeVar, response.StatusCode)
+		conditions = append(conditions, strings.Replace(condition, " == ", " != ", 1))
+	}
+	if len(conditions) == 0 {
+		return "true"
+	}
+	sort.Strings(conditions)
+	return strings.Join(conditions, " && ")
+}
+
 func (o OperationDefinition) HasMaskedRequestContentTypes() bool {
 	for _, body := range o.Bodies {
 		if !body.IsFixedContentType() {
@@ -337,6 +871,37 @@ func (o OperationDefinition) HasMaskedRequestContentTypes() bool {
 	return false
 }
 
+// HasRequestContentType returns true if the request objects of strict servers
+// need the Content-Type of the request, which tells apart the bodies of
+// operations with several of them, and is the only record of the actual
+// content type of bodies whose content types have wildcards.
+func (o OperationDefinition) HasRequestContentType() bool {
+	return len(o.Bodies) > 1 || o.HasMaskedRequestContentTypes()
+}
+
+// BodiesByContentTypeSpecificity returns the bodies of the operation in the
+// order strict servers match the request's Content-Type against them: those
+// with a media type first, then those with a subtype wildcard like text/*,
+// and those with */* last, so that a wildcard only takes what isn't matched
+// more specifically.
+func (o OperationDefinition) BodiesByContentTypeSpecificity() []RequestBodyDefinition {
+	bodies := append([]RequestBodyDefinition(nil), o.Bodies...)
+	specificity := func(contentType string) int {
+		switch {
+		case strings.HasPrefix(contentType, "*/*"):
+			return 0
+		case strings.Contains(contentType, "/*"):
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(bodies, func(i, j int) bool {
+		return specificity(bodies[i].ContentType) > specificity(bodies[j].ContentType)
+	})
+	return bodies
+}
+
 // RequestBodyDefinition describes a request body
 type RequestBodyDefinition struct {
 	// Is this body required, or optional?
@@ -362,10 +927,16 @@ type RequestBodyDefinition struct {
 
 // TypeDef returns the Go type definition for a request body
 func (r RequestBodyDefinition) TypeDef(opID string) *TypeDefinition {
-	return &TypeDefinition{
+	td := &TypeDefinition{
 		TypeName: fmt.Sprintf("%s%sRequestBody", opID, r.NameTag),
 		Schema:   r.Schema,
 	}
+	if r.Schema.HasAdditionalProperties {
+		// A type definition would lose the additional properties marshaling
+		// methods of the body type, so alias it instead.
+		td.Schema.DefineViaAlias = true
+	}
+	return td
 }
 
 // CustomType returns whether the body is a custom inline type, or pre-defined. This is
@@ -386,9 +957,20 @@ func (r RequestBodyDefinition) Suffix() string {
 	return "With" + r.NameTag + "Body"
 }
 
+// StrictFieldName returns the name of the body's field in the request object
+// of the strict server. When there are several bodies, each field is prefixed
+// with the NameTag, apart from that of a binary body, which is an io.Reader
+// like the bodies of other content types, and so is just Body.
+func (r RequestBodyDefinition) StrictFieldName(multipleBodies bool) string {
+	if multipleBodies && r.NameTag != "Binary" {
+		return r.NameTag + "Body"
+	}
+	return "Body"
+}
+
 // IsSupportedByClient returns true if we support this content type for client. Otherwise only generic method will ge generated
 func (r RequestBodyDefinition) IsSupportedByClient() bool {
-	return r.NameTag == "JSON" || r.NameTag == "Formdata" || r.NameTag == "Text"
+	return r.NameTag == "JSON" || r.NameTag == "Formdata" || r.NameTag == "Text" || r.NameTag == "Binary"
 }
 
 // IsSupported returns true if we support this content type for server. Otherwise io.Reader will be generated
@@ -396,6 +978,19 @@ func (r RequestBodyDefinition) IsSupported() bool {
 	return r.NameTag != ""
 }
 
+// Nilable returns true if the body isn't required, and optional-body-pointers
+// is set, so that it may be left out of requests.
+func (r RequestBodyDefinition) Nilable() bool {
+	return !r.Required && globalState.options.OutputOptions.OptionalBodyPointers
+}
+
+// IsPointerInClient returns true if the client takes the body by pointer, so
+// that nil can be passed to leave it out. Binary bodies are readers, which
+// can be nil already.
+func (r RequestBodyDefinition) IsPointerInClient() bool {
+	return r.Nilable() && r.NameTag != "Binary"
+}
+
 // IsFixedContentType returns true if content type has fixed content type, i.e. contains no "*" symbol
 func (r RequestBodyDefinition) IsFixedContentType() bool {
 	return !strings.Contains(r.ContentType, "*")
@@ -428,6 +1023,41 @@ func (r ResponseDefinition) IsRef() bool {
 	return r.Ref != ""
 }
 
+// RefName returns the name of the referenced response, without the package
+// of an external reference.
+func (r ResponseDefinition) RefName() string {
+	name := UppercaseFirstCharacterWithPkgName(r.Ref)
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// IsError returns true for the responses which report errors, those with a
+// 4XX or 5XX status code, and the default response.
+func (r ResponseDefinition) IsError() bool {
+	return r.StatusCode == "default" || strings.HasPrefix(r.StatusCode, "4") || strings.HasPrefix(r.StatusCode, "5")
+}
+
+// NegotiatedContents returns the contents of the response which strict servers
+// can marshal themselves, as JSON, XML or YAML, if it has several of them with
+// the same Go type. Strict servers then choose one of them according to the
+// Accept header of the request. It returns nil otherwise.
+func (r ResponseDefinition) NegotiatedContents() []ResponseContentDefinition {
+	var contents []ResponseContentDefinition
+	for _, content := range r.Contents {
+		if content.Encoding() == "" || !content.HasFixedContentType() || content.Schema.GoType == "" ||
+			len(content.Schema.GetAdditionalTypeDefs()) != 0 {
+			continue
+		}
+		if len(contents) != 0 && content.Schema.TypeDecl() != contents[0].Schema.TypeDecl() {
+			return nil
+		}
+		contents = append(contents, content)
+	}
+	if len(contents) < 2 {
+		return nil
+	}
+	return contents
+}
+
 type ResponseContentDefinition struct {
 	// This is the schema describing this content
 	Schema Schema
@@ -448,6 +1078,40 @@ func (r ResponseContentDefinition) TypeDef(opID string, statusCode int) *TypeDef
 	}
 }
 
+// Encoding returns how the content is marshaled, JSON, XML, YAML or CSV, or
+// an empty string for other content types.
+func (r ResponseContentDefinition) Encoding() string {
+	switch {
+	case r.NameTag == "JSON":
+		return "JSON"
+	case StringInArray(r.ContentType, contentTypesXML):
+		return "XML"
+	case StringInArray(r.ContentType, contentTypesYAML):
+		return "YAML"
+	case r.NameTag == "CSV":
+		return "CSV"
+	default:
+		return ""
+	}
+}
+
+// CanDefineError returns true if the strict server's response type for the
+// content, when it's defined as its schema's type, can have an Error method.
+// That's not the case for pointers and interfaces, nor for structs with an
+// Error field.
+func (r ResponseContentDefinition) CanDefineError() bool {
+	typeDecl := r.Schema.TypeDecl()
+	if strings.HasPrefix(typeDecl, "*") || strings.HasPrefix(typeDecl, "interface{") {
+		return false
+	}
+	for _, p := range r.Schema.Properties {
+		if p.GoStructFieldName() == "Error" {
+			return false
+		}
+	}
+	return true
+}
+
 func (r ResponseContentDefinition) IsSupported() bool {
 	return r.NameTag != ""
 }
@@ -485,6 +1149,11 @@ func FilterParameterDefinitionByType(params []ParameterDefinition, in string) []
 // OperationDefinitions returns all operations for a swagger definition.
 func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 	var operations []OperationDefinition
+	// Maps each method name to the path and method of the operation using it
+	methodNames := make(map[string]string)
+	// Maps each route, a method and a path without the names of its
+	// parameters, to the path and method of the operation using it
+	routes := make(map[string]string)
 
 	for _, requestPath := range SortedPathsKeys(swagger.Paths) {
 		pathItem := swagger.Paths[requestPath]
@@ -500,9 +1169,11 @@ func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 		pathOps := pathItem.Operations()
 		for _, opName := range SortedOperationsKeys(pathOps) {
 			op := pathOps[opName]
-			if pathItem.Servers != nil {
+			// Servers on the operation take precedence over those on the path
+			if op.Servers == nil && pathItem.Servers != nil {
 				op.Servers = &pathItem.Servers
 			}
+			specOperationID := op.OperationID
 			// We rely on OperationID to generate function names, it's required
 			if op.OperationID == "" {
 				op.OperationID, err = generateDefaultOperationID(opName, requestPath)
@@ -511,10 +1182,14 @@ func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 						opName, requestPath, err)
 				}
 			} else {
-				op.OperationID = ToCamelCase(op.OperationID)
+				op.OperationID = normalizeName(op.OperationID)
 			}
 			op.OperationID = typeNamePrefix(op.OperationID) + op.OperationID
 
+			if operationIsFiltered(globalState.options.OutputOptions, specOperationID, op.OperationID) {
+				continue
+			}
+
 			// These are parameters defined for the specific path method that
 			// we're iterating over.
 			localParams, err := DescribeParameters(op.Parameters, []string{op.OperationID + "Params"})
@@ -534,6 +1209,26 @@ func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 			if err != nil {
 				return nil, err
 			}
+			if err := setResourcePatterns(requestPath, pathParams); err != nil {
+				return nil, err
+			}
+
+			// Query, header and cookie parameters share the Params struct,
+			// so their JSON tags mustn't conflict.
+			var objectParamNames []string
+			for _, param := range allParams {
+				if param.In != "path" {
+					objectParamNames = append(objectParamNames, param.ParamName)
+				}
+			}
+			if err := checkJSONTagConflicts(objectParamNames); err != nil {
+				return nil, fmt.Errorf("error generating JSON tags for %s parameters: %w", op.OperationID, err)
+			}
+			for _, param := range allParams {
+				if _, err := param.ObjectQueryFields(); err != nil {
+					return nil, fmt.Errorf("error generating %s: %w", op.OperationID, err)
+				}
+			}
 
 			bodyDefinitions, typeDefinitions, err := GenerateBodyDefinitions(op.OperationID, op.RequestBody)
 			if err != nil {
@@ -545,25 +1240,96 @@ func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 				return nil, fmt.Errorf("error generating response definitions: %w", err)
 			}
 
-			opDef := OperationDefinition{
-				PathParams:   pathParams,
-				HeaderParams: FilterParameterDefinitionByType(allParams, "header"),
-				QueryParams:  FilterParameterDefinitionByType(allParams, "query"),
-				CookieParams: FilterParameterDefinitionByType(allParams, "cookie"),
-				OperationId:  ToCamelCase(op.OperationID),
-				// Replace newlines in summary.
-				Summary:         op.Summary,
-				Method:          opName,
-				Path:            requestPath,
-				Spec:            op,
-				Bodies:          bodyDefinitions,
-				Responses:       responseDefinitions,
-				TypeDefinitions: typeDefinitions,
+			methodName := normalizeName(op.OperationID)
+			if extension, ok := op.Extensions[extGoMethodName]; ok {
+				name, err := extParseGoMethodName(extension)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value for %q on %s %s: %w", extGoMethodName, opName, requestPath, err)
+				}
+				methodName = normalizeName(name)
 			}
+			if other, found := methodNames[methodName]; found {
+				return nil, fmt.Errorf("operations %s and %s %s both have the method name %s", other, opName, requestPath, methodName)
+			}
+			methodNames[methodName] = opName + " " + requestPath
 
-			// check for overrides of SecurityDefinitions.
-			// See: "Step 2. Applying security:" from the spec:
-			// https://swagger.io/docs/specification/authentication/
+			// Paths which only differ by the names of their parameters match
+			// the same requests, which routers refuse to register twice
+			route := opName + " " + pathParamRE.ReplaceAllString(requestPath, "{}")
+			if other, found := routes[route]; found {
+				return nil, fmt.Errorf("operations %s and %s %s have the same route, since their paths only differ by the names of their parameters", other, opName, requestPath)
+			}
+			routes[route] = opName + " " + requestPath
+
+			webSocket := false
+			if extension, ok := op.Extensions[extWebSocket]; ok {
+				webSocket, err = extParseWebSocket(extension)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value for %q on %s %s: %w", extWebSocket, opName, requestPath, err)
+				}
+			}
+			if webSocket && opName != http.MethodGet {
+				return nil, fmt.Errorf("%s %s can't be a WebSocket operation, only GET requests can be upgraded", opName, requestPath)
+			}
+			if webSocket && op.RequestBody != nil {
+				return nil, fmt.Errorf("WebSocket operation %s %s can't have a request body", opName, requestPath)
+			}
+
+			maxBodyBytes := globalState.options.OutputOptions.MaxBodyBytes
+			if extension, ok := op.Extensions[extMaxBodyBytes]; ok {
+				maxBodyBytes, err = extParseMaxBodyBytes(extension)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value for %q on %s %s: %w", extMaxBodyBytes, opName, requestPath, err)
+				}
+			}
+
+			idempotencyKey := opName == http.MethodPost || opName == http.MethodPatch
+			if extension, ok := op.Extensions[extIdempotencyKey]; ok {
+				idempotencyKey, err = extParseIdempotencyKey(extension)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value for %q on %s %s: %w", extIdempotencyKey, opName, requestPath, err)
+				}
+			}
+
+			deprecationReason := op.Description
+			if extension, ok := op.Extensions[extDeprecationReason]; ok {
+				deprecationReason, err = extParseDeprecationReason(extension)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value for %q on %s %s: %w", extDeprecationReason, opName, requestPath, err)
+				}
+			}
+
+			opDef := OperationDefinition{
+				PathParams:   pathParams,
+				HeaderParams: FilterParameterDefinitionByType(allParams, "header"),
+				QueryParams:  FilterParameterDefinitionByType(allParams, "query"),
+				CookieParams: FilterParameterDefinitionByType(allParams, "cookie"),
+				OperationId:  normalizeName(op.OperationID),
+				MethodName:   methodName,
+				// Replace newlines in summary.
+				Summary:         op.Summary,
+				Method:          opName,
+				Path:            requestPath,
+				Spec:            op,
+				Bodies:          bodyDefinitions,
+				Responses:       responseDefinitions,
+				TypeDefinitions: typeDefinitions,
+				WebSocket:       webSocket,
+				MaxBodyBytes:    maxBodyBytes,
+				IdempotencyKey:  idempotencyKey,
+				Deprecated:      op.Deprecated,
+			}
+			if op.Deprecated {
+				opDef.DeprecationReason = deprecationReason
+			}
+
+			if op.Servers != nil && len(*op.Servers) > 0 {
+				opDef.ServerURL = serverDefaultURL((*op.Servers)[0])
+			}
+
+			// check for overrides of SecurityDefinitions.
+			// See: "Step 2. Applying security:" from the spec:
+			// https://swagger.io/docs/specification/authentication/
 			if op.Security != nil {
 				opDef.SecurityDefinitions = DescribeSecurityDefinition(*op.Security)
 			} else {
@@ -580,11 +1346,30 @@ func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 			}
 
 			// Generate all the type definitions needed for this operation
-			opDef.TypeDefinitions = append(opDef.TypeDefinitions, GenerateTypeDefsForOperation(opDef)...)
+			operationTypeDefinitions, err := GenerateTypeDefsForOperation(opDef)
+			if err != nil {
+				return nil, fmt.Errorf("error generating type definitions for %s: %w", opDef.OperationId, err)
+			}
+			opDef.TypeDefinitions = append(opDef.TypeDefinitions, operationTypeDefinitions...)
+
+			// Inline response schemas may need types of their own, too
+			responseTypeDefinitions, err := opDef.GetResponseTypeDefinitions()
+			if err != nil {
+				return nil, fmt.Errorf("error generating response type definitions for %s: %w", opDef.OperationId, err)
+			}
+			for _, td := range responseTypeDefinitions {
+				opDef.TypeDefinitions = append(opDef.TypeDefinitions, td.Schema.GetAdditionalTypeDefs()...)
+			}
 
 			operations = append(operations, opDef)
 		}
 	}
+
+	outputOptions := globalState.options.OutputOptions
+	if len(operations) == 0 && (len(outputOptions.IncludeOperations) > 0 || len(outputOptions.ExcludeOperations) > 0) {
+		return nil, fmt.Errorf("no operations left after applying include-operations %v and exclude-operations %v",
+			outputOptions.IncludeOperations, outputOptions.ExcludeOperations)
+	}
 	return operations, nil
 }
 
@@ -605,7 +1390,7 @@ func generateDefaultOperationID(opName string, requestPath string) (string, erro
 		}
 	}
 
-	return ToCamelCase(operationId), nil
+	return normalizeName(operationId), nil
 }
 
 // GenerateBodyDefinitions turns the Swagger body definitions into a list of our body
@@ -634,6 +1419,16 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 			tag = "Formdata"
 		case contentType == "text/plain":
 			tag = "Text"
+		case contentType == "application/octet-stream":
+			// Binary bodies are streamed as they are, whatever their schema
+			bd := RequestBodyDefinition{
+				Required:    body.Required,
+				Schema:      Schema{GoType: "io.Reader", DefineViaAlias: true},
+				NameTag:     "Binary",
+				ContentType: contentType,
+			}
+			bodyDefinitions = append(bodyDefinitions, bd)
+			continue
 		default:
 			bd := RequestBodyDefinition{
 				Required:    body.Required,
@@ -649,8 +1444,20 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 			return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
 		}
 
+		split := false
+		if tag == "JSON" || tag == "Formdata" {
+			var splitSchema Schema
+			splitSchema, split, err = generateSplitBodySchema(content.Schema, []string{bodyTypeName}, true)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
+			}
+			if split {
+				bodySchema = splitSchema
+			}
+		}
+
 		// If the body is a pre-defined type
-		if IsGoTypeReference(content.Schema.Ref) {
+		if IsGoTypeReference(content.Schema.Ref) && !split {
 			// Convert the reference path to Go type
 			refType, err := RefPathToGoType(content.Schema.Ref)
 			if err != nil {
@@ -733,10 +1540,24 @@ func GenerateResponseDefinitions(operationID string, responses openapi3.Response
 				tag = "Multipart"
 			case contentType == "text/plain":
 				tag = "Text"
+			case contentType == "text/event-stream":
+				tag = "EventStream"
+			case StringInArray(contentType, contentTypesCSV) && isCSVRowsSchema(content.Schema):
+				tag = "CSV"
 			default:
 				rcd := ResponseContentDefinition{
 					ContentType: contentType,
 				}
+				// Strict servers can marshal XML and YAML bodies when they
+				// negotiate the content type, see NegotiatedContents.
+				if StringInArray(contentType, contentTypesXML) || StringInArray(contentType, contentTypesYAML) {
+					responseTypeName := operationID + statusCode + rcd.NameTagOrContentType() + "Response"
+					contentSchema, err := GenerateGoSchema(content.Schema, []string{responseTypeName})
+					if err != nil {
+						return nil, fmt.Errorf("error generating response definition: %w", err)
+					}
+					rcd.Schema = contentSchema
+				}
 				responseContentDefinitions = append(responseContentDefinitions, rcd)
 				continue
 			}
@@ -746,6 +1567,17 @@ func GenerateResponseDefinitions(operationID string, responses openapi3.Response
 			if err != nil {
 				return nil, fmt.Errorf("error generating request body definition: %w", err)
 			}
+			// Only the JSON content types which the client parses get a body
+			// type, see GetResponseTypeDefinitions.
+			if operationID != "" && StringInArray(contentType, contentTypesJSON) {
+				_, split, err := generateSplitBodySchema(content.Schema, []string{responseTypeName}, false)
+				if err != nil {
+					return nil, fmt.Errorf("error generating request body definition: %w", err)
+				}
+				if split || (contentSchema.HasAdditionalProperties && contentSchema.RefType == "") {
+					contentSchema.RefType = responseBodyTypeName(operationID, statusCode, tag)
+				}
+			}
 
 			rcd := ResponseContentDefinition{
 				ContentType: contentType,
@@ -775,8 +1607,10 @@ func GenerateResponseDefinitions(operationID string, responses openapi3.Response
 			rd.Description = *response.Description
 		}
 		if IsGoTypeReference(responseOrRef.Ref) {
-			// Convert the reference path to Go type
-			refType, err := RefPathToGoType(responseOrRef.Ref)
+			// Convert the reference path to Go type. The response types of the
+			// spec's own components are generated along with the strict server,
+			// rather than the models, so they're never in the models package.
+			refType, err := refPathToGoType(responseOrRef.Ref, true)
 			if err != nil {
 				return nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", responseOrRef.Ref, err)
 			}
@@ -794,11 +1628,15 @@ func GenerateResponseDefinitions(operationID string, responses openapi3.Response
 	return responseDefinitions, nil
 }
 
-func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
+func GenerateTypeDefsForOperation(op OperationDefinition) ([]TypeDefinition, error) {
 	var typeDefs []TypeDefinition
 	// Start with the params object itself
 	if len(op.Params()) != 0 {
-		typeDefs = append(typeDefs, GenerateParamsTypes(op)...)
+		paramsTypes, err := GenerateParamsTypes(op)
+		if err != nil {
+			return nil, err
+		}
+		typeDefs = append(typeDefs, paramsTypes...)
 	}
 
 	// Now, go through all the additional types we need to declare.
@@ -809,12 +1647,62 @@ func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
 	for _, body := range op.Bodies {
 		typeDefs = append(typeDefs, body.Schema.GetAdditionalTypeDefs()...)
 	}
-	return typeDefs
+	return typeDefs, nil
+}
+
+// setResourcePatterns sets the resource name patterns of the path parameters
+// which have them in path, which must be string parameters.
+func setResourcePatterns(path string, pathParams []ParameterDefinition) error {
+	patterns := ResourcePatternsFromUri(path)
+	for i := range pathParams {
+		param := &pathParams[i]
+		pattern, found := patterns[param.ParamName]
+		if !found {
+			continue
+		}
+		if param.Spec.Schema == nil || param.Schema.GoType != "string" {
+			return fmt.Errorf("path parameter %s in '%s' has a resource name pattern, so must be a string", param.ParamName, path)
+		}
+		segments := strings.Split(pattern, "/")
+		for j, segment := range segments {
+			if segment == "" {
+				return fmt.Errorf("resource name pattern %s in '%s' has an empty segment", pattern, path)
+			}
+			if segment == "**" && (j != len(segments)-1 || !strings.HasSuffix(path, pattern+"}")) {
+				return fmt.Errorf("resource name pattern %s in '%s' can only have ** at the end of the path", pattern, path)
+			}
+		}
+		param.ResourcePattern = pattern
+	}
+	return nil
+}
+
+// checkResourcePatternsSupported returns an error if any of ops has a path
+// parameter with a resource name pattern, but a server is to be generated for
+// a router which can't match them, as only chi and gorilla can.
+func checkResourcePatternsSupported(ops []OperationDefinition, opts GenerateOptions) error {
+	var router string
+	switch {
+	case opts.EchoServer:
+		router = "echo"
+	case opts.GinServer:
+		router = "gin"
+	default:
+		return nil
+	}
+	for _, op := range ops {
+		for _, param := range op.PathParams {
+			if param.ResourcePattern != "" {
+				return fmt.Errorf("path parameter %s of %s %s has a resource name pattern, which %s servers don't support", param.ParamName, op.Method, op.Path, router)
+			}
+		}
+	}
+	return nil
 }
 
 // GenerateParamsTypes defines the schema for a parameters definition object
 // which encapsulates all the query, header and cookie parameters for an operation.
-func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
+func GenerateParamsTypes(op OperationDefinition) ([]TypeDefinition, error) {
 	var typeDefs []TypeDefinition
 
 	objectParams := op.QueryParams
@@ -826,7 +1714,10 @@ func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 	s := Schema{}
 	for _, param := range objectParams {
 		pSchema := param.Schema
-		param.Style()
+		style, err := param.Style()
+		if err != nil {
+			return nil, err
+		}
 		if pSchema.HasAdditionalProperties {
 			propRefName := strings.Join([]string{typeName, param.GoName()}, "_")
 			pSchema.RefType = propRefName
@@ -840,12 +1731,16 @@ func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 			JsonFieldName: param.ParamName,
 			Required:      param.Required,
 			Schema:        pSchema,
-			NeedsFormTag:  param.Style() == "form",
+			NeedsFormTag:  style == "form",
 			Extensions:    param.Spec.Extensions,
 		}
 		s.Properties = append(s.Properties, prop)
 	}
 
+	if err := checkFieldNameConflicts(s.Properties); err != nil {
+		return nil, fmt.Errorf("error generating fields for %s: %w", typeName, err)
+	}
+
 	s.Description = op.Spec.Description
 	s.GoType = GenStructFromSchema(s)
 
@@ -853,7 +1748,7 @@ func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 		TypeName: typeName,
 		Schema:   s,
 	}
-	return append(typeDefs, td)
+	return append(typeDefs, td), nil
 }
 
 // GenerateTypesForOperations generates code for all types produced within operations
@@ -889,6 +1784,78 @@ func GenerateTypesForOperations(t *template.Template, ops []OperationDefinition)
 		return "", fmt.Errorf("error generating additional properties boilerplate for operations: %w", err)
 	}
 
+	nullable, err := GenerateNullableBoilerplate(t, td)
+	if err != nil {
+		return "", fmt.Errorf("error generating nullable boilerplate for operations: %w", err)
+	}
+
+	if _, err := w.WriteString(nullable); err != nil {
+		return "", fmt.Errorf("error generating nullable boilerplate for operations: %w", err)
+	}
+
+	constructors, err := GenerateConstructors(t, td)
+	if err != nil {
+		return "", fmt.Errorf("error generating constructors for operations: %w", err)
+	}
+
+	if _, err := w.WriteString(constructors); err != nil {
+		return "", fmt.Errorf("error generating constructors for operations: %w", err)
+	}
+
+	defaults, err := GenerateDefaults(t, td)
+	if err != nil {
+		return "", fmt.Errorf("error generating defaults for operations: %w", err)
+	}
+
+	if _, err := w.WriteString(defaults); err != nil {
+		return "", fmt.Errorf("error generating defaults for operations: %w", err)
+	}
+
+	validators, err := GenerateValidators(t, td)
+	if err != nil {
+		return "", fmt.Errorf("error generating validators for operations: %w", err)
+	}
+
+	if _, err := w.WriteString(validators); err != nil {
+		return "", fmt.Errorf("error generating validators for operations: %w", err)
+	}
+
+	patternProperties, err := GeneratePatternProperties(t, td)
+	if err != nil {
+		return "", fmt.Errorf("error generating validators of patternProperties for operations: %w", err)
+	}
+
+	if _, err := w.WriteString(patternProperties); err != nil {
+		return "", fmt.Errorf("error generating validators of patternProperties for operations: %w", err)
+	}
+
+	// The request body types are defined as the types of the bodies
+	bodyTypes := td
+	for _, op := range ops {
+		for _, body := range op.Bodies {
+			if body.IsSupported() {
+				bodyTypes = append(bodyTypes, *body.TypeDef(op.OperationId))
+			}
+		}
+	}
+	tuples, err := GenerateTupleBoilerplate(t, bodyTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating tuple boilerplate for operations: %w", err)
+	}
+
+	if _, err := w.WriteString(tuples); err != nil {
+		return "", fmt.Errorf("error generating tuple boilerplate for operations: %w", err)
+	}
+
+	rejectUnknownFields, err := GenerateRejectUnknownFields(t, bodyTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating unmarshalers rejecting unknown fields for operations: %w", err)
+	}
+
+	if _, err := w.WriteString(rejectUnknownFields); err != nil {
+		return "", fmt.Errorf("error generating unmarshalers rejecting unknown fields for operations: %w", err)
+	}
+
 	if err = w.Flush(); err != nil {
 		return "", fmt.Errorf("error flushing output buffer for server interface: %w", err)
 	}
@@ -896,6 +1863,59 @@ func GenerateTypesForOperations(t *template.Template, ops []OperationDefinition)
 	return buf.String(), nil
 }
 
+// ServerInterfaceDefinition describes one of the interfaces making up the
+// ServerInterface, holding the operations whose handlers it declares.
+type ServerInterfaceDefinition struct {
+	TypeName   string                // The name of the interface, like ServerInterfacePets
+	Tag        string                // The first tag of the operations, empty for untagged ones
+	Operations []OperationDefinition // The operations, in the order they were given
+}
+
+// GroupOperationsByTag groups operations into an interface per first tag,
+// named ServerInterface followed by the tag, sorted by name. Operations without
+// tags go into ServerInterfaceDefault. It fails if different tags, such as
+// default and no tag at all, would give interfaces the same name, or if a tag,
+// such as wrapper, would give one the name of the ServerInterfaceWrapper.
+func GroupOperationsByTag(ops []OperationDefinition) ([]ServerInterfaceDefinition, error) {
+	groups := make(map[string]*ServerInterfaceDefinition)
+	for _, op := range ops {
+		tag := ""
+		if op.Spec != nil && len(op.Spec.Tags) > 0 {
+			tag = op.Spec.Tags[0]
+		}
+		typeName := "ServerInterfaceDefault"
+		if tag != "" {
+			typeName = "ServerInterface" + SchemaNameToTypeName(tag)
+		}
+		if typeName == "ServerInterfaceWrapper" {
+			return nil, fmt.Errorf("the operations tagged %q would be in %s, which wraps the ServerInterface, "+
+				"please use another tag", tag, typeName)
+		}
+		group, found := groups[typeName]
+		if !found {
+			group = &ServerInterfaceDefinition{TypeName: typeName, Tag: tag}
+			groups[typeName] = group
+		} else if group.Tag != tag {
+			if group.Tag == "" || tag == "" {
+				return nil, fmt.Errorf("the operations tagged %q and those without tags would both be in %s, "+
+					"please use another tag", group.Tag+tag, typeName)
+			}
+			return nil, fmt.Errorf("the operations tagged %q and %q would both be in %s, please use the same tag for them",
+				group.Tag, tag, typeName)
+		}
+		group.Operations = append(group.Operations, op)
+	}
+
+	result := make([]ServerInterfaceDefinition, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].TypeName < result[j].TypeName
+	})
+	return result, nil
+}
+
 // GenerateChiServer This function generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateChiServer(t *template.Template, operations []OperationDefinition) (string, error) {
@@ -920,6 +1940,231 @@ func GenerateGorillaServer(t *template.Template, operations []OperationDefinitio
 	return GenerateTemplates([]string{"gorilla/gorilla-interface.tmpl", "gorilla/gorilla-middleware.tmpl", "gorilla/gorilla-register.tmpl"}, t, operations)
 }
 
+// GenerateServerInterface generates the ServerInterface of a net/http server,
+// without the wrappers of any framework, for teams which route requests to it
+// themselves. Unless the models are generated too, or are in the models
+// package, it comes with the types of the parameters and bodies of the
+// operations, which it uses.
+func GenerateServerInterface(t *template.Template, ops []OperationDefinition, opts Configuration) (string, error) {
+	out, err := GenerateTemplates([]string{"chi/chi-interface.tmpl"}, t, ops)
+	if err != nil || opts.Generate.Models || opts.OutputOptions.ModelsPackage != "" {
+		return out, err
+	}
+
+	var typeDefs []TypeDefinition
+	for _, op := range ops {
+		typeDefs = append(typeDefs, op.TypeDefinitions...)
+	}
+	enumsOut, err := GenerateEnums(t, typeDefs)
+	if err != nil {
+		return "", fmt.Errorf("error generating enums for operations: %w", err)
+	}
+	typesOut, err := GenerateTypesForOperations(t, ops)
+	if err != nil {
+		return "", fmt.Errorf("error generating types for operations: %w", err)
+	}
+	return enumsOut + typesOut + out, nil
+}
+
+// GenerateValidationMiddleware generates middleware for the configured server
+// which validates requests against the embedded spec.
+func GenerateValidationMiddleware(t *template.Template, opts Configuration) (string, error) {
+	templates := []string{"validation-middleware.tmpl"}
+	switch {
+	case opts.Generate.EchoServer:
+		templates = append(templates, "echo/echo-validation-middleware.tmpl")
+	case opts.Generate.GinServer:
+		templates = append(templates, "gin/gin-validation-middleware.tmpl")
+	case opts.Generate.ChiServer || opts.Generate.GorillaServer:
+		templates = append(templates, "chi/chi-validation-middleware.tmpl")
+	default:
+		return "", errors.New("validation middleware can only be generated along with a chi, echo, gin or gorilla server")
+	}
+	return GenerateTemplates(templates, t, nil)
+}
+
+// MockResponseDefinition describes how MockServer responds to an operation.
+type MockResponseDefinition struct {
+	OperationDefinition
+	StatusCode  int    // The status code of the lowest 2xx response, or 501 when there isn't one
+	ContentType string // The content type of the body, which is empty when there's none
+	Example     string // The body, from the first example of its content, if it has one
+	ZeroType    string // The Go type whose zero value is written as JSON when there's no example
+}
+
+// DescribeMockResponses describes the response of MockServer to each
+// operation, which is its lowest 2xx response. Its body is the first example
+// of its JSON content, or its first other content with a fixed content type,
+// and when it has no example, the zero value of the JSON content's type.
+func DescribeMockResponses(ops []OperationDefinition) ([]MockResponseDefinition, error) {
+	var mocks []MockResponseDefinition
+	for _, op := range ops {
+		mock := MockResponseDefinition{OperationDefinition: op, StatusCode: http.StatusNotImplemented}
+		var response *ResponseDefinition
+		for i, rd := range op.Responses {
+			statusCode, err := strconv.Atoi(rd.StatusCode)
+			if strings.EqualFold(rd.StatusCode, "2XX") {
+				statusCode, err = http.StatusOK, nil
+			}
+			if err != nil || statusCode < 200 || statusCode > 299 {
+				continue
+			}
+			if response == nil || statusCode < mock.StatusCode {
+				response, mock.StatusCode = &op.Responses[i], statusCode
+			}
+		}
+		if response != nil {
+			if content := mockContent(response.Contents); content != nil {
+				mock.ContentType = content.ContentType
+				example, err := mockExample(op.Spec.Responses[response.StatusCode].Value.Content[content.ContentType], content)
+				if err != nil {
+					return nil, fmt.Errorf("error describing the mock response to %s: %w", op.OperationId, err)
+				}
+				mock.Example = example
+				if example == "" && content.NameTag == "JSON" && content.Schema.GoType != "" &&
+					len(content.Schema.GetAdditionalTypeDefs()) == 0 {
+					mock.ZeroType = content.Schema.TypeDecl()
+				}
+			}
+		}
+		mocks = append(mocks, mock)
+	}
+	return mocks, nil
+}
+
+// mockContent returns the content of a response which MockServer writes,
+// preferring JSON, or nil if none of them has a fixed content type.
+func mockContent(contents []ResponseContentDefinition) *ResponseContentDefinition {
+	var found *ResponseContentDefinition
+	for i, content := range contents {
+		if !content.HasFixedContentType() {
+			continue
+		}
+		if content.NameTag == "JSON" {
+			return &contents[i]
+		}
+		if found == nil {
+			found = &contents[i]
+		}
+	}
+	return found
+}
+
+// mockExample returns the body of the first example of mediaType: its
+// example, or the first of its named examples, or the example of its schema.
+// JSON examples are marshaled, while for other content types, only examples
+// which are strings can be written. It returns an empty string when there's no
+// example which can be.
+func mockExample(mediaType *openapi3.MediaType, content *ResponseContentDefinition) (string, error) {
+	example := mediaType.Example
+	if example == nil {
+		names := make([]string, 0, len(mediaType.Examples))
+		for name := range mediaType.Examples {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if ref := mediaType.Examples[name]; ref != nil && ref.Value != nil && ref.Value.Value != nil {
+				example = ref.Value.Value
+				break
+			}
+		}
+	}
+	if example == nil && mediaType.Schema != nil && mediaType.Schema.Value != nil {
+		example = mediaType.Schema.Value.Example
+	}
+	if example == nil {
+		return "", nil
+	}
+	if content.NameTag == "JSON" {
+		data, err := json.Marshal(example)
+		if err != nil {
+			return "", fmt.Errorf("error marshaling example of %s: %w", content.ContentType, err)
+		}
+		return string(data), nil
+	}
+	str, _ := example.(string)
+	return str, nil
+}
+
+// GenerateMockServer generates MockServer, an implementation of the
+// ServerInterface of the configured server which responds with examples from
+// the spec, and RegisterMockHandlers, adding its handlers to a router.
+func GenerateMockServer(t *template.Template, ops []OperationDefinition, opts Configuration) (string, error) {
+	templates := []string{"mock-server.tmpl"}
+	switch {
+	case opts.Generate.EchoServer:
+		templates = append(templates, "echo/echo-mock-server.tmpl")
+	case opts.Generate.GinServer:
+		templates = append(templates, "gin/gin-mock-server.tmpl")
+	case opts.Generate.ChiServer:
+		templates = append(templates, "chi/chi-mock-server.tmpl")
+	case opts.Generate.GorillaServer:
+		templates = append(templates, "gorilla/gorilla-mock-server.tmpl")
+	default:
+		return "", errors.New("mock server can only be generated along with a chi, echo, gin or gorilla server")
+	}
+	mocks, err := DescribeMockResponses(ops)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates(templates, t, mocks)
+}
+
+// HealthEndpointsDefinition describes the health endpoints added by
+// RegisterHealthHandlers, which are those the spec doesn't have.
+type HealthEndpointsDefinition struct {
+	Liveness  bool // Whether to add the /healthz liveness endpoint
+	Readiness bool // Whether to add the /readyz readiness endpoint
+}
+
+// GenerateHealthEndpoints generates RegisterHealthHandlers for the configured
+// server, adding liveness and readiness endpoints to its router unless the
+// spec already has paths for them. Nothing is generated when it has both.
+func GenerateHealthEndpoints(t *template.Template, spec *openapi3.T, opts Configuration) (string, error) {
+	health := HealthEndpointsDefinition{
+		Liveness:  spec.Paths.Find("/healthz") == nil,
+		Readiness: spec.Paths.Find("/readyz") == nil,
+	}
+	if !health.Liveness && !health.Readiness {
+		return "", nil
+	}
+	templates := []string{"health-endpoints.tmpl"}
+	switch {
+	case opts.Generate.EchoServer:
+		templates = append(templates, "echo/echo-health.tmpl")
+	case opts.Generate.GinServer:
+		templates = append(templates, "gin/gin-health.tmpl")
+	case opts.Generate.ChiServer:
+		templates = append(templates, "chi/chi-health.tmpl")
+	case opts.Generate.GorillaServer:
+		templates = append(templates, "gorilla/gorilla-health.tmpl")
+	default:
+		return "", errors.New("health endpoints can only be generated along with a chi, echo, gin or gorilla server")
+	}
+	return GenerateTemplates(templates, t, health)
+}
+
+// GenerateIdempotencyKeyMiddleware generates IdempotencyKeyMiddleware, which
+// puts the Idempotency-Key header of requests in their context, for the chi,
+// echo, gin or gorilla server, if there is one.
+func GenerateIdempotencyKeyMiddleware(t *template.Template, opts Configuration) (string, error) {
+	templates := []string{"idempotency-key.tmpl"}
+	switch {
+	case opts.Generate.EchoServer:
+		templates = append(templates, "echo/echo-idempotency-key.tmpl")
+	case opts.Generate.GinServer:
+		templates = append(templates, "gin/gin-idempotency-key.tmpl")
+	case opts.Generate.ChiServer:
+		templates = append(templates, "chi/chi-idempotency-key.tmpl")
+	case opts.Generate.GorillaServer:
+		templates = append(templates, "gorilla/gorilla-idempotency-key.tmpl")
+	default:
+		return "", nil
+	}
+	return GenerateTemplates(templates, t, nil)
+}
+
 func GenerateStrictServer(t *template.Template, operations []OperationDefinition, opts Configuration) (string, error) {
 	templates := []string{"strict/strict-interface.tmpl"}
 	if opts.Generate.ChiServer || opts.Generate.GorillaServer {
@@ -941,7 +2186,119 @@ func GenerateStrictResponses(t *template.Template, responses []ResponseDefinitio
 // GenerateClient uses the template engine to generate the function which registers our wrappers
 // as Echo path handlers.
 func GenerateClient(t *template.Template, ops []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"client.tmpl"}, t, ops)
+	client, err := GenerateTemplates([]string{"client.tmpl"}, t, ops)
+	if err != nil {
+		return "", err
+	}
+	servers, err := GenerateServers(t, DescribeServers(globalState.spec.Servers))
+	if err != nil {
+		return "", err
+	}
+	return client + servers, nil
+}
+
+// ServerDefinition describes one of the servers in the spec, which the
+// client can use as its base URL.
+type ServerDefinition struct {
+	Index       int                        // The position of the server in the spec
+	URL         string                     // The URL, which may contain variables like {region}
+	DefaultURL  string                     // The URL with every variable set to its default
+	Description string                     // The description of the server, used to generate a comment
+	Variables   []ServerVariableDefinition // The variables in the URL, sorted by name
+}
+
+// ServerVariableDefinition describes a variable in a server URL.
+type ServerVariableDefinition struct {
+	Name    string
+	Default string
+	Enum    []string
+}
+
+// DescribeServers returns the definitions of the given servers.
+func DescribeServers(servers openapi3.Servers) []ServerDefinition {
+	var defs []ServerDefinition
+	for i, server := range servers {
+		def := ServerDefinition{
+			Index:       i,
+			URL:         server.URL,
+			DefaultURL:  serverDefaultURL(server),
+			Description: server.Description,
+		}
+		for _, name := range SortedServerVariableKeys(server.Variables) {
+			variable := server.Variables[name]
+			def.Variables = append(def.Variables, ServerVariableDefinition{
+				Name:    name,
+				Default: variable.Default,
+				Enum:    variable.Enum,
+			})
+		}
+		defs = append(defs, def)
+	}
+	return defs
+}
+
+// serverDefaultURL returns the URL of server with its variables set to their
+// defaults.
+func serverDefaultURL(server *openapi3.Server) string {
+	u := server.URL
+	for name, variable := range server.Variables {
+		u = strings.ReplaceAll(u, "{"+name+"}", variable.Default)
+	}
+	return u
+}
+
+// GenerateServers generates constants and functions for the URLs of the
+// servers in the spec, and a client option to choose between them.
+func GenerateServers(t *template.Template, servers []ServerDefinition) (string, error) {
+	if len(servers) == 0 {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"servers.tmpl"}, t, servers)
+}
+
+// OperationExtensionsDefinition holds the extensions of an operation, for
+// the registry generated by generate-operation-extensions.
+type OperationExtensionsDefinition struct {
+	OperationId string
+	Extensions  []ExtensionDefinition // Sorted by name
+}
+
+// ExtensionDefinition is an extension, such as x-rate-limit, with its value
+// as JSON.
+type ExtensionDefinition struct {
+	Name  string
+	Value string
+}
+
+// DescribeOperationExtensions returns the extensions of the operations which
+// have any.
+func DescribeOperationExtensions(ops []OperationDefinition) ([]OperationExtensionsDefinition, error) {
+	var defs []OperationExtensionsDefinition
+	for _, op := range ops {
+		if len(op.Spec.Extensions) == 0 {
+			continue
+		}
+		def := OperationExtensionsDefinition{OperationId: op.OperationId}
+		for _, name := range SortedExtensionKeys(op.Spec.Extensions) {
+			value, err := json.Marshal(op.Spec.Extensions[name])
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling extension %s of operation %s: %w", name, op.OperationId, err)
+			}
+			def.Extensions = append(def.Extensions, ExtensionDefinition{Name: name, Value: string(value)})
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// GenerateOperationExtensions generates a map from the IDs of the operations
+// which have extensions to their values, for middleware to read at runtime.
+func GenerateOperationExtensions(t *template.Template, ops []OperationDefinition) (string, error) {
+	defs, err := DescribeOperationExtensions(ops)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"operation-extensions.tmpl"}, t, defs)
 }
 
 // GenerateClientWithResponses generates a client which extends the basic client which does response
@@ -950,6 +2307,237 @@ func GenerateClientWithResponses(t *template.Template, ops []OperationDefinition
 	return GenerateTemplates([]string{"client-with-responses.tmpl"}, t, ops)
 }
 
+// QueryBuilderDefinition describes the builder of the parameters of an
+// operation's requests, which is generated with the generate-query-builders
+// option.
+type QueryBuilderDefinition struct {
+	OperationDefinition
+	TypeName string
+	Setters  []QueryBuilderSetter
+}
+
+// QueryBuilderSetter describes the method of a query builder setting one of
+// the fields of the operation's Params.
+type QueryBuilderSetter struct {
+	MethodName string
+	FieldName  string
+	ParamName  string
+	In         string // Where the parameter goes, like query or header
+	ArgType    string // The type of the value the method is given
+	Value      string // The value of the field, in terms of the method's argument v
+}
+
+// GenerateQueryBuilders generates a builder for the parameters of each of the
+// operations which have a Params type, with a method setting each of them,
+// and Do methods calling the operation with those which are set, when the
+// generate-query-builders option is set.
+func GenerateQueryBuilders(t *template.Template, ops []OperationDefinition) (string, error) {
+	if !globalState.options.OutputOptions.GenerateQueryBuilders {
+		return "", nil
+	}
+
+	var builders []QueryBuilderDefinition
+	for _, op := range ops {
+		if !op.RequiresParamObject() {
+			continue
+		}
+		var params *TypeDefinition
+		for i, td := range op.TypeDefinitions {
+			if td.TypeName == op.OperationId+"Params" {
+				params = &op.TypeDefinitions[i]
+			}
+		}
+		if params == nil {
+			return "", fmt.Errorf("missing the type of the parameters of %s", op.OperationId)
+		}
+
+		// The fields of Params are in the order of the parameters
+		builder := QueryBuilderDefinition{OperationDefinition: op, TypeName: op.OperationId + "QueryBuilder"}
+		for i, param := range op.Params() {
+			p := params.Schema.Properties[i]
+			setter := QueryBuilderSetter{
+				MethodName: "With" + p.GoStructFieldName(),
+				FieldName:  p.GoStructFieldName(),
+				ParamName:  param.ParamName,
+				In:         param.In,
+				ArgType:    p.GoTypeDef(),
+				Value:      "v",
+			}
+			if strings.HasPrefix(p.GoTypeDef(), "*") {
+				setter.ArgType = strings.TrimPrefix(p.GoTypeDef(), "*")
+				setter.Value = "&v"
+			}
+			builder.Setters = append(builder.Setters, setter)
+		}
+		builders = append(builders, builder)
+	}
+
+	if len(builders) == 0 {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"query-builders.tmpl"}, t, builders)
+}
+
+// GenerateInProcessClient generates NewInProcessClient, which creates a
+// ClientWithResponses whose requests are served by an http.Handler without a
+// network round trip, when the generate-in-process-client option is set.
+func GenerateInProcessClient(t *template.Template) (string, error) {
+	if !globalState.options.OutputOptions.GenerateInProcessClient {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"in-process-client.tmpl"}, t, nil)
+}
+
+// PaginatorDefinition describes the paginator of an operation whose requests
+// and responses follow one of the patterns of the paginators option.
+type PaginatorDefinition struct {
+	OperationDefinition
+	TypeName      string
+	ResponseField string // The field of the response type holding the parsed body, like JSON200
+	CursorField   string // The field of Params holding the cursor
+	CursorPointer bool   // Whether the cursor's field in Params is a pointer
+	NextField     string // The field of the response body holding the next cursor
+	NextPointer   bool   // Whether the next cursor's field in the response body is a pointer
+	NextNullable  bool   // Whether the next cursor's field in the response body is a Nullable
+	CursorType    string // The type of the cursor, without a pointer
+	CursorLen     bool   // Whether the cursor is a slice or map, which is empty rather than zero on the last page
+}
+
+// GeneratePaginators generates a paginator for each of the operations whose
+// requests have the query parameter of one of the patterns of the paginators
+// option, and whose successful JSON responses have its property.
+func GeneratePaginators(t *template.Template, ops []OperationDefinition) (string, error) {
+	patterns := globalState.options.OutputOptions.Paginators
+	if len(patterns) == 0 {
+		return "", nil
+	}
+
+	var paginators []PaginatorDefinition
+	for _, op := range ops {
+		if !op.RequiresParamObject() || op.HasBody() {
+			continue
+		}
+		for _, pattern := range patterns {
+			paginator, ok, err := describePaginator(op, pattern)
+			if err != nil {
+				return "", err
+			}
+			if ok {
+				paginators = append(paginators, paginator)
+				break
+			}
+		}
+	}
+
+	return GenerateTemplates([]string{"paginators.tmpl"}, t, paginators)
+}
+
+// GenerateHeaderEditors generates a request editor, like WithXRequestIdHeader,
+// for each header parameter of #/components/parameters, for the header-editors
+// option. Those are the headers which are shared by several operations, like
+// Authorization.
+func GenerateHeaderEditors(t *template.Template) (string, error) {
+	if !globalState.options.OutputOptions.HeaderEditors || globalState.spec.Components == nil {
+		return "", nil
+	}
+
+	var params openapi3.Parameters
+	for _, name := range SortedParameterKeys(globalState.spec.Components.Parameters) {
+		param := globalState.spec.Components.Parameters[name]
+		if param.Value == nil || param.Value.In != openapi3.ParameterInHeader {
+			continue
+		}
+		// Referring to the component gives the parameter its type
+		params = append(params, &openapi3.ParameterRef{Ref: "#/components/parameters/" + name, Value: param.Value})
+	}
+	headers, err := DescribeParameters(params, nil)
+	if err != nil {
+		return "", fmt.Errorf("error describing header parameters: %w", err)
+	}
+
+	// Maps the name of each editor to the header it sets
+	editors := make(map[string]string)
+	for _, header := range headers {
+		if other, found := editors[header.GoName()]; found {
+			return "", fmt.Errorf("header parameters %s and %s would both have the request editor With%sHeader", other, header.ParamName, header.GoName())
+		}
+		editors[header.GoName()] = header.ParamName
+	}
+
+	return GenerateTemplates([]string{"header-editors.tmpl"}, t, headers)
+}
+
+// describePaginator describes the paginator of an operation following the
+// given pattern, returning false if it doesn't.
+func describePaginator(op OperationDefinition, pattern PaginationPattern) (PaginatorDefinition, bool, error) {
+	paginator := PaginatorDefinition{OperationDefinition: op, TypeName: op.OperationId + "Paginator"}
+
+	var params *TypeDefinition
+	for i, td := range op.TypeDefinitions {
+		if td.TypeName == op.OperationId+"Params" {
+			params = &op.TypeDefinitions[i]
+		}
+	}
+	if params == nil {
+		return paginator, false, fmt.Errorf("missing the type of the parameters of %s", op.OperationId)
+	}
+	var cursor *Property
+	// The fields of Params are in the order of the parameters
+	for i, param := range op.Params() {
+		if param.In == "query" && param.ParamName == pattern.CursorParam {
+			cursor = &params.Schema.Properties[i]
+		}
+	}
+	if cursor == nil {
+		return paginator, false, nil
+	}
+
+	responses, err := op.GetResponseTypeDefinitions()
+	if err != nil {
+		return paginator, false, err
+	}
+	var next *Property
+	for _, rtd := range responses {
+		if !strings.HasPrefix(rtd.ResponseName, "2") || !StringInArray(rtd.ContentTypeName, contentTypesJSON) {
+			continue
+		}
+		// Generate the body's schema itself rather than a reference to it,
+		// so that its properties can be looked up.
+		sref := op.Spec.Responses[rtd.ResponseName].Value.Content[rtd.ContentTypeName].Schema
+		body, err := GenerateGoSchema(&openapi3.SchemaRef{Value: sref.Value}, []string{op.OperationId + "Page"})
+		if err != nil {
+			return paginator, false, fmt.Errorf("error generating the response body of %s: %w", op.OperationId, err)
+		}
+		for i, p := range body.Properties {
+			if p.JsonFieldName == pattern.NextCursorField {
+				next = &body.Properties[i]
+			}
+		}
+		paginator.ResponseField = rtd.TypeName
+		break
+	}
+	if next == nil {
+		return paginator, false, nil
+	}
+
+	paginator.CursorField = cursor.GoStructFieldName()
+	paginator.CursorType = strings.TrimPrefix(cursor.GoTypeDef(), "*")
+	paginator.CursorPointer = strings.HasPrefix(cursor.GoTypeDef(), "*")
+	paginator.CursorLen = strings.HasPrefix(paginator.CursorType, "[]") || strings.HasPrefix(paginator.CursorType, "map[")
+	paginator.NextField = next.GoStructFieldName()
+	nextType := next.GoTypeDef()
+	if next.HasNullableType() {
+		paginator.NextNullable = true
+		nextType = next.Schema.TypeDecl()
+	}
+	paginator.NextPointer = strings.HasPrefix(nextType, "*")
+	if nextType = strings.TrimPrefix(nextType, "*"); nextType != paginator.CursorType {
+		return paginator, false, fmt.Errorf("the %s parameter of %s has type %s, but the %s property of its response has type %s",
+			pattern.CursorParam, op.OperationId, paginator.CursorType, pattern.NextCursorField, nextType)
+	}
+	return paginator, true, nil
+}
+
 // GenerateTemplates used to generate templates
 func GenerateTemplates(templates []string, t *template.Template, ops interface{}) (string, error) {
 	var generatedTemplates []string
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestIsJson(t *testing.T) {
@@ -146,3 +147,50 @@ func TestGenerateDefaultOperationID(t *testing.T) {
 		}
 	}
 }
+
+func TestExpectedStatusCodes(t *testing.T) {
+	op := OperationDefinition{
+		Responses: []ResponseDefinition{
+			{StatusCode: "404"},
+			{StatusCode: "200"},
+			{StatusCode: "3XX"},
+			{StatusCode: "4XX"},
+			{StatusCode: "default"},
+		},
+	}
+	var want []int
+	want = append(want, 200)
+	for code := 300; code < 500; code++ {
+		want = append(want, code)
+	}
+	assert.Equal(t, want, op.ExpectedStatusCodes())
+	assert.True(t, op.HasDefaultResponse())
+
+	op = OperationDefinition{
+		Responses: []ResponseDefinition{
+			{StatusCode: "204"},
+		},
+	}
+	assert.Equal(t, []int{204}, op.ExpectedStatusCodes())
+	assert.False(t, op.HasDefaultResponse())
+
+	assert.Equal(t, []int{}, OperationDefinition{}.ExpectedStatusCodes())
+}
+
+func TestBodiesByContentTypeSpecificity(t *testing.T) {
+	op := OperationDefinition{
+		Bodies: []RequestBodyDefinition{
+			{ContentType: "*/*"},
+			{ContentType: "application/json"},
+			{ContentType: "text/*"},
+			{ContentType: "text/plain"},
+		},
+	}
+	var contentTypes []string
+	for _, body := range op.BodiesByContentTypeSpecificity() {
+		contentTypes = append(contentTypes, body.ContentType)
+	}
+	assert.Equal(t, []string{"application/json", "text/plain", "text/*", "*/*"}, contentTypes)
+	// The bodies of the operation keep their order
+	assert.Equal(t, "*/*", op.Bodies[0].ContentType)
+}
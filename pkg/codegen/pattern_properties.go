@@ -0,0 +1,146 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// keywordPatternProperties is the JSON Schema keyword giving the schemas of
+// the properties of an object whose names match patterns. Like prefixItems,
+// kin-openapi keeps it among the extensions of the schema.
+const keywordPatternProperties = "patternProperties"
+
+// PatternPropertiesDefinition is a map type from patternProperties, for which
+// a Validate method, and an UnmarshalJSON method calling it, are generated.
+type PatternPropertiesDefinition struct {
+	TypeName  string
+	ValueType string
+	Patterns  []string
+	Schema    Schema // For its minProperties and maxProperties
+}
+
+// PatternsVar returns the name of the variable holding the compiled patterns
+func (d PatternPropertiesDefinition) PatternsVar() string {
+	return LowercaseFirstCharacter(d.TypeName) + "KeyPatterns"
+}
+
+// hasPatternProperties returns true if schema has patternProperties
+func hasPatternProperties(schema *openapi3.Schema) bool {
+	properties, ok := schema.Extensions[keywordPatternProperties].(map[string]interface{})
+	return ok && len(properties) != 0
+}
+
+// decodePatternProperties returns the schemas of the patternProperties of
+// schema, if it has any, by their patterns, leaving their references
+// unresolved.
+func decodePatternProperties(schema *openapi3.Schema) (map[string]*openapi3.SchemaRef, error) {
+	if !hasPatternProperties(schema) {
+		return nil, nil
+	}
+	data, err := json.Marshal(schema.Extensions[keywordPatternProperties])
+	if err != nil {
+		return nil, fmt.Errorf("error encoding patternProperties: %w", err)
+	}
+	var properties map[string]*openapi3.SchemaRef
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return nil, fmt.Errorf("error decoding patternProperties: %w", err)
+	}
+	return properties, nil
+}
+
+// patternPropertiesSchemaToGoType generates a map for an object with only
+// patternProperties, whose values have the type of their schema, if there's
+// only one, or they all have the same type, and are interface{} otherwise.
+// With generate-validators, it's a type definition, whose methods check its
+// keys against the patterns. Otherwise it's an alias.
+func patternPropertiesSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schema) error {
+	properties, err := decodePatternProperties(schema)
+	if err != nil {
+		return err
+	}
+	patterns := make([]string, 0, len(properties))
+	for pattern := range properties {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("patternProperties pattern %q isn't supported by Go's regexp package: %w", pattern, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+
+	var valueType string
+	var additionalTypes []TypeDefinition
+	for i, pattern := range patterns {
+		valuePath := append(path, "PatternProperties")
+		if len(patterns) > 1 {
+			valuePath = append(path, fmt.Sprintf("PatternProperties%d", i))
+		}
+		sref := properties[pattern]
+		if err := resolveLocalSchemaRefs(sref); err != nil {
+			return fmt.Errorf("error resolving patternProperties %q: %w", pattern, err)
+		}
+		valueSchema, err := GenerateGoSchema(sref, valuePath)
+		if err != nil {
+			return fmt.Errorf("error generating type for patternProperties %q: %w", pattern, err)
+		}
+		if valueSchema.needsTypeDefinition() {
+			typeName := PathToTypeName(valuePath)
+			valueSchema.AdditionalTypes = append(valueSchema.AdditionalTypes, TypeDefinition{
+				TypeName: typeName,
+				JsonName: strings.Join(valuePath, "."),
+				Schema:   valueSchema,
+			})
+			valueSchema.RefType = typeName
+		}
+		if i == 0 {
+			valueType = valueSchema.TypeDecl()
+			additionalTypes = valueSchema.AdditionalTypes
+		} else if valueSchema.TypeDecl() != valueType {
+			valueType = "interface{}"
+			additionalTypes = nil
+		}
+	}
+
+	outSchema.GoType = "map[string]" + valueType
+	outSchema.PatternProperties = patterns
+	outSchema.AdditionalTypes = append(outSchema.AdditionalTypes, additionalTypes...)
+	outSchema.DefineViaAlias = !globalState.options.OutputOptions.GenerateValidators
+	return nil
+}
+
+// GeneratePatternProperties generates a Validate method, checking the keys of
+// a map against the patterns of its schema, and their number against its
+// minProperties and maxProperties, and an UnmarshalJSON method which
+// calls it, for each of the given types from patternProperties, when the
+// generate-validators option is set.
+func GeneratePatternProperties(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	if !globalState.options.OutputOptions.GenerateValidators {
+		return "", nil
+	}
+
+	var definitions []PatternPropertiesDefinition
+	m := map[string]bool{}
+	for _, td := range typeDefs {
+		if m[td.TypeName] || len(td.Schema.PatternProperties) == 0 || td.IsAlias() || td.Schema.IsRef() {
+			continue
+		}
+		m[td.TypeName] = true
+		definitions = append(definitions, PatternPropertiesDefinition{
+			TypeName:  td.TypeName,
+			ValueType: strings.TrimPrefix(td.Schema.GoType, "map[string]"),
+			Patterns:  td.Schema.PatternProperties,
+			Schema:    td.Schema,
+		})
+	}
+
+	if len(definitions) == 0 {
+		return "", nil
+	}
+
+	return GenerateTemplates([]string{"pattern-properties.tmpl"}, t, definitions)
+}
@@ -146,6 +146,19 @@ func walkSchemaRef(ref *openapi3.SchemaRef, doFn func(RefWrapper) (bool, error))
 
 	_ = walkSchemaRef(ref.Value.AdditionalProperties.Schema, doFn)
 
+	// kin-openapi doesn't know prefixItems or patternProperties, so their
+	// references are found here
+	if items, err := decodePrefixItems(ref.Value); err == nil {
+		for _, ref := range items {
+			_ = walkSchemaRef(ref, doFn)
+		}
+	}
+	if properties, err := decodePatternProperties(ref.Value); err == nil {
+		for _, ref := range properties {
+			_ = walkSchemaRef(ref, doFn)
+		}
+	}
+
 	return nil
 }
 
@@ -476,12 +489,80 @@ func removeOrphanedComponents(swagger *openapi3.T, refs []string) int {
 	return countRemoved
 }
 
-func pruneUnusedComponents(swagger *openapi3.T) {
-	for {
-		refs := findComponentRefs(swagger)
-		countRemoved := removeOrphanedComponents(swagger, refs)
-		if countRemoved < 1 {
-			break
+// findReachableComponentRefs returns the refs which are reachable from the
+// operations remaining in the spec, following refs through the components
+// they point at. Components which are only referred to by unreachable ones,
+// including those which refer to each other, aren't reachable, and nor are
+// the parameters of paths whose operations have all been filtered out.
+func findReachableComponentRefs(swagger *openapi3.T) []string {
+	refs := []string{}
+	seen := map[string]bool{}
+
+	visit := func(ref RefWrapper) (bool, error) {
+		if ref.Ref == "" {
+			return true, nil
+		}
+		if seen[ref.Ref] {
+			return false, nil
+		}
+		seen[ref.Ref] = true
+		refs = append(refs, ref.Ref)
+		// The loader resolves refs, so carrying on walks the component which
+		// the ref points at.
+		return true, nil
+	}
+
+	for _, p := range swagger.Paths {
+		ops := p.Operations()
+		if len(ops) == 0 {
+			continue
 		}
+		for _, param := range p.Parameters {
+			_ = walkParameterRef(param, visit)
+		}
+		for _, op := range ops {
+			_ = walkOperation(op, visit)
+		}
+	}
+
+	return refs
+}
+
+// copyComponents returns a copy of swagger with its own copies of the
+// component maps, so that components can be pruned from it without affecting
+// swagger. Everything else is shared.
+func copyComponents(swagger *openapi3.T) *openapi3.T {
+	spec := *swagger
+	if swagger.Components == nil {
+		return &spec
+	}
+	components := *swagger.Components
+	components.Schemas = copyMap(components.Schemas)
+	components.Parameters = copyMap(components.Parameters)
+	components.Headers = copyMap(components.Headers)
+	components.RequestBodies = copyMap(components.RequestBodies)
+	components.Responses = copyMap(components.Responses)
+	components.SecuritySchemes = copyMap(components.SecuritySchemes)
+	components.Examples = copyMap(components.Examples)
+	components.Links = copyMap(components.Links)
+	components.Callbacks = copyMap(components.Callbacks)
+	spec.Components = &components
+	return &spec
+}
+
+func copyMap[M ~map[K]V, K comparable, V any](m M) M {
+	if m == nil {
+		return nil
 	}
+	c := make(M, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// pruneUnusedComponents removes the components which aren't reachable from
+// the operations in the spec.
+func pruneUnusedComponents(swagger *openapi3.T) {
+	removeOrphanedComponents(swagger, findReachableComponentRefs(swagger))
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFindReferences(t *testing.T) {
@@ -136,6 +137,112 @@ func TestPruningUnusedComponents(t *testing.T) {
 	assert.Len(t, swagger.Components.Callbacks, 0)
 }
 
+func TestPruningAfterFilteringByTag(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(pruneFilteredTestFixture))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:       true,
+			EmbeddedSpec: true,
+		},
+		OutputOptions: OutputOptions{
+			IncludeTags: []string{"cat"},
+		},
+	}
+
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "type Cat struct")
+	assert.Contains(t, code, "type Error struct")
+	// Dog and Owner refer to each other, but only the filtered out operation
+	// refers to them, as it does to the DogId path parameter.
+	assert.NotContains(t, code, "type Dog struct")
+	assert.NotContains(t, code, "type Owner struct")
+	assert.NotContains(t, code, "DogId")
+
+	// The embedded spec, which is generated from swagger, isn't pruned
+	assert.Len(t, swagger.Components.Schemas, 5)
+	assert.Len(t, swagger.Components.Parameters, 1)
+}
+
+const pruneFilteredTestFixture = `
+openapi: 3.0.1
+info:
+  title: OpenAPI-CodeGen Test
+  version: 1.0.0
+paths:
+  /cat:
+    get:
+      tags:
+        - cat
+      operationId: getCat
+      responses:
+        200:
+          description: Success
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Cat'
+        default:
+          description: Error
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Error'
+  /dog/{dogId}:
+    parameters:
+      - $ref: '#/components/parameters/DogId'
+    get:
+      tags:
+        - dog
+      operationId: getDog
+      responses:
+        200:
+          description: Success
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Dog'
+        default:
+          description: Error
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Error'
+components:
+  parameters:
+    DogId:
+      name: dogId
+      in: path
+      required: true
+      schema:
+        $ref: '#/components/schemas/DogId'
+  schemas:
+    Error:
+      properties:
+        message:
+          type: string
+    Cat:
+      properties:
+        name:
+          type: string
+    DogId:
+      type: string
+    Dog:
+      properties:
+        owner:
+          $ref: '#/components/schemas/Owner'
+    Owner:
+      properties:
+        dogs:
+          type: array
+          items:
+            $ref: '#/components/schemas/Dog'
+`
+
 const pruneComprehensiveTestFixture = `
 openapi: 3.0.1
 
@@ -0,0 +1,204 @@
+package codegen
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// receiverMethods are the methods of a type whose receivers have the same
+// name, which are renamed together.
+type receiverMethods struct {
+	typeName string
+	decls    []*ast.FuncDecl
+}
+
+// renameReceivers renames the receivers of the generated methods of a type,
+// which are named by ReceiverName, when that name is taken by an import or a
+// package-level identifier, which the receiver would shadow, or by a
+// parameter or variable of one of the methods. The new name is the next
+// which ReceiverName would pick that none of them takes. The files of the
+// package are keyed by name. Files which don't parse are left as they are
+// for formatCode to report.
+func renameReceivers(packageName string, files map[string]string) map[string]string {
+	fset := token.NewFileSet()
+	var parsed []*ast.File
+	for _, name := range SortedStringKeys(files) {
+		f, err := parser.ParseFile(fset, name, files[name], 0)
+		if err != nil {
+			return files
+		}
+		parsed = append(parsed, f)
+	}
+
+	info := &types.Info{
+		Defs: make(map[*ast.Ident]types.Object),
+		Uses: make(map[*ast.Ident]types.Object),
+	}
+	conf := types.Config{Importer: emptyImporter{}, Error: func(error) {}}
+	pkg, _ := conf.Check(packageName, fset, parsed, info)
+
+	packageNames := make(map[string]bool)
+	for _, name := range pkg.Scope().Names() {
+		packageNames[name] = true
+	}
+	var groups []*receiverMethods
+	byReceiver := make(map[string]*receiverMethods)
+	for _, f := range parsed {
+		for _, imp := range f.Imports {
+			if imp.Name != nil {
+				packageNames[imp.Name.Name] = true
+			} else if importPath, err := strconv.Unquote(imp.Path.Value); err == nil {
+				packageNames[packageNameOfPath(importPath)] = true
+			}
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 || len(fn.Recv.List[0].Names) == 0 {
+				continue
+			}
+			typeName := receiverTypeName(fn.Recv.List[0].Type)
+			key := typeName + "." + fn.Recv.List[0].Names[0].Name
+			if byReceiver[key] == nil {
+				byReceiver[key] = &receiverMethods{typeName: typeName}
+				groups = append(groups, byReceiver[key])
+			}
+			byReceiver[key].decls = append(byReceiver[key].decls, fn)
+		}
+	}
+
+	edits := make(map[string][]identEdit)
+	for _, group := range groups {
+		receivers := make(map[types.Object]bool)
+		for _, fn := range group.decls {
+			receivers[info.Defs[fn.Recv.List[0].Names[0]]] = true
+		}
+		taken := methodNames(group.decls, info, receivers)
+		name := group.decls[0].Recv.List[0].Names[0].Name
+		collides := func(name string) bool {
+			return packageNames[name] || taken[name] || isReservedReceiver(name)
+		}
+		if name == "_" || !collides(name) {
+			continue
+		}
+		newName := receiverName(group.typeName, collides)
+		for _, fn := range group.decls {
+			ast.Inspect(fn, func(node ast.Node) bool {
+				ident, ok := node.(*ast.Ident)
+				if !ok {
+					return true
+				}
+				obj := info.Defs[ident]
+				if obj == nil {
+					obj = info.Uses[ident]
+				}
+				if receivers[obj] {
+					position := fset.Position(ident.Pos())
+					edits[position.Filename] = append(edits[position.Filename], identEdit{offset: position.Offset, name: newName, length: len(ident.Name)})
+				}
+				return true
+			})
+		}
+	}
+	return applyIdentEdits(files, edits)
+}
+
+// methodNames returns the names which the methods decls declare or refer to,
+// other than their receivers and the fields and methods selected from values.
+// A receiver which is declared again with := counts too, since the method
+// means a variable of its own.
+func methodNames(decls []*ast.FuncDecl, info *types.Info, receivers map[types.Object]bool) map[string]bool {
+	names := make(map[string]bool)
+	for _, fn := range decls {
+		ast.Inspect(fn, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.SelectorExpr:
+				ast.Inspect(node.X, func(node ast.Node) bool {
+					return visitMethodName(node, info, receivers, names)
+				})
+				return false
+			case *ast.AssignStmt:
+				if node.Tok == token.DEFINE {
+					for _, lhs := range node.Lhs {
+						if ident, ok := lhs.(*ast.Ident); ok && receivers[info.Uses[ident]] {
+							names[ident.Name] = true
+						}
+					}
+				}
+			}
+			return visitMethodName(node, info, receivers, names)
+		})
+	}
+	return names
+}
+
+// visitMethodName adds the name of node to names, if it's an identifier of
+// something other than a receiver or a field, for methodNames.
+func visitMethodName(node ast.Node, info *types.Info, receivers map[types.Object]bool, names map[string]bool) bool {
+	ident, ok := node.(*ast.Ident)
+	if !ok {
+		return true
+	}
+	obj := info.Defs[ident]
+	if obj == nil {
+		obj = info.Uses[ident]
+	}
+	if v, ok := obj.(*types.Var); (ok && v.IsField()) || receivers[obj] {
+		return true
+	}
+	names[ident.Name] = true
+	return true
+}
+
+// receiverTypeName returns the name of the type of a receiver, without any
+// pointer or type parameters.
+func receiverTypeName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(expr.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(expr.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	}
+	return ""
+}
+
+// receiverName returns the name for the receiver of the methods of typeName:
+// the lowercase initials of its words, such as ps for PetStore. When that's
+// reserved, more of its first word is taken, such as bl for Blob, then t, and
+// then t with a number.
+func receiverName(typeName string, reserved func(string) bool) string {
+	runes := []rune(typeName)
+	var initials []rune
+	for i, r := range runes {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		// Words start after lowercase letters or digits, and at the last
+		// capital of an acronym followed by a lowercase letter
+		if len(initials) == 0 || unicode.IsUpper(r) && (!unicode.IsUpper(runes[i-1]) ||
+			i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			initials = append(initials, unicode.ToLower(r))
+		}
+	}
+	name := string(initials)
+	lower := []rune(strings.ToLower(typeName))
+	for n := 2; n <= len(lower) && (name == "" || reserved(name)); n++ {
+		name = string(lower[:n])
+	}
+	if name != "" && !reserved(name) {
+		return name
+	}
+	name = "t"
+	for i := 2; reserved(name); i++ {
+		name = "t" + strconv.Itoa(i)
+	}
+	return name
+}
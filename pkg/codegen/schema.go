@@ -3,8 +3,12 @@ package codegen
 import (
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/deepmap/oapi-codegen/pkg/util"
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
@@ -17,19 +21,53 @@ type Schema struct {
 	ArrayType *Schema // The schema of array element
 
 	EnumValues map[string]string // Enum values
+	Const      bool              // Whether the enum's only value is the schema's const, which UnmarshalJSON checks
 
 	Properties               []Property       // For an object, the fields with names
 	HasAdditionalProperties  bool             // Whether we support additional properties
 	AdditionalPropertiesType *Schema          // And if we do, their type
 	AdditionalTypes          []TypeDefinition // We may need to generate auxiliary helper types, stored here
 
+	// An object with only additional properties is flattened to a
+	// map[string]AdditionalPropertiesType, in which case this is set.
+	IsAdditionalPropertiesMap bool
+
 	SkipOptionalPointer bool // Some types don't need a * in front when they're optional
 
+	// Constraints on the items of an array, which are checked by generated
+	// validators
+	MinItems    uint64
+	MaxItems    *uint64
+	UniqueItems bool
+
+	// Constraints on the number of properties of an object, which are checked
+	// by generated validators
+	MinProperties uint64
+	MaxProperties *uint64
+
+	// Constraints on numbers, which are checked by generated validators.
+	// ExclusiveMin and ExclusiveMax make Minimum and Maximum strict bounds.
+	Minimum      *float64
+	Maximum      *float64
+	ExclusiveMin bool
+	ExclusiveMax bool
+	MultipleOf   *float64
+
 	Description string // The description of the element
 
 	UnionElements []UnionElement // Possible elements of oneOf/anyOf union
 	Discriminator *Discriminator // Describes which value is stored in a union
 
+	// For an array with prefixItems, its leading items, which are generated
+	// as the fields of a struct, and the schema of any further ones, which
+	// are collected in its Rest field, unless the array allows none.
+	TupleItems []TupleItem
+	TupleRest  *Schema
+
+	// For an object with patternProperties, which is generated as a map, the
+	// patterns its keys have to match
+	PatternProperties []string
+
 	// If this is set, the schema will declare a type via alias, eg,
 	// `type Foo = bool`. If this is not set, we will define this type via
 	// type definition `type Foo bool`
@@ -37,6 +75,8 @@ type Schema struct {
 
 	// The original OpenAPIv3 Schema.
 	OAPISchema *openapi3.Schema
+	// For a reference to another schema, the schema it refers to.
+	RefOAPISchema *openapi3.Schema
 }
 
 func (s Schema) IsRef() bool {
@@ -45,11 +85,98 @@ func (s Schema) IsRef() bool {
 
 func (s Schema) TypeDecl() string {
 	if s.IsRef() {
-		return s.RefType
+		return qualifyModelType(s.RefType)
 	}
 	return s.GoType
 }
 
+// IsScalar returns true if the schema is a string, number, integer or
+// boolean, rather than an array or object, even if its Go type is a struct
+// such as time.Time.
+func (s Schema) IsScalar() bool {
+	schema := s.OAPISchema
+	if s.RefOAPISchema != nil {
+		schema = s.RefOAPISchema
+	}
+	if schema == nil || len(schema.AllOf) != 0 || len(schema.AnyOf) != 0 || len(schema.OneOf) != 0 {
+		return false
+	}
+	switch schema.Type {
+	case "string", "number", "integer", "boolean":
+		return true
+	default:
+		return false
+	}
+}
+
+// HasArrayConstraints returns true if the schema limits the number of items
+// in an array, or requires them to be unique.
+func (s Schema) HasArrayConstraints() bool {
+	return s.MinItems != 0 || s.MaxItems != nil || s.UniqueItems
+}
+
+func setArrayConstraints(outSchema *Schema, schema *openapi3.Schema) {
+	outSchema.MinItems = schema.MinItems
+	outSchema.MaxItems = schema.MaxItems
+	outSchema.UniqueItems = schema.UniqueItems
+}
+
+// HasPropertiesConstraints returns true if the schema limits the number of
+// properties of an object.
+func (s Schema) HasPropertiesConstraints() bool {
+	return s.MinProperties != 0 || s.MaxProperties != nil
+}
+
+func setPropertiesConstraints(outSchema *Schema, schema *openapi3.Schema) {
+	outSchema.MinProperties = schema.MinProps
+	outSchema.MaxProperties = schema.MaxProps
+}
+
+// hasConstraints returns true if the schema has any of the constraints which
+// are checked by generated validators.
+func (s Schema) hasConstraints() bool {
+	return s.HasArrayConstraints() || s.HasNumericConstraints() || s.HasPropertiesConstraints()
+}
+
+// exceedsInt64 returns true if the bounds of an integer schema allow values
+// which don't fit in an int64.
+func exceedsInt64(schema *openapi3.Schema) bool {
+	return (schema.Max != nil && *schema.Max >= math.MaxInt64) || (schema.Min != nil && *schema.Min < math.MinInt64)
+}
+
+// needsTypeDefinition returns true if the schema is generated with methods,
+// such as those of additional properties, unions and tuples, so that it needs
+// a type of its own where it's defined inline.
+func (s Schema) needsTypeDefinition() bool {
+	hasMethods := s.HasAdditionalProperties || len(s.UnionElements) != 0 || len(s.TupleItems) != 0 ||
+		(len(s.PatternProperties) != 0 && !s.DefineViaAlias)
+	return hasMethods && s.RefType == ""
+}
+
+// HasNumericConstraints returns true if the schema bounds a number, or
+// requires it to be a multiple of another.
+func (s Schema) HasNumericConstraints() bool {
+	return s.Minimum != nil || s.Maximum != nil || s.MultipleOf != nil
+}
+
+// IsInteger returns true if the schema is for an integer, rather than a
+// number which may have a fractional part.
+func (s Schema) IsInteger() bool {
+	schema := s.OAPISchema
+	if s.RefOAPISchema != nil {
+		schema = s.RefOAPISchema
+	}
+	return schema != nil && schema.Type == "integer"
+}
+
+func setNumericConstraints(outSchema *Schema, schema *openapi3.Schema) {
+	outSchema.Minimum = schema.Min
+	outSchema.Maximum = schema.Max
+	outSchema.ExclusiveMin = schema.ExclusiveMin
+	outSchema.ExclusiveMax = schema.ExclusiveMax
+	outSchema.MultipleOf = schema.MultipleOf
+}
+
 // AddProperty adds a new property to the current Schema, and returns an error
 // if it collides. Two identical fields will not collide, but two properties by
 // the same name, but different definition, will collide. It's safe to merge the
@@ -86,15 +213,98 @@ type Property struct {
 	NeedsFormTag  bool
 	Extensions    map[string]interface{}
 	Deprecated    bool
+	Recursive     bool // Whether the property refers to a type containing the one it's in, so must be a pointer
+
+	// The Go expression for the default value of an optional property, which
+	// use-defaults-for-optional makes a value rather than a pointer, and
+	// whether it's the zero value of its type.
+	Default       string
+	DefaultIsZero bool
+}
+
+// JsonTagName returns the name used for the property in JSON, after applying
+// the configured JSON tag style.
+func (p Property) JsonTagName() string {
+	return jsonTagName(p.JsonFieldName)
 }
 
 func (p Property) GoFieldName() string {
 	return SchemaNameToTypeName(p.JsonFieldName)
 }
 
+// GoStructFieldName returns the name of the property's field in the generated
+// struct, which may be overridden with the x-go-name extension.
+func (p Property) GoStructFieldName() string {
+	if _, ok := p.Extensions[extGoName]; ok {
+		if extGoFieldName, err := extParseGoFieldName(p.Extensions[extGoName]); err == nil {
+			return extGoFieldName
+		}
+	}
+	return p.GoFieldName()
+}
+
+// OmitEmpty reports whether the property's JSON tag gets omitempty. By
+// default, optional properties which aren't nullable do, subject to the
+// omit-empty-policy option, and the x-omitempty extension overrides both.
+func (p Property) OmitEmpty() bool {
+	if _, ok := p.Extensions[extPropOmitEmpty]; ok {
+		if extOmitEmpty, err := extParseOmitEmpty(p.Extensions[extPropOmitEmpty]); err == nil {
+			return extOmitEmpty
+		}
+	}
+
+	if (p.Required && !p.ReadOnly && !p.WriteOnly) || p.Nullable || (p.Required && p.ReadOnly && globalState.options.Compatibility.DisableRequiredReadOnlyAsPointer) {
+		return false
+	}
+
+	// Leaving out a value which isn't the default would change its meaning,
+	// since the default would be used in its place.
+	if p.Default != "" && !p.DefaultIsZero {
+		return false
+	}
+
+	switch globalState.options.OutputOptions.OmitEmptyPolicy {
+	case OmitEmptyNever:
+		return false
+	case OmitEmptyScalarsOnly:
+		return p.Schema.IsScalar()
+	default:
+		return true
+	}
+}
+
+// Sensitive reports whether the property holds a secret, which the
+// redact-sensitive option keeps out of the String and GoString methods of its
+// struct. Those marked with x-go-sensitive are, as are writeOnly passwords,
+// unless x-go-sensitive is false.
+func (p Property) Sensitive() bool {
+	if _, ok := p.Extensions[extSensitive]; ok {
+		if sensitive, err := extParseSensitive(p.Extensions[extSensitive]); err == nil {
+			return sensitive
+		}
+	}
+	return p.WriteOnly && p.Schema.OAPISchema != nil && p.Schema.OAPISchema.Format == "password"
+}
+
+// HasNullableType reports whether the property is generated as a Nullable,
+// which is the case for optional, nullable properties when the nullable-type
+// option is set.
+func (p Property) HasNullableType() bool {
+	return globalState.options.OutputOptions.NullableType && p.Nullable && !p.Required
+}
+
 func (p Property) GoTypeDef() string {
 	typeDef := p.Schema.TypeDecl()
-	if !p.Schema.SkipOptionalPointer &&
+	if p.HasNullableType() {
+		if p.Recursive {
+			typeDef = "*" + typeDef
+		}
+		return "Nullable[" + typeDef + "]"
+	}
+	if p.Default != "" {
+		return typeDef
+	}
+	if p.Recursive || !p.Schema.SkipOptionalPointer &&
 		(!p.Required || p.Nullable ||
 			(p.ReadOnly && (!p.Required || !globalState.options.Compatibility.DisableRequiredReadOnlyAsPointer)) ||
 			p.WriteOnly) {
@@ -104,6 +314,108 @@ func (p Property) GoTypeDef() string {
 	return typeDef
 }
 
+// defaultValue returns the Go expression for the default value of a property
+// with the given schema, and whether it's the zero value of its type, if it
+// has one and its Go type is a string, number or boolean, or a type defined
+// as one, such as an enum. Otherwise, it returns an empty string.
+func defaultValue(s Schema) (string, bool) {
+	schema := s.OAPISchema
+	if s.RefOAPISchema != nil {
+		schema = s.RefOAPISchema
+	}
+	if schema == nil || schema.Default == nil || schema.Extensions[extPropGoType] != nil {
+		return "", false
+	}
+	if s.RefOAPISchema != nil {
+		if strings.Contains(s.GoType, ".") {
+			return "", false
+		}
+		// References don't carry the Go type of the schemas they refer to.
+		// Those being generated, which the reference is within, aren't
+		// resolved again, which would never end.
+		for _, inProgress := range schemasInProgress {
+			if inProgress == schema {
+				return "", false
+			}
+		}
+		resolved, err := GenerateGoSchema(openapi3.NewSchemaRef("", schema), []string{s.GoType})
+		if err != nil {
+			return "", false
+		}
+		s = resolved
+	}
+
+	switch value := schema.Default.(type) {
+	case string:
+		if s.GoType == "string" {
+			return strconv.Quote(value), value == ""
+		}
+	case bool:
+		if s.GoType == "bool" {
+			return strconv.FormatBool(value), !value
+		}
+	case float64:
+		switch s.GoType {
+		case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+			if value == math.Trunc(value) {
+				return strconv.FormatFloat(value, 'f', -1, 64), value == 0
+			}
+		case "float32", "float64":
+			return strconv.FormatFloat(value, 'g', -1, 64), value == 0
+		}
+	}
+	return "", false
+}
+
+// schemasInProgress holds the schemas which GenerateGoSchema is generating
+// types for, from the outermost in, so that properties referring to schemas
+// which contain any of them can be made pointers, since a Go type can't
+// contain itself.
+var schemasInProgress []*openapi3.Schema
+
+// containsByValue returns true if the type generated for schema is one of
+// targets, or contains one of them by value, through the properties which
+// aren't pointers and the schemas merged with allOf. Arrays, maps and unions
+// don't count, since they hold their values indirectly.
+func containsByValue(schema *openapi3.Schema, targets []*openapi3.Schema, seen map[*openapi3.Schema]bool) bool {
+	if schema == nil || seen[schema] || schema.Extensions[extPropGoType] != nil {
+		return false
+	}
+	seen[schema] = true
+	for _, target := range targets {
+		if schema == target {
+			return true
+		}
+	}
+
+	for _, sref := range schema.AllOf {
+		if sref != nil && containsByValue(sref.Value, targets, seen) {
+			return true
+		}
+	}
+	if schema.Type != "" && schema.Type != "object" {
+		return false
+	}
+	for name, sref := range schema.Properties {
+		if sref == nil || sref.Value == nil {
+			continue
+		}
+		p := Property{
+			Required:  StringInArray(name, schema.Required),
+			Nullable:  sref.Value.Nullable,
+			ReadOnly:  sref.Value.ReadOnly,
+			WriteOnly: sref.Value.WriteOnly,
+		}
+		if strings.HasPrefix(p.GoTypeDef(), "*") {
+			continue
+		}
+		if containsByValue(sref.Value, targets, seen) {
+			return true
+		}
+	}
+	return false
+}
+
 // EnumDefinition holds type information for enum
 type EnumDefinition struct {
 	// Schema is the scheme of a type which has a list of enum values, eg, the
@@ -136,6 +448,26 @@ func (e *EnumDefinition) GetValues() map[string]string {
 	return newValues
 }
 
+// ConstName returns the name of the constant of an enum from a const
+func (e *EnumDefinition) ConstName() string {
+	return e.ConstantNames()[0]
+}
+
+// IsString returns true if the enum's values are strings
+func (e *EnumDefinition) IsString() bool {
+	return e.Schema.GoType == "string"
+}
+
+// IsInteger returns true if the enum's values are integers
+func (e *EnumDefinition) IsInteger() bool {
+	return strings.HasPrefix(e.Schema.GoType, "int") || strings.HasPrefix(e.Schema.GoType, "uint")
+}
+
+// ConstantNames returns the names of the enum's constants in sorted order
+func (e *EnumDefinition) ConstantNames() []string {
+	return SortedStringKeys(e.GetValues())
+}
+
 type Constants struct {
 	// SecuritySchemeProviderNames holds all provider names for security schemes.
 	SecuritySchemeProviderNames []string
@@ -177,6 +509,11 @@ type ResponseTypeDefinition struct {
 	ResponseName string
 }
 
+// IsSuccessResponse returns whether the response is for a 2xx status code.
+func (t ResponseTypeDefinition) IsSuccessResponse() bool {
+	return strings.HasPrefix(t.ResponseName, "2")
+}
+
 func (t *TypeDefinition) IsAlias() bool {
 	return !globalState.options.Compatibility.OldAliasing && t.Schema.DefineViaAlias
 }
@@ -237,13 +574,45 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			return Schema{}, fmt.Errorf("error turning reference (%s) into a Go type: %s",
 				sref.Ref, err)
 		}
-		return Schema{
+		refSchema := Schema{
 			GoType:         refType,
 			Description:    schema.Description,
 			DefineViaAlias: true,
-		}, nil
+			RefOAPISchema:  schema,
+		}
+		// The referenced type is an alias for arrays, which can't have
+		// methods of their own, so their constraints are checked by the
+		// types using them.
+		if schema.Type == "array" && schema.Extensions[extPropGoType] == nil && !hasPrefixItems(schema) {
+			setArrayConstraints(&refSchema, schema)
+		}
+		// Likewise for numbers, unless they have a custom Go type
+		if (schema.Type == "integer" || schema.Type == "number") && schema.Extensions[extPropGoType] == nil {
+			if _, mapped := globalState.options.OutputOptions.TypeMappings[schema.Format]; !mapped || schema.Format == "" {
+				setNumericConstraints(&refSchema, schema)
+			}
+		}
+		return refSchema, nil
+	}
+
+	// A const is generated as an enum with a single value
+	constValue := schema.Extensions[keywordConst]
+	isConst := false
+	if constValue != nil && len(schema.Enum) == 0 {
+		var typ string
+		if typ, isConst = constType(schema, constValue); isConst {
+			constSchema := *schema
+			constSchema.Enum = []interface{}{constValue}
+			constSchema.Type = typ
+			schema = &constSchema
+		}
 	}
 
+	schemasInProgress = append(schemasInProgress, schema)
+	defer func() {
+		schemasInProgress = schemasInProgress[:len(schemasInProgress)-1]
+	}()
+
 	outSchema := Schema{
 		Description: schema.Description,
 		OAPISchema:  schema,
@@ -268,6 +637,16 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		if err != nil {
 			return outSchema, fmt.Errorf("invalid value for %q: %w", extPropGoType, err)
 		}
+		// A type qualified with the path of its package, such as
+		// github.com/shopspring/decimal.Decimal, is referred to by the
+		// package's name, and its package is imported.
+		if strings.Contains(typeName, "/") {
+			_, goType, err := parseTypeMapping(typeName)
+			if err != nil {
+				return outSchema, fmt.Errorf("invalid value for %q: %w", extPropGoType, err)
+			}
+			typeName = goType
+		}
 		outSchema.GoType = typeName
 		outSchema.DefineViaAlias = true
 		return outSchema, nil
@@ -278,6 +657,15 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// Handle objects and empty schemas first as a special case
 	if t == "" || t == "object" {
 		var outType string
+		setPropertiesConstraints(&outSchema, schema)
+
+		if hasPatternProperties(schema) && len(schema.Properties) == 0 && !SchemaHasAdditionalProperties(schema) && schema.AnyOf == nil && schema.OneOf == nil {
+			// An object with only patternProperties is a map
+			if err := patternPropertiesSchemaToGoType(schema, path, &outSchema); err != nil {
+				return Schema{}, err
+			}
+			return outSchema, nil
+		}
 
 		if len(schema.Properties) == 0 && !SchemaHasAdditionalProperties(schema) && schema.AnyOf == nil && schema.OneOf == nil {
 			// If the object has no properties or additional properties, we
@@ -292,7 +680,10 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 				outType = "interface{}"
 			}
 			outSchema.GoType = outType
-			outSchema.DefineViaAlias = true
+			// A map whose number of properties is validated needs a type
+			// definition for its Validate method
+			outSchema.DefineViaAlias = t != "object" || !outSchema.HasPropertiesConstraints() ||
+				!globalState.options.OutputOptions.GenerateValidators
 		} else {
 			// When we define an object, we want it to be a type definition,
 			// not a type alias, eg, "type Foo struct {...}"
@@ -315,7 +706,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 				if err != nil {
 					return Schema{}, fmt.Errorf("error generating type for additional properties: %w", err)
 				}
-				if additionalSchema.HasAdditionalProperties || len(additionalSchema.UnionElements) != 0 {
+				if additionalSchema.needsTypeDefinition() {
 					// If we have fields present which have additional properties or union values,
 					// but are not a pre-defined type, we need to define a type
 					// for them, which will be based on the field names we followed
@@ -345,6 +736,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 				// that we won't generate custom json.Marshaler and json.Unmarshaler functions,
 				// since we don't need them for a simple map.
 				outSchema.HasAdditionalProperties = false
+				outSchema.IsAdditionalPropertiesMap = true
 				outSchema.GoType = fmt.Sprintf("map[string]%s", additionalPropertiesType(outSchema))
 				return outSchema, nil
 			}
@@ -360,7 +752,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 
 				required := StringInArray(pName, schema.Required)
 
-				if (pSchema.HasAdditionalProperties || len(pSchema.UnionElements) != 0) && pSchema.RefType == "" {
+				if pSchema.needsTypeDefinition() {
 					// If we have fields present which have additional properties or union values,
 					// but are not a pre-defined type, we need to define a type
 					// for them, which will be based on the field names we followed
@@ -390,10 +782,23 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 					WriteOnly:     p.Value.WriteOnly,
 					Extensions:    p.Value.Extensions,
 					Deprecated:    p.Value.Deprecated,
+					Recursive:     p.Ref != "" && containsByValue(p.Value, schemasInProgress, map[*openapi3.Schema]bool{}),
+				}
+				if globalState.options.OutputOptions.UseDefaultsForOptional && !required &&
+					!prop.Nullable && !prop.ReadOnly && !prop.WriteOnly {
+					prop.Default, prop.DefaultIsZero = defaultValue(pSchema)
 				}
 				outSchema.Properties = append(outSchema.Properties, prop)
 			}
 
+			if err := checkJSONTagConflicts(SortedSchemaKeys(schema.Properties)); err != nil {
+				return Schema{}, fmt.Errorf("error generating JSON tags for %s: %w", strings.Join(path, "."), err)
+			}
+
+			if err := checkFieldNameConflicts(outSchema.Properties); err != nil {
+				return Schema{}, fmt.Errorf("error generating fields for %s: %w", strings.Join(path, "."), err)
+			}
+
 			if schema.AnyOf != nil {
 				if err := generateUnion(&outSchema, schema.AnyOf, schema.Discriminator, path); err != nil {
 					return Schema{}, fmt.Errorf("error generating type for anyOf: %w", err)
@@ -414,20 +819,28 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		// so no matter what schema conversion thinks, we need to define a
 		// new type.
 		outSchema.DefineViaAlias = false
+		outSchema.Const = isConst
 
 		if err != nil {
 			return Schema{}, fmt.Errorf("error resolving primitive type: %w", err)
 		}
-		enumValues := make([]string, len(schema.Enum))
+		// A nullable enum lists null among its values, which is represented
+		// by a nil pointer to the enum type rather than by a constant.
+		enumValues := make([]string, 0, len(schema.Enum))
+		var nullIndices []int
 		for i, enumValue := range schema.Enum {
-			enumValues[i] = fmt.Sprintf("%v", enumValue)
+			if enumValue == nil {
+				nullIndices = append(nullIndices, i)
+				continue
+			}
+			enumValues = append(enumValues, fmt.Sprintf("%v", enumValue))
 		}
 
 		enumNames := enumValues
 		for _, key := range []string{extEnumVarNames, extEnumNames} {
 			if _, ok := schema.Extensions[key]; ok {
 				if extEnumNames, err := extParseEnumVarNames(schema.Extensions[key]); err == nil {
-					enumNames = extEnumNames
+					enumNames = withoutIndices(extEnumNames, nullIndices)
 					break
 				}
 			}
@@ -485,15 +898,28 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 	f := schema.Format
 	t := schema.Type
 
+	if mapping, ok := globalState.options.OutputOptions.TypeMappings[f]; ok && f != "" && t != "array" {
+		_, goType, err := parseTypeMapping(mapping)
+		if err != nil {
+			return fmt.Errorf("invalid type mapping for format %q: %w", f, err)
+		}
+		outSchema.GoType = goType
+		outSchema.DefineViaAlias = true
+		return nil
+	}
+
 	switch t {
 	case "array":
+		if hasPrefixItems(schema) {
+			return tupleSchemaToGoType(schema, path, outSchema)
+		}
 		// For arrays, we'll get the type of the Items and throw a
 		// [] in front of it.
 		arrayType, err := GenerateGoSchema(schema.Items, path)
 		if err != nil {
 			return fmt.Errorf("error generating type for array: %w", err)
 		}
-		if (arrayType.HasAdditionalProperties || len(arrayType.UnionElements) != 0) && arrayType.RefType == "" {
+		if arrayType.needsTypeDefinition() {
 			// If we have items which have additional properties or union values,
 			// but are not a pre-defined type, we need to define a type
 			// for them, which will be based on the field names we followed
@@ -511,6 +937,7 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 		}
 		outSchema.ArrayType = &arrayType
 		outSchema.GoType = "[]" + arrayType.TypeDecl()
+		setArrayConstraints(outSchema, schema)
 		outSchema.AdditionalTypes = arrayType.AdditionalTypes
 		outSchema.Properties = arrayType.Properties
 		outSchema.DefineViaAlias = true
@@ -536,19 +963,31 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 			outSchema.GoType = "uint8"
 		} else if f == "uint" {
 			outSchema.GoType = "uint"
+		} else if globalState.options.OutputOptions.UseJSONNumber && len(schema.Enum) == 0 && exceedsInt64(schema) {
+			outSchema.GoType = "json.Number"
 		} else {
 			outSchema.GoType = "int"
 		}
+		// json.Number can't be compared with the bounds, so isn't validated
+		if outSchema.GoType != "json.Number" {
+			setNumericConstraints(outSchema, schema)
+		}
 		outSchema.DefineViaAlias = true
 	case "number":
-		// We default to float for "number"
+		// We default to float for "number". Enums, including consts, keep
+		// it with use-json-number, since their values have to be constants.
 		if f == "double" {
 			outSchema.GoType = "float64"
+		} else if f == "" && globalState.options.OutputOptions.UseJSONNumber && len(schema.Enum) == 0 {
+			outSchema.GoType = "json.Number"
 		} else if f == "float" || f == "" {
 			outSchema.GoType = "float32"
 		} else {
 			return fmt.Errorf("invalid number format: %s", f)
 		}
+		if outSchema.GoType != "json.Number" {
+			setNumericConstraints(outSchema, schema)
+		}
 		outSchema.DefineViaAlias = true
 	case "boolean":
 		if f != "" {
@@ -560,7 +999,9 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 		// Special case string formats here.
 		switch f {
 		case "byte":
+			// A nil slice is omitted, so optional bytes don't need a pointer
 			outSchema.GoType = "[]byte"
+			outSchema.SkipOptionalPointer = true
 		case "email":
 			outSchema.GoType = "openapi_types.Email"
 		case "date":
@@ -603,16 +1044,17 @@ type FieldDescriptor struct {
 // GenFieldsFromProperties produce corresponding field names with JSON annotations,
 // given a list of schema descriptors
 func GenFieldsFromProperties(props []Property) []string {
+	return genFieldsFromProperties(props, hasXMLMetadata(props))
+}
+
+// genFieldsFromProperties is GenFieldsFromProperties, also adding xml tags
+// when withXMLTags is set.
+func genFieldsFromProperties(props []Property, withXMLTags bool) []string {
 	var fields []string
 	for i, p := range props {
 		field := ""
 
-		goFieldName := p.GoFieldName()
-		if _, ok := p.Extensions[extGoName]; ok {
-			if extGoFieldName, err := extParseGoFieldName(p.Extensions[extGoName]); err == nil {
-				goFieldName = extGoFieldName
-			}
-		}
+		goFieldName := p.GoStructFieldName()
 
 		// Add a comment to a field in case we have one, otherwise skip.
 		if p.Description != "" {
@@ -621,14 +1063,18 @@ func GenFieldsFromProperties(props []Property) []string {
 			if i != 0 {
 				field += "\n"
 			}
-			field += fmt.Sprintf("%s\n", StringWithTypeNameToGoComment(p.Description, p.GoFieldName()))
+			field += fmt.Sprintf("%s\n", FieldComment(p.Description, p.GoFieldName(), globalState.options.OutputOptions.CommentWidth))
 		}
 
 		if p.Deprecated {
-			// This comment has to be on its own line for godoc & IDEs to pick up
-			var deprecationReason string
+			// This comment has to be a paragraph of its own for godoc & IDEs to
+			// pick up
+			if p.Description != "" {
+				field += "//\n"
+			}
+			deprecationReason := "this property is deprecated."
 			if _, ok := p.Extensions[extDeprecationReason]; ok {
-				if extOmitEmpty, err := extParseDeprecationReason(p.Extensions[extDeprecationReason]); err == nil {
+				if extOmitEmpty, err := extParseDeprecationReason(p.Extensions[extDeprecationReason]); err == nil && extOmitEmpty != "" {
 					deprecationReason = extOmitEmpty
 				}
 			}
@@ -638,25 +1084,27 @@ func GenFieldsFromProperties(props []Property) []string {
 
 		field += fmt.Sprintf("    %s %s", goFieldName, p.GoTypeDef())
 
-		// Support x-omitempty
-		overrideOmitEmpty := true
-		if _, ok := p.Extensions[extPropOmitEmpty]; ok {
-			if extOmitEmpty, err := extParseOmitEmpty(p.Extensions[extPropOmitEmpty]); err == nil {
-				overrideOmitEmpty = extOmitEmpty
-			}
-		}
-
 		fieldTags := make(map[string]string)
 
-		if (p.Required && !p.ReadOnly && !p.WriteOnly) || p.Nullable || !overrideOmitEmpty || (p.Required && p.ReadOnly && globalState.options.Compatibility.DisableRequiredReadOnlyAsPointer) {
-			fieldTags["json"] = p.JsonFieldName
+		if p.OmitEmpty() {
+			fieldTags["json"] = p.JsonTagName() + ",omitempty"
 			if p.NeedsFormTag {
-				fieldTags["form"] = p.JsonFieldName
+				fieldTags["form"] = p.JsonFieldName + ",omitempty"
 			}
 		} else {
-			fieldTags["json"] = p.JsonFieldName + ",omitempty"
+			fieldTags["json"] = p.JsonTagName()
 			if p.NeedsFormTag {
-				fieldTags["form"] = p.JsonFieldName + ",omitempty"
+				fieldTags["form"] = p.JsonFieldName
+			}
+		}
+
+		if withXMLTags {
+			fieldTags["xml"] = p.xmlTag()
+		}
+
+		if globalState.options.OutputOptions.GinBindingTags {
+			if binding := p.ginBindingTag(); binding != "" {
+				fieldTags["binding"] = binding
 			}
 		}
 
@@ -688,24 +1136,200 @@ func GenFieldsFromProperties(props []Property) []string {
 	return fields
 }
 
-func additionalPropertiesType(schema Schema) string {
-	addPropsType := schema.AdditionalPropertiesType.GoType
-	if schema.AdditionalPropertiesType.RefType != "" {
-		addPropsType = schema.AdditionalPropertiesType.RefType
+// ginBindingFormats are the validators of gin's binding tags for the string
+// formats which are generated as strings. UUIDs are already checked when
+// they're decoded.
+var ginBindingFormats = map[string]string{
+	"email":    "email",
+	"hostname": "hostname",
+	"ipv4":     "ipv4",
+	"ipv6":     "ipv6",
+	"uri":      "uri",
+}
+
+// ginBindingTag returns the binding struct tag with which gin's validator
+// checks p, if any. Required properties held in pointers, slices or maps are
+// required, which rejects them when they're missing, while the zero values of
+// other fields, such as empty strings, are valid. Strings with a format it
+// knows are checked against it, when they're set or always there.
+func (p Property) ginBindingTag() string {
+	if p.HasNullableType() {
+		return ""
+	}
+	schema := p.Schema.OAPISchema
+	if p.Schema.RefOAPISchema != nil {
+		schema = p.Schema.RefOAPISchema
 	}
-	return addPropsType
+	if schema == nil {
+		return ""
+	}
+
+	typeDef := p.GoTypeDef()
+	nilable := strings.HasPrefix(typeDef, "*") || strings.HasPrefix(typeDef, "[]") || strings.HasPrefix(typeDef, "map[")
+	required := p.Required && !p.Nullable && !p.ReadOnly && nilable
+	validator, ok := ginBindingFormats[schema.Format]
+	hasFormat := ok && schema.Type == "string" && !p.Schema.IsRef() &&
+		(p.Schema.GoType == "string" || p.Schema.GoType == "openapi_types.Email")
+
+	switch {
+	case required && hasFormat:
+		return "required," + validator
+	case required:
+		return "required"
+	case hasFormat && p.Required && !nilable:
+		// The field is always there, so its value is always checked
+		return validator
+	case hasFormat:
+		// Missing values are only checked by required
+		return "omitempty," + validator
+	}
+	return ""
+}
+
+// schemaXML returns the xml metadata of s, or of the schema it refers to.
+func schemaXML(s Schema) *openapi3.XML {
+	if s.OAPISchema != nil && s.OAPISchema.XML != nil {
+		return s.OAPISchema.XML
+	}
+	if s.RefOAPISchema != nil {
+		return s.RefOAPISchema.XML
+	}
+	return nil
+}
+
+// hasXMLMetadata returns true if the schema of any of props, or of its items,
+// has xml metadata, in which case all of them get xml tags.
+func hasXMLMetadata(props []Property) bool {
+	for _, p := range props {
+		if schemaXML(p.Schema) != nil || p.Schema.ArrayType != nil && schemaXML(*p.Schema.ArrayType) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// xmlTag returns the xml struct tag of p, naming its element or attribute as
+// the xml metadata of its schema says, or after the property otherwise. The
+// name and namespace of a schema it refers to only name the root element of a
+// document, through MarshalXML. Arrays are the elements of their items, named
+// after the items, inside an element named after the array when they're
+// wrapped.
+func (p Property) xmlTag() string {
+	meta := schemaXML(p.Schema)
+	if meta == nil {
+		meta = &openapi3.XML{}
+	}
+	name := p.JsonFieldName
+	var namespace string
+	if own := p.Schema.OAPISchema; own != nil && own.XML != nil {
+		if own.XML.Name != "" {
+			name = own.XML.Name
+		}
+		namespace = own.XML.Namespace
+	}
+
+	tag := name
+	if meta.Attribute {
+		tag += ",attr"
+	} else if p.Schema.ArrayType != nil {
+		itemName := p.JsonFieldName
+		if items := schemaXML(*p.Schema.ArrayType); items != nil {
+			if items.Name != "" {
+				itemName = items.Name
+			}
+			if items.Namespace != "" {
+				namespace = items.Namespace
+			}
+		}
+		tag = itemName
+		if meta.Wrapped {
+			tag = name + ">" + itemName
+		}
+	}
+	if namespace != "" {
+		tag = namespace + " " + tag
+	}
+	if p.OmitEmpty() {
+		tag += ",omitempty"
+	}
+	return tag
+}
+
+// jsonTagName applies the configured JSON tag style to a name from the spec.
+func jsonTagName(name string) string {
+	switch globalState.options.OutputOptions.JSONTagStyle {
+	case JSONTagStyleCamel:
+		return ToLowerCamelCase(name)
+	case JSONTagStyleSnake:
+		return ToSnakeCase(name)
+	default:
+		return name
+	}
+}
+
+// checkJSONTagConflicts returns an error if the JSON tag style maps two of the
+// given names to the same JSON name, or maps a name to one which can't be
+// used in a JSON tag.
+func checkJSONTagConflicts(names []string) error {
+	style := globalState.options.OutputOptions.JSONTagStyle
+	if style == "" || style == JSONTagStyleSpec {
+		return nil
+	}
+
+	seen := make(map[string]string)
+	for _, name := range names {
+		tagName := jsonTagName(name)
+		if tagName == "" || tagName == "-" {
+			return fmt.Errorf("'%s' has no usable name in %s JSON tag style", name, style)
+		}
+		if other, found := seen[tagName]; found {
+			return fmt.Errorf("'%s' and '%s' both become '%s' in %s JSON tag style", other, name, tagName, style)
+		}
+		seen[tagName] = name
+	}
+	return nil
+}
+
+// checkFieldNameConflicts returns an error if two of the properties have the
+// same Go field name once normalized, such as "user_id" and "userId".
+func checkFieldNameConflicts(props []Property) error {
+	seen := make(map[string]string)
+	for _, p := range props {
+		fieldName := p.GoStructFieldName()
+		if other, found := seen[fieldName]; found {
+			return fmt.Errorf("'%s' and '%s' both have the Go field name %s, "+
+				"please use x-go-name to specify your own name for one of them", other, p.JsonFieldName, fieldName)
+		}
+		seen[fieldName] = p.JsonFieldName
+	}
+	return nil
+}
+
+func additionalPropertiesType(schema Schema) string {
+	return schema.AdditionalPropertiesType.TypeDecl()
 }
 
 func GenStructFromSchema(schema Schema) string {
 	// Start out with struct {
 	objectParts := []string{"struct {"}
+	// The name and namespace of the element, when the schema gives them, are
+	// given by MarshalXML, since properties name their own elements
+	withXMLTags := hasXMLMetadata(schema.Properties)
+	if meta := schemaXML(schema); meta != nil && (meta.Name != "" || meta.Namespace != "") {
+		withXMLTags = true
+	}
 	// Append all the field definitions
-	objectParts = append(objectParts, GenFieldsFromProperties(schema.Properties)...)
+	objectParts = append(objectParts, genFieldsFromProperties(schema.Properties, withXMLTags)...)
 	// Close the struct
 	if schema.HasAdditionalProperties {
+		// encoding/xml can't marshal maps
+		xmlTag := ""
+		if withXMLTags {
+			xmlTag = ` xml:"-"`
+		}
 		objectParts = append(objectParts,
-			fmt.Sprintf("AdditionalProperties map[string]%s `json:\"-\"`",
-				additionalPropertiesType(schema)))
+			fmt.Sprintf("AdditionalProperties map[string]%s `json:\"-\"%s`",
+				additionalPropertiesType(schema), xmlTag))
 	}
 	if len(schema.UnionElements) != 0 {
 		objectParts = append(objectParts, "union json.RawMessage")
@@ -736,18 +1360,20 @@ func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
 		}, nil
 	}
 
-	// Otherwise, look for application/json in there
-	mt, found := param.Content["application/json"]
-	if !found {
-		// If we don't have json, it's a string
-		return Schema{
-			GoType:      "string",
-			Description: StringToGoComment(param.Description),
-		}, nil
+	// Otherwise, look for a JSON media type in there, such as
+	// application/json or application/vnd.example+json
+	for contentType, mt := range param.Content {
+		if util.IsMediaTypeJson(contentType) {
+			// For json, we go through the standard schema mechanism
+			return GenerateGoSchema(mt.Schema, path)
+		}
 	}
 
-	// For json, we go through the standard schema mechanism
-	return GenerateGoSchema(mt.Schema, path)
+	// If we don't have json, it's a string
+	return Schema{
+		GoType:      "string",
+		Description: StringToGoComment(param.Description),
+	}, nil
 }
 
 func generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminator *openapi3.Discriminator, path []string) error {
@@ -758,6 +1384,15 @@ func generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminato
 		}
 	}
 
+	// Explicitly mapped discriminator values, which we check off as we find
+	// the union elements they refer to.
+	unmappedValues := make(map[string]string)
+	if discriminator != nil {
+		for k, v := range discriminator.Mapping {
+			unmappedValues[k] = v
+		}
+	}
+
 	refToGoTypeMap := make(map[string]string)
 	for i, element := range elements {
 		elementSchema, err := GenerateGoSchema(element, path)
@@ -778,13 +1413,13 @@ func generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminato
 				return errors.New("ambiguous discriminator.mapping: please replace inlined object with $ref")
 			}
 
-			// Explicit mapping.
+			// Explicit mapping. Several values may refer to the same schema.
 			var mapped bool
-			for k, v := range discriminator.Mapping {
-				if v == element.Ref {
+			for _, k := range SortedStringKeys(discriminator.Mapping) {
+				if discriminatorMappingRefersTo(discriminator.Mapping[k], element.Ref) {
 					outSchema.Discriminator.Mapping[k] = elementSchema.GoType
+					delete(unmappedValues, k)
 					mapped = true
-					break
 				}
 			}
 			// Implicit mapping.
@@ -795,9 +1430,42 @@ func generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminato
 		outSchema.UnionElements = append(outSchema.UnionElements, UnionElement(elementSchema.GoType))
 	}
 
-	if (outSchema.Discriminator != nil) && len(outSchema.Discriminator.Mapping) != len(elements) {
-		return errors.New("discriminator: not all schemas were mapped")
+	if len(unmappedValues) != 0 {
+		k := SortedStringKeys(unmappedValues)[0]
+		return fmt.Errorf("discriminator mapping %q of %s refers to %q, which is not one of the union's schemas",
+			k, strings.Join(path, "."), unmappedValues[k])
 	}
 
+	if outSchema.Discriminator != nil && countMappedTypes(outSchema.Discriminator.Mapping) != len(elements) {
+		return fmt.Errorf("discriminator of %s: not all schemas were mapped", strings.Join(path, "."))
+	}
+
+	// The As, From and Merge methods of the elements are generated in the
+	// order of their names, which doesn't change when the spec's elements
+	// are reordered.
+	sort.SliceStable(outSchema.UnionElements, func(i, j int) bool {
+		return outSchema.UnionElements[i].Method() < outSchema.UnionElements[j].Method()
+	})
+
 	return nil
 }
+
+// countMappedTypes returns the number of distinct Go types which a
+// discriminator mapping maps its values to.
+func countMappedTypes(mapping map[string]string) int {
+	types := make(map[string]bool, len(mapping))
+	for _, t := range mapping {
+		types[t] = true
+	}
+	return len(types)
+}
+
+// discriminatorMappingRefersTo returns whether a discriminator mapping value
+// refers to the schema with the given $ref. Mapping values are either
+// references, or names of component schemas.
+func discriminatorMappingRefersTo(mappingValue string, ref string) bool {
+	if mappingValue == ref {
+		return true
+	}
+	return !strings.Contains(mappingValue, "/") && ref == "#/components/schemas/"+mappingValue
+}
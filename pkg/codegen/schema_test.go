@@ -0,0 +1,99 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const discriminatorSpec = `
+openapi: 3.0.1
+info:
+  title: Discriminator mappings
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Cat:
+      type: object
+      properties:
+        kind:
+          type: string
+    Dog:
+      type: object
+      properties:
+        kind:
+          type: string
+    Bird:
+      type: object
+      properties:
+        kind:
+          type: string
+    ByRef:
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+        - $ref: '#/components/schemas/Dog'
+      discriminator:
+        propertyName: kind
+        mapping:
+          cat: '#/components/schemas/Cat'
+    ByName:
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+        - $ref: '#/components/schemas/Dog'
+      discriminator:
+        propertyName: kind
+        mapping:
+          cat: Cat
+          dog: Dog
+    Aliases:
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+        - $ref: '#/components/schemas/Dog'
+      discriminator:
+        propertyName: kind
+        mapping:
+          cat: '#/components/schemas/Cat'
+          kitten: '#/components/schemas/Cat'
+          dog: Dog
+    UnknownSchema:
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+        - $ref: '#/components/schemas/Dog'
+      discriminator:
+        propertyName: kind
+        mapping:
+          cat: '#/components/schemas/Cat'
+          bird: '#/components/schemas/Bird'
+`
+
+func TestGenerateUnionDiscriminatorMapping(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(discriminatorSpec))
+	require.NoError(t, err)
+	globalState.spec = swagger
+
+	t.Run("explicit and implicit mappings", func(t *testing.T) {
+		schema, err := GenerateGoSchema(swagger.Components.Schemas["ByRef"], []string{"ByRef"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"cat": "Cat", "Dog": "Dog"}, schema.Discriminator.Mapping)
+	})
+
+	t.Run("mapping by schema name", func(t *testing.T) {
+		schema, err := GenerateGoSchema(swagger.Components.Schemas["ByName"], []string{"ByName"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"cat": "Cat", "dog": "Dog"}, schema.Discriminator.Mapping)
+	})
+
+	t.Run("several values mapping to one schema", func(t *testing.T) {
+		schema, err := GenerateGoSchema(swagger.Components.Schemas["Aliases"], []string{"Aliases"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"cat": "Cat", "kitten": "Cat", "dog": "Dog"}, schema.Discriminator.Mapping)
+	})
+
+	t.Run("mapping to a schema outside the union", func(t *testing.T) {
+		_, err := GenerateGoSchema(swagger.Components.Schemas["UnknownSchema"], []string{"UnknownSchema"})
+		assert.EqualError(t, err, `error generating type for oneOf: discriminator mapping "bird" of UnknownSchema refers to "#/components/schemas/Bird", which is not one of the union's schemas`)
+	})
+}
@@ -31,12 +31,14 @@ const (
 	prefixLeastSpecific = "9"
 
 	defaultClientTypeName = "Client"
+	defaultPtrHelperName  = "Ptr"
 )
 
 var (
 	contentTypesJSON = []string{echo.MIMEApplicationJSON, "text/x-json", "application/problem+json"}
 	contentTypesYAML = []string{"application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml"}
 	contentTypesXML  = []string{echo.MIMEApplicationXML, echo.MIMETextXML, "application/problems+xml"}
+	contentTypesCSV  = []string{"text/csv"}
 
 	responseTypeSuffix = "Response"
 
@@ -96,6 +98,7 @@ func genResponsePayload(operationID string) string {
 	fmt.Fprintf(buffer, "&%s{\n", genResponseTypeName(operationID))
 	fmt.Fprintf(buffer, "Body: bodyBytes,\n")
 	fmt.Fprintf(buffer, "HTTPResponse: rsp,\n")
+	fmt.Fprintf(buffer, "HTTPRequest: capturedRequest(rsp),\n")
 	fmt.Fprintf(buffer, "}")
 
 	return buffer.String()
@@ -120,7 +123,30 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 	// Add a case for each possible response:
 	buffer := new(bytes.Buffer)
 	responses := op.Spec.Responses
+	condition := func(responseName string) string {
+		if responseName == "default" {
+			return op.DefaultResponseCondition("rsp.StatusCode")
+		}
+		return getConditionOfResponseName("rsp.StatusCode", responseName)
+	}
+	// Several content types of a response may share a field, such as JSON200,
+	// which the first of them declares. The others are decoded into it when
+	// they have the same type, and the first case for a key is kept.
+	fieldTypes := make(map[string]string)
+	for _, typeDefinition := range typeDefinitions {
+		if _, found := fieldTypes[typeDefinition.TypeName]; !found {
+			fieldTypes[typeDefinition.TypeName] = typeDefinition.Schema.TypeDecl()
+		}
+	}
+	addHandledCase := func(caseKey, caseClause string) {
+		if _, found := handledCaseClauses[caseKey]; !found {
+			handledCaseClauses[caseKey] = caseClause
+		}
+	}
 	for _, typeDefinition := range typeDefinitions {
+		if fieldTypes[typeDefinition.TypeName] != typeDefinition.Schema.TypeDecl() {
+			continue
+		}
 
 		responseRef, ok := responses[typeDefinition.ResponseName]
 		if !ok {
@@ -136,7 +162,7 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 		// If there is no content-type then we have no unmarshalling to do:
 		if len(responseRef.Value.Content) == 0 {
 			caseAction := "break // No content-type"
-			caseClauseKey := "case " + getConditionOfResponseName("rsp.StatusCode", typeDefinition.ResponseName) + ":"
+			caseClauseKey := "case " + condition(typeDefinition.ResponseName) + ":"
 			unhandledCaseClauses[prefixLeastSpecific+caseClauseKey] = fmt.Sprintf("%s\n%s\n", caseClauseKey, caseAction)
 			continue
 		}
@@ -157,16 +183,20 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 			// JSON:
 			case StringInArray(contentTypeName, contentTypesJSON) || util.IsMediaTypeJson(contentTypeName):
 				if typeDefinition.ContentTypeName == contentTypeName {
+					decode := "json.Unmarshal(bodyBytes, &dest)"
+					if globalState.options.OutputOptions.UseJSONNumber {
+						decode = "runtime.UnmarshalJSONWithNumbers(bodyBytes, &dest)"
+					}
 					caseAction := fmt.Sprintf("var dest %s\n"+
-						"if err := json.Unmarshal(bodyBytes, &dest); err != nil { \n"+
+						"if err := %s; err != nil { \n"+
 						" return nil, err \n"+
 						"}\n"+
 						"response.%s = &dest",
 						typeDefinition.Schema.TypeDecl(),
+						decode,
 						typeDefinition.TypeName)
 
-					caseKey, caseClause := buildUnmarshalCase(typeDefinition, caseAction, "json")
-					handledCaseClauses[caseKey] = caseClause
+					addHandledCase(buildUnmarshalCase(typeDefinition, condition(typeDefinition.ResponseName), caseAction, "json"))
 				}
 
 			// YAML:
@@ -179,8 +209,7 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 						"response.%s = &dest",
 						typeDefinition.Schema.TypeDecl(),
 						typeDefinition.TypeName)
-					caseKey, caseClause := buildUnmarshalCase(typeDefinition, caseAction, "yaml")
-					handledCaseClauses[caseKey] = caseClause
+					addHandledCase(buildUnmarshalCase(typeDefinition, condition(typeDefinition.ResponseName), caseAction, "yaml"))
 				}
 
 			// XML:
@@ -193,14 +222,26 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 						"response.%s = &dest",
 						typeDefinition.Schema.TypeDecl(),
 						typeDefinition.TypeName)
-					caseKey, caseClause := buildUnmarshalCase(typeDefinition, caseAction, "xml")
-					handledCaseClauses[caseKey] = caseClause
+					addHandledCase(buildUnmarshalCase(typeDefinition, condition(typeDefinition.ResponseName), caseAction, "xml"))
+				}
+
+			// CSV:
+			case StringInArray(contentTypeName, contentTypesCSV) && isCSVRowsSchema(responseRef.Value.Content[contentTypeName].Schema):
+				if typeDefinition.ContentTypeName == contentTypeName {
+					caseAction := fmt.Sprintf("var dest %s\n"+
+						"if err := runtime.UnmarshalCSV(bodyBytes, &dest); err != nil { \n"+
+						" return nil, err \n"+
+						"}\n"+
+						"response.%s = &dest",
+						typeDefinition.Schema.TypeDecl(),
+						typeDefinition.TypeName)
+					addHandledCase(buildUnmarshalCase(typeDefinition, condition(typeDefinition.ResponseName), caseAction, "csv"))
 				}
 
 			// Everything else:
 			default:
 				caseAction := fmt.Sprintf("// Content-type (%s) unsupported", contentTypeName)
-				caseClauseKey := "case " + getConditionOfResponseName("rsp.StatusCode", typeDefinition.ResponseName) + ":"
+				caseClauseKey := "case " + condition(typeDefinition.ResponseName) + ":"
 				unhandledCaseClauses[prefixLeastSpecific+caseClauseKey] = fmt.Sprintf("%s\n%s\n", caseClauseKey, caseAction)
 			}
 		}
@@ -228,10 +269,19 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 	return buffer.String()
 }
 
-// buildUnmarshalCase builds an unmarshalling case clause for different content-types:
-func buildUnmarshalCase(typeDefinition ResponseTypeDefinition, caseAction string, contentType string) (caseKey string, caseClause string) {
+// buildUnmarshalCase builds an unmarshalling case clause for different content-types,
+// for responses whose status code satisfies caseClauseKey.
+// With client-accept-header, which may have the server answer in any of the
+// content types of the response, the Content-Type of the response must be the
+// type definition's, rather than merely contain the name of its encoding, so
+// each content type has a case of its own.
+func buildUnmarshalCase(typeDefinition ResponseTypeDefinition, caseClauseKey string, caseAction string, contentType string) (caseKey string, caseClause string) {
 	caseKey = fmt.Sprintf("%s.%s.%s", prefixLeastSpecific, contentType, typeDefinition.ResponseName)
-	caseClauseKey := getConditionOfResponseName("rsp.StatusCode", typeDefinition.ResponseName)
+	if globalState.options.OutputOptions.ClientAcceptHeader {
+		caseKey += "." + typeDefinition.ContentTypeName
+		caseClause = fmt.Sprintf("case runtime.MatchesContentType(rsp.Header.Get(\"%s\"), \"%s\") && %s:\n%s\n", echo.HeaderContentType, typeDefinition.ContentTypeName, caseClauseKey, caseAction)
+		return caseKey, caseClause
+	}
 	caseClause = fmt.Sprintf("case strings.Contains(rsp.Header.Get(\"%s\"), \"%s\") && %s:\n%s\n", echo.HeaderContentType, contentType, caseClauseKey, caseAction)
 	return caseKey, caseClause
 }
@@ -241,12 +291,23 @@ func genResponseTypeName(operationID string) string {
 	return fmt.Sprintf("%s%s", UppercaseFirstCharacter(operationID), responseTypeSuffix)
 }
 
+// getResponseTypeDefinitions returns the type definitions of the fields of
+// an operation's response, declaring each field once, for the first of the
+// content types which share it.
 func getResponseTypeDefinitions(op *OperationDefinition) []ResponseTypeDefinition {
-	td, err := op.GetResponseTypeDefinitions()
+	tds, err := op.GetResponseTypeDefinitions()
 	if err != nil {
 		panic(err)
 	}
-	return td
+	var fields []ResponseTypeDefinition
+	declared := make(map[string]bool)
+	for _, td := range tds {
+		if !declared[td.TypeName] {
+			declared[td.TypeName] = true
+			fields = append(fields, td)
+		}
+	}
+	return fields
 }
 
 // Return the statusCode comparison clause from the response name.
@@ -261,6 +322,36 @@ func getConditionOfResponseName(statusCodeVar, responseName string) string {
 	}
 }
 
+// genChiURLParam generates the expression for the value of a path parameter
+// in a chi handler. The value of a parameter with a resource name pattern is
+// put back together from the route parameters for its wildcards.
+func genChiURLParam(param ParameterDefinition) string {
+	if param.ResourcePattern == "" {
+		return fmt.Sprintf("chi.URLParam(r, %q)", param.ParamName)
+	}
+	var parts []string
+	var literal string
+	for i, segment := range strings.Split(param.ResourcePattern, "/") {
+		if i > 0 {
+			literal += "/"
+		}
+		if segment != "*" && segment != "**" {
+			literal += segment
+			continue
+		}
+		if literal != "" {
+			parts = append(parts, fmt.Sprintf("%q", literal))
+			literal = ""
+		}
+		key := strings.Trim(chiResourceSegment(param.ParamName, i, segment), "{}")
+		parts = append(parts, fmt.Sprintf("chi.URLParam(r, %q)", key))
+	}
+	if literal != "" {
+		parts = append(parts, fmt.Sprintf("%q", literal))
+	}
+	return strings.Join(parts, " + ")
+}
+
 // This outputs a string array
 func toStringArray(sarr []string) string {
 	s := strings.Join(sarr, `","`)
@@ -275,6 +366,28 @@ func stripNewLines(s string) string {
 	return r.Replace(s)
 }
 
+// hasMaxBodyBytes returns whether the size of the request bodies of any of
+// the operations is limited, so that servers have to answer 413 when a body
+// is larger.
+func hasMaxBodyBytes(ops []OperationDefinition) bool {
+	for _, op := range ops {
+		if op.MaxBodyBytes != 0 && !op.WebSocket {
+			return true
+		}
+	}
+	return false
+}
+
+// genServerInterfaces returns the interfaces which the ServerInterface is made
+// of: one per tag when interfaces are split by tag, or else the ServerInterface
+// itself.
+func genServerInterfaces(ops []OperationDefinition) ([]ServerInterfaceDefinition, error) {
+	if globalState.options.OutputOptions.InterfacePerTag {
+		return GroupOperationsByTag(ops)
+	}
+	return []ServerInterfaceDefinition{{TypeName: "ServerInterface", Operations: ops}}, nil
+}
+
 // TemplateFunctions is passed to the template engine, and we can call each
 // function here by keyName from the template code.
 var TemplateFunctions = template.FuncMap{
@@ -284,6 +397,7 @@ var TemplateFunctions = template.FuncMap{
 	"genParamFmtString":          ReplacePathParamsWithStr,
 	"swaggerUriToEchoUri":        SwaggerUriToEchoUri,
 	"swaggerUriToChiUri":         SwaggerUriToChiUri,
+	"genChiURLParam":             genChiURLParam,
 	"swaggerUriToGinUri":         SwaggerUriToGinUri,
 	"swaggerUriToGorillaUri":     SwaggerUriToGorillaUri,
 	"lcFirst":                    LowercaseFirstCharacter,
@@ -294,10 +408,15 @@ var TemplateFunctions = template.FuncMap{
 	"genResponseTypeName":        genResponseTypeName,
 	"genResponseUnmarshal":       genResponseUnmarshal,
 	"getResponseTypeDefinitions": getResponseTypeDefinitions,
+	"hasMaxBodyBytes":            hasMaxBodyBytes,
 	"toStringArray":              toStringArray,
 	"lower":                      strings.ToLower,
 	"title":                      titleCaser.String,
 	"stripNewLines":              stripNewLines,
 	"sanitizeGoIdentity":         SanitizeGoIdentity,
 	"toGoComment":                StringWithTypeNameToGoComment,
+	"formatNumber":               formatNumber,
+	"serverInterfaces":           genServerInterfaces,
+	"modelsPkg":                  modelsQualifier,
+	"receiver":                   ReceiverName,
 }
@@ -0,0 +1,222 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// keywordPrefixItems is the JSON Schema keyword, from OpenAPI 3.1, giving the
+// schemas of the leading items of an array. kin-openapi doesn't know it, so
+// it's kept among the extensions of the schema.
+const keywordPrefixItems = "prefixItems"
+
+// TupleItem is one of the leading items of an array with prefixItems, which
+// is generated as a field of a struct.
+type TupleItem struct {
+	GoFieldName string
+	Schema      Schema
+	// Required is set for the items which minItems requires. The others are
+	// pointers, and may be missing.
+	Required bool
+}
+
+// GoTypeDef returns the type of the item's field
+func (t TupleItem) GoTypeDef() string {
+	if t.Required {
+		return t.Schema.TypeDecl()
+	}
+	return "*" + t.Schema.TypeDecl()
+}
+
+// TupleDefinition is a type with prefixItems, for which MarshalJSON and
+// UnmarshalJSON methods are generated.
+type TupleDefinition struct {
+	TypeName string
+	Items    []TupleItem
+	Rest     *Schema
+	// Closed is set when the array allows no items past its prefixItems
+	Closed bool
+	// MinItems is the number of items which are required
+	MinItems int
+	// Underlying is set for types defined as another tuple type, whose
+	// methods they call.
+	Underlying string
+}
+
+// LastRequired returns the index of the last required item, or -1 if none is
+func (t TupleDefinition) LastRequired() int {
+	return t.MinItems - 1
+}
+
+// hasPrefixItems returns true if schema is an array with prefixItems
+func hasPrefixItems(schema *openapi3.Schema) bool {
+	items, ok := schema.Extensions[keywordPrefixItems].([]interface{})
+	return ok && len(items) != 0
+}
+
+// decodePrefixItems returns the schemas of the prefixItems of schema, if it
+// has any, leaving their references unresolved.
+func decodePrefixItems(schema *openapi3.Schema) ([]*openapi3.SchemaRef, error) {
+	if !hasPrefixItems(schema) {
+		return nil, nil
+	}
+	data, err := json.Marshal(schema.Extensions[keywordPrefixItems])
+	if err != nil {
+		return nil, fmt.Errorf("error encoding prefixItems: %w", err)
+	}
+	var items []*openapi3.SchemaRef
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("error decoding prefixItems: %w", err)
+	}
+	return items, nil
+}
+
+// prefixItems returns the schemas of the prefixItems of schema, with their
+// references to the schemas of the spec resolved.
+func prefixItems(schema *openapi3.Schema) ([]*openapi3.SchemaRef, error) {
+	items, err := decodePrefixItems(schema)
+	if err != nil {
+		return nil, err
+	}
+	for i, item := range items {
+		if err := resolveLocalSchemaRefs(item); err != nil {
+			return nil, fmt.Errorf("error resolving prefixItems item %d: %w", i, err)
+		}
+	}
+	return items, nil
+}
+
+// resolveLocalSchemaRefs sets the values of the references in sref, and the
+// schemas it holds, to the schemas of the spec they refer to. Only references
+// to #/components/schemas are supported.
+func resolveLocalSchemaRefs(sref *openapi3.SchemaRef) error {
+	if sref == nil {
+		return nil
+	}
+	if sref.Ref != "" {
+		const prefix = "#/components/schemas/"
+		if !strings.HasPrefix(sref.Ref, prefix) || globalState.spec == nil || globalState.spec.Components == nil {
+			return fmt.Errorf("unsupported reference %s, only references to %s are supported", sref.Ref, prefix)
+		}
+		target, ok := globalState.spec.Components.Schemas[strings.TrimPrefix(sref.Ref, prefix)]
+		if !ok || target.Value == nil {
+			return fmt.Errorf("reference %s doesn't exist", sref.Ref)
+		}
+		sref.Value = target.Value
+		return nil
+	}
+	schema := sref.Value
+	if schema == nil {
+		return nil
+	}
+	refs := []*openapi3.SchemaRef{schema.Items, schema.Not, schema.AdditionalProperties.Schema}
+	refs = append(refs, schema.AllOf...)
+	refs = append(refs, schema.AnyOf...)
+	refs = append(refs, schema.OneOf...)
+	for _, p := range schema.Properties {
+		refs = append(refs, p)
+	}
+	for _, ref := range refs {
+		if err := resolveLocalSchemaRefs(ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tupleSchemaToGoType generates a struct for an array with prefixItems, with
+// an ItemN field for each of them, and a Rest field for any further items,
+// unless items is false, which the loader turns into a maxItems allowing no
+// more.
+func tupleSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schema) error {
+	items, err := prefixItems(schema)
+	if err != nil {
+		return err
+	}
+
+	fields := make([]string, 0, len(items)+1)
+	for i, item := range items {
+		itemPath := append(path, fmt.Sprintf("Item%d", i))
+		itemSchema, err := GenerateGoSchema(item, itemPath)
+		if err != nil {
+			return fmt.Errorf("error generating type for prefixItems item %d: %w", i, err)
+		}
+		if itemSchema.needsTypeDefinition() {
+			// Like the items of arrays, these need a type of their own
+			typeName := PathToTypeName(itemPath)
+			itemSchema.AdditionalTypes = append(itemSchema.AdditionalTypes, TypeDefinition{
+				TypeName: typeName,
+				JsonName: strings.Join(itemPath, "."),
+				Schema:   itemSchema,
+			})
+			itemSchema.RefType = typeName
+		}
+		tupleItem := TupleItem{
+			GoFieldName: fmt.Sprintf("Item%d", i),
+			Schema:      itemSchema,
+			Required:    uint64(i) < schema.MinItems,
+		}
+		outSchema.TupleItems = append(outSchema.TupleItems, tupleItem)
+		outSchema.AdditionalTypes = append(outSchema.AdditionalTypes, itemSchema.AdditionalTypes...)
+		fields = append(fields, fmt.Sprintf("%s %s", tupleItem.GoFieldName, tupleItem.GoTypeDef()))
+	}
+
+	if schema.MaxItems == nil || *schema.MaxItems > uint64(len(items)) {
+		restPath := append(path, "Rest")
+		rest, err := GenerateGoSchema(schema.Items, restPath)
+		if err != nil {
+			return fmt.Errorf("error generating type for items after prefixItems: %w", err)
+		}
+		if rest.needsTypeDefinition() {
+			typeName := PathToTypeName(restPath)
+			rest.AdditionalTypes = append(rest.AdditionalTypes, TypeDefinition{
+				TypeName: typeName,
+				JsonName: strings.Join(restPath, "."),
+				Schema:   rest,
+			})
+			rest.RefType = typeName
+		}
+		outSchema.TupleRest = &rest
+		outSchema.AdditionalTypes = append(outSchema.AdditionalTypes, rest.AdditionalTypes...)
+		fields = append(fields, "Rest []"+rest.TypeDecl())
+	}
+
+	outSchema.GoType = "struct {\n" + strings.Join(fields, "\n") + "\n}"
+	return nil
+}
+
+// GenerateTupleBoilerplate generates MarshalJSON and UnmarshalJSON methods
+// for the types with prefixItems, which encode them as JSON arrays.
+func GenerateTupleBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	isTuple := func(td TypeDefinition) bool {
+		return len(td.Schema.TupleItems) != 0 && !td.IsAlias() && !td.Schema.IsRef()
+	}
+	tuples, err := methodDefinitions(typeDefs, isTuple, func(td TypeDefinition) (TupleDefinition, bool, error) {
+		minItems := 0
+		for i, item := range td.Schema.TupleItems {
+			if item.Required {
+				minItems = i + 1
+			}
+		}
+		return TupleDefinition{
+			TypeName: td.TypeName,
+			Items:    td.Schema.TupleItems,
+			Rest:     td.Schema.TupleRest,
+			Closed:   td.Schema.TupleRest == nil,
+			MinItems: minItems,
+		}, true, nil
+	}, func(typeName, underlying string, _ TupleDefinition) TupleDefinition {
+		return TupleDefinition{TypeName: typeName, Underlying: underlying}
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(tuples) == 0 {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"tuple.tmpl"}, t, tuples)
+}
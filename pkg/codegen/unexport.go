@@ -0,0 +1,233 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// identEdit replaces the identifier at an offset of a file with a new name.
+type identEdit struct {
+	offset int
+	name   string
+	length int
+}
+
+// unexportIdentifiers rewrites the generated files of a package, keyed by file
+// name, so that the package-level types, functions, constants and variables
+// which they declare are unexported, along with the names of the embedded
+// fields of those types and their mentions in comments, for the
+// unexported-types option. Methods and struct fields keep their names, so
+// that types still implement interfaces like json.Marshaler and are
+// marshaled as usual.
+func unexportIdentifiers(packageName string, files map[string]string) (map[string]string, error) {
+	fset := token.NewFileSet()
+	names := SortedStringKeys(files)
+	var parsed []*ast.File
+	for _, name := range names {
+		f, err := parser.ParseFile(fset, name, files[name], parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing generated code: %w", err)
+		}
+		parsed = append(parsed, f)
+	}
+
+	// Imported packages aren't loaded, so references to them don't resolve,
+	// but those to the package's own identifiers, which are all that's
+	// renamed, do.
+	info := &types.Info{
+		Defs: make(map[*ast.Ident]types.Object),
+		Uses: make(map[*ast.Ident]types.Object),
+	}
+	conf := types.Config{Importer: emptyImporter{}, Error: func(error) {}}
+	pkg, _ := conf.Check(packageName, fset, parsed, info)
+
+	// Names of the imported packages which are used can't be taken, while
+	// goimports removes the others.
+	reserved := make(map[string]bool)
+	for _, obj := range info.Uses {
+		if pkgName, ok := obj.(*types.PkgName); ok {
+			reserved[pkgName.Name()] = true
+		}
+	}
+
+	renamed := make(map[types.Object]string)
+	taken := make(map[string]string)
+	for _, name := range pkg.Scope().Names() {
+		obj := pkg.Scope().Lookup(name)
+		if !obj.Exported() {
+			continue
+		}
+		newName := LowercaseFirstCharacter(name)
+		if IsGoKeyword(newName) || IsPredeclaredGoIdentifier(newName) || reserved[newName] {
+			newName += unexportSuffix(obj)
+		}
+		if pkg.Scope().Lookup(newName) != nil {
+			return nil, fmt.Errorf("can't unexport %s, since %s is already declared", name, newName)
+		}
+		if other, ok := taken[newName]; ok {
+			return nil, fmt.Errorf("can't unexport both %s and %s, which would both be %s", other, name, newName)
+		}
+		taken[newName] = name
+		renamed[obj] = newName
+	}
+
+	// renamedName returns the new name of an identifier, which is that of
+	// the object it refers to, or, for embedded fields, that of their type.
+	renamedName := func(obj types.Object) (string, bool) {
+		if v, ok := obj.(*types.Var); ok && v.Embedded() {
+			t := v.Type()
+			if p, ok := t.(*types.Pointer); ok {
+				t = p.Elem()
+			}
+			if named, ok := t.(*types.Named); ok {
+				obj = named.Obj()
+			}
+		}
+		name, ok := renamed[obj]
+		return name, ok
+	}
+
+	edits := make(map[string][]identEdit)
+	seen := make(map[token.Pos]bool)
+	addEdit := func(ident *ast.Ident, name string) {
+		if seen[ident.Pos()] {
+			return
+		}
+		seen[ident.Pos()] = true
+		position := fset.Position(ident.Pos())
+		edits[position.Filename] = append(edits[position.Filename], identEdit{offset: position.Offset, name: name, length: len(ident.Name)})
+	}
+	for ident, obj := range info.Defs {
+		if name, ok := renamedName(obj); ok {
+			addEdit(ident, name)
+		}
+	}
+	for ident, obj := range info.Uses {
+		name, ok := renamedName(obj)
+		if !ok {
+			continue
+		}
+		// The new name mustn't refer to something else where it's used,
+		// unless it's that of a field, which isn't looked up in scopes
+		if _, direct := renamed[obj]; !direct {
+			addEdit(ident, name)
+			continue
+		}
+		if _, other := pkg.Scope().Innermost(ident.Pos()).LookupParent(name, ident.Pos()); other != nil {
+			return nil, fmt.Errorf("can't unexport %s, since %s refers to something else at %s", ident.Name, name, fset.Position(ident.Pos()))
+		}
+		addEdit(ident, name)
+	}
+
+	// Comments mention the identifiers by name, though not after a dot,
+	// where they're those of imported packages or methods
+	if len(renamed) != 0 {
+		oldNames := make(map[string]string, len(renamed))
+		var alternatives []string
+		for obj, name := range renamed {
+			oldNames[obj.Name()] = name
+			alternatives = append(alternatives, regexp.QuoteMeta(obj.Name()))
+		}
+		sort.Strings(alternatives)
+		pattern := regexp.MustCompile(`\b(` + strings.Join(alternatives, "|") + `)\b`)
+		for _, f := range parsed {
+			keptDocs := keptNameDocs(f)
+			for _, group := range f.Comments {
+				for _, comment := range group.List {
+					position := fset.Position(comment.Pos())
+					for _, match := range pattern.FindAllStringIndex(comment.Text, -1) {
+						if match[0] > 0 && comment.Text[match[0]-1] == '.' {
+							continue
+						}
+						if match[0] == len("// ") && keptDocs[comment] == comment.Text[match[0]:match[1]] {
+							continue
+						}
+						oldName := comment.Text[match[0]:match[1]]
+						edits[position.Filename] = append(edits[position.Filename], identEdit{offset: position.Offset + match[0], name: oldNames[oldName], length: len(oldName)})
+					}
+				}
+			}
+		}
+	}
+
+	return applyIdentEdits(files, edits), nil
+}
+
+// applyIdentEdits returns files, keyed by name, with the edits to each made.
+func applyIdentEdits(files map[string]string, edits map[string][]identEdit) map[string]string {
+	out := make(map[string]string, len(files))
+	for name, code := range files {
+		fileEdits := edits[name]
+		sort.Slice(fileEdits, func(i, j int) bool { return fileEdits[i].offset > fileEdits[j].offset })
+		for _, edit := range fileEdits {
+			code = code[:edit.offset] + edit.name + code[edit.offset+edit.length:]
+		}
+		out[name] = code
+	}
+	return out
+}
+
+// keptNameDocs returns the first comments of the doc comments of the methods
+// and struct fields of a file, which keep their names, along with those names,
+// so that a method like Error isn't documented as though it were a type of the
+// same name.
+func keptNameDocs(f *ast.File) map[*ast.Comment]string {
+	docs := make(map[*ast.Comment]string)
+	ast.Inspect(f, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.FuncDecl:
+			if node.Recv != nil && node.Doc != nil {
+				docs[node.Doc.List[0]] = node.Name.Name
+			}
+		case *ast.Field:
+			if node.Doc != nil && len(node.Names) != 0 {
+				docs[node.Doc.List[0]] = node.Names[0].Name
+			}
+		}
+		return true
+	})
+	return docs
+}
+
+// unexportSuffix returns what's appended to the unexported name of an object
+// which would otherwise be a keyword, a predeclared identifier or the name of
+// an imported package, so that Error becomes errorType rather than error.
+func unexportSuffix(obj types.Object) string {
+	switch obj.(type) {
+	case *types.TypeName:
+		return "Type"
+	case *types.Func:
+		return "Func"
+	case *types.Const:
+		return "Const"
+	default:
+		return "Var"
+	}
+}
+
+// versionSuffix matches the major version suffixes of import paths, like /v5
+// or .v2.
+var versionSuffix = regexp.MustCompile(`[./]v[0-9]+$`)
+
+// packageNameOfPath guesses the name of the package with the given import
+// path, which is usually its last element, without any major version.
+func packageNameOfPath(importPath string) string {
+	return strings.ReplaceAll(path.Base(versionSuffix.ReplaceAllString(importPath, "")), "-", "")
+}
+
+// emptyImporter imports packages without any declarations, which is all the
+// type checking of unexportIdentifiers and renameReceivers needs.
+type emptyImporter struct{}
+
+func (emptyImporter) Import(importPath string) (*types.Package, error) {
+	pkg := types.NewPackage(importPath, packageNameOfPath(importPath))
+	pkg.MarkComplete()
+	return pkg, nil
+}
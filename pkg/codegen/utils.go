@@ -33,7 +33,7 @@ var (
 )
 
 func init() {
-	pathParamRE = regexp.MustCompile(`{[.;?]?([^{}*]+)\*?}`)
+	pathParamRE = regexp.MustCompile(`{[.;?]?([^{}*=]+)(?:=([^{}]+))?\*?}`)
 
 	predeclaredIdentifiers := []string{
 		// Types
@@ -132,6 +132,21 @@ func LowercaseFirstCharacter(str string) string {
 	return string(runes)
 }
 
+// ReceiverName returns the name of the receiver of the methods generated for
+// typeName: the lowercase initials of its words, such as ps for PetStore.
+// When those are a keyword or a predeclared identifier, more of its first
+// word is taken. Generated methods which use the name for something else get
+// another, see renameReceivers.
+func ReceiverName(typeName string) string {
+	return receiverName(typeName, isReservedReceiver)
+}
+
+// isReservedReceiver returns true if name can't be the receiver of any
+// generated method.
+func isReservedReceiver(name string) bool {
+	return IsGoKeyword(name) || IsPredeclaredGoIdentifier(name)
+}
+
 // ToCamelCase will convert query-arg style strings to CamelCase. We will
 // use `., -, +, :, ;, _, ~, ' ', (, ), {, }, [, ]` as valid delimiters for words.
 // So, "word.word-word+word:word;word_word~word word(word)word{word}[word]"
@@ -160,6 +175,134 @@ func ToCamelCase(str string) string {
 	return n
 }
 
+// ToCamelCaseWithDigits is like ToCamelCase, but also starts a new word after
+// digits, so that "v2user" becomes "V2User" rather than "V2user".
+func ToCamelCaseWithDigits(str string) string {
+	s := strings.Trim(str, " ")
+
+	n := ""
+	capNext := true
+	for _, v := range s {
+		switch {
+		case unicode.IsUpper(v):
+			n += string(v)
+			capNext = false
+		case unicode.IsDigit(v):
+			n += string(v)
+			capNext = true
+		case unicode.IsLower(v):
+			if capNext {
+				n += strings.ToUpper(string(v))
+			} else {
+				n += string(v)
+			}
+			capNext = false
+		default:
+			_, capNext = separatorSet[v]
+		}
+	}
+	return n
+}
+
+// initialisms are the words which ToCamelCaseWithInitialisms writes in upper
+// case, following the Go convention for names like ID and HTTP.
+var initialisms = map[string]bool{
+	"ACL": true, "API": true, "ASCII": true, "CPU": true, "CSS": true,
+	"DNS": true, "EOF": true, "GUID": true, "HTML": true, "HTTP": true,
+	"HTTPS": true, "ID": true, "IP": true, "JSON": true, "JWT": true,
+	"LHS": true, "QPS": true, "RAM": true, "RHS": true, "RPC": true,
+	"SLA": true, "SMTP": true, "SQL": true, "SSH": true, "TCP": true,
+	"TLS": true, "TTL": true, "UDP": true, "UI": true, "UID": true,
+	"URI": true, "URL": true, "UTF8": true, "UUID": true, "VM": true,
+	"XML": true, "XMPP": true, "XSRF": true, "XSS": true,
+}
+
+// ToCamelCaseWithInitialisms is like ToCamelCase, but writes initialisms in
+// upper case, so that "user_id" becomes "UserID" and "httpUrl" becomes
+// "HTTPURL".
+func ToCamelCaseWithInitialisms(str string) string {
+	words := splitWords(ToCamelCase(str))
+	for i, w := range words {
+		if upper := strings.ToUpper(w); initialisms[upper] {
+			words[i] = upper
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// NameNormalizers maps the supported values of OutputOptions.NameNormalizer
+// to the functions which turn names in the spec into Go identifiers.
+var NameNormalizers = map[string]func(string) string{
+	"":                                 ToCamelCase,
+	NameNormalizerDefault:              ToCamelCase,
+	NameNormalizerCamelCaseWithDigits:  ToCamelCaseWithDigits,
+	NameNormalizerCamelCaseInitialisms: ToCamelCaseWithInitialisms,
+}
+
+// normalizeName turns a name in the spec into a Go identifier, with the
+// name normalizer chosen by the configuration, or ToCamelCase if there's none.
+func normalizeName(str string) string {
+	if normalizer, found := NameNormalizers[globalState.options.OutputOptions.NameNormalizer]; found {
+		return normalizer(str)
+	}
+	return ToCamelCase(str)
+}
+
+// splitWords splits a name into words at separators and at changes of case,
+// so that "first_name", "first-name" and "firstName" all give "first" and
+// "name", and "HTTPCode" gives "HTTP" and "Code".
+func splitWords(str string) []string {
+	var words []string
+	var word []rune
+	runes := []rune(str)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(word) != 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			continue
+		}
+		if unicode.IsUpper(r) && len(word) != 0 {
+			prev := word[len(word)-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if !unicode.IsUpper(prev) || nextIsLower {
+				words = append(words, string(word))
+				word = nil
+			}
+		}
+		word = append(word, r)
+	}
+	if len(word) != 0 {
+		words = append(words, string(word))
+	}
+	return words
+}
+
+// ToSnakeCase converts a name to snake_case, so that "firstName" and
+// "first-name" both become "first_name".
+func ToSnakeCase(str string) string {
+	words := splitWords(str)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, "_")
+}
+
+// ToLowerCamelCase converts a name to camelCase, so that "first_name" and
+// "FirstName" both become "firstName".
+func ToLowerCamelCase(str string) string {
+	words := splitWords(str)
+	for i, w := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(w)
+		} else {
+			words[i] = UppercaseFirstCharacter(w)
+		}
+	}
+	return strings.Join(words, "")
+}
+
 // SortedSchemaKeys returns the keys of the given SchemaRef dictionary in sorted
 // order, since Golang scrambles dictionary keys
 func SortedSchemaKeys(dict map[string]*openapi3.SchemaRef) []string {
@@ -233,6 +376,30 @@ func SortedContentKeys(dict openapi3.Content) []string {
 	return keys
 }
 
+// SortedServerVariableKeys returns the names of server variables in sorted order
+func SortedServerVariableKeys(dict map[string]*openapi3.ServerVariable) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// SortedExtensionKeys returns the names of extensions in sorted order
+func SortedExtensionKeys(dict map[string]interface{}) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // SortedStringKeys returns string map keys in sorted order
 func SortedStringKeys(dict map[string]string) []string {
 	keys := make([]string, len(dict))
@@ -314,8 +481,15 @@ func RefPathToObjName(refPath string) string {
 // Remote components (document.json#/Foo) are supported if they present in --import-mapping
 // URL components (http://deepmap.com/schemas/document.json#/Foo) are supported if they present in --import-mapping
 // Remote and URL also support standard local paths even though the spec doesn't mention them.
+//
+// Types of the spec's own components are qualified with the package of models
+// of the models-package option, if it's set.
 func RefPathToGoType(refPath string) (string, error) {
-	return refPathToGoType(refPath, true)
+	goType, err := refPathToGoType(refPath, true)
+	if err != nil {
+		return "", err
+	}
+	return qualifyModelType(goType), nil
 }
 
 // refPathToGoType returns the Go typename for refPath given its
@@ -332,13 +506,16 @@ func refPathToGoType(refPath string, local bool) (string, error) {
 		}
 
 		// Schemas may have been renamed locally, so look up the actual name in
-		// the spec.
-		name, err := findSchemaNameByRefPath(refPath, globalState.spec)
-		if err != nil {
-			return "", fmt.Errorf("error finding ref: %s in spec: %v", refPath, err)
-		}
-		if name != "" {
-			return name, nil
+		// the spec. The components of external documents aren't in it, so a
+		// local component of the same name mustn't be mistaken for them.
+		if local {
+			name, err := findSchemaNameByRefPath(refPath, globalState.spec)
+			if err != nil {
+				return "", fmt.Errorf("error finding ref: %s in spec: %v", refPath, err)
+			}
+			if name != "" {
+				return name, nil
+			}
 		}
 		// lastPart now stores the final element of the type path. This is what
 		// we use as the base for a type name.
@@ -350,7 +527,7 @@ func refPathToGoType(refPath string, local bool) (string, error) {
 		return "", fmt.Errorf("unsupported reference: %s", refPath)
 	}
 	remoteComponent, flatComponent := pathParts[0], pathParts[1]
-	if goImport, ok := importMapping[remoteComponent]; !ok {
+	if goImport, ok := importMapping.lookup(remoteComponent); !ok {
 		return "", fmt.Errorf("unrecognized external reference '%s'; please provide the known import for this reference using option --import-mapping", remoteComponent)
 	} else {
 		goType, err := refPathToGoType("#"+flatComponent, false)
@@ -361,6 +538,31 @@ func refPathToGoType(refPath string, local bool) (string, error) {
 	}
 }
 
+// modelsQualifier returns the qualifier, like "models.", of the types defined
+// in the package of models of the models-package option, or an empty string
+// if the types are in the generated package.
+func modelsQualifier() string {
+	if globalState.options.OutputOptions.ModelsPackage == "" {
+		return ""
+	}
+	name, err := packageName(globalState.options.OutputOptions.ModelsPackage)
+	if err != nil {
+		// generate checks it first
+		panic(err)
+	}
+	return name + "."
+}
+
+// qualifyModelType returns the name of the type typeName, which refers to one
+// of the generated types, or of an external package, qualified with the
+// package of models of the models-package option if it's one of the former.
+func qualifyModelType(typeName string) string {
+	if strings.Contains(typeName, ".") {
+		return typeName
+	}
+	return modelsQualifier() + typeName
+}
+
 // IsGoTypeReference takes a $ref value and checks if it has link to go type.
 // #/components/schemas/Foo                     -> true
 // ./local/file.yml#/components/parameters/Bar  -> true
@@ -424,8 +626,36 @@ func SwaggerUriToFiberUri(uri string) string {
 //	{;param*}
 //	{?param}
 //	{?param*}
+//
+// A parameter with a resource name pattern, like {name=shelves/*/books/*},
+// becomes a parameter for each of its wildcards, like
+// shelves/{name_1}/books/{name_3}, with ** becoming chi's catch all, *.
 func SwaggerUriToChiUri(uri string) string {
-	return pathParamRE.ReplaceAllString(uri, "{$1}")
+	return pathParamRE.ReplaceAllStringFunc(uri, func(param string) string {
+		match := pathParamRE.FindStringSubmatch(param)
+		name, pattern := match[1], match[2]
+		if pattern == "" {
+			return "{" + name + "}"
+		}
+		segments := strings.Split(pattern, "/")
+		for i, segment := range segments {
+			segments[i] = chiResourceSegment(name, i, segment)
+		}
+		return strings.Join(segments, "/")
+	})
+}
+
+// chiResourceSegment returns the chi route segment for the i-th segment of
+// the resource name pattern of the path parameter called name.
+func chiResourceSegment(name string, i int, segment string) string {
+	switch segment {
+	case "*":
+		return fmt.Sprintf("{%s_%d}", name, i)
+	case "**":
+		return "*"
+	default:
+		return segment
+	}
 }
 
 // SwaggerUriToGinUri converts a swagger style path URI with parameters to a
@@ -456,8 +686,30 @@ func SwaggerUriToGinUri(uri string) string {
 //	{;param*}
 //	{?param}
 //	{?param*}
+//
+// A parameter with a resource name pattern, like {name=shelves/*/books/*},
+// becomes a parameter matching a regular expression, like
+// {name:shelves/[^/]+/books/[^/]+}.
 func SwaggerUriToGorillaUri(uri string) string {
-	return pathParamRE.ReplaceAllString(uri, "{$1}")
+	return pathParamRE.ReplaceAllStringFunc(uri, func(param string) string {
+		match := pathParamRE.FindStringSubmatch(param)
+		name, pattern := match[1], match[2]
+		if pattern == "" {
+			return "{" + name + "}"
+		}
+		segments := strings.Split(pattern, "/")
+		for i, segment := range segments {
+			switch segment {
+			case "*":
+				segments[i] = "[^/]+"
+			case "**":
+				segments[i] = ".+"
+			default:
+				segments[i] = regexp.QuoteMeta(segment)
+			}
+		}
+		return "{" + name + ":" + strings.Join(segments, "/") + "}"
+	})
 }
 
 // OrderedParamsFromUri returns the argument names, in order, in a given URI string, so for
@@ -471,6 +723,20 @@ func OrderedParamsFromUri(uri string) []string {
 	return result
 }
 
+// ResourcePatternsFromUri returns the resource name patterns of the
+// parameters in a given URI string which have them, keyed by parameter name,
+// so for /v1/{name=shelves/*/books/*}, it would return shelves/*/books/* for
+// name.
+func ResourcePatternsFromUri(uri string) map[string]string {
+	patterns := make(map[string]string)
+	for _, m := range pathParamRE.FindAllStringSubmatch(uri, -1) {
+		if m[2] != "" {
+			patterns[m[1]] = m[2]
+		}
+	}
+	return patterns
+}
+
 // ReplacePathParamsWithStr replaces path parameters of the form {param} with %s
 func ReplacePathParamsWithStr(uri string) string {
 	return pathParamRE.ReplaceAllString(uri, "%s")
@@ -568,6 +834,23 @@ func SanitizeGoIdentity(str string) string {
 	return str
 }
 
+// withoutIndices returns a copy of values without the elements at indices,
+// which are in increasing order. Indices beyond the end of values are ignored.
+func withoutIndices(values []string, indices []int) []string {
+	if len(indices) == 0 {
+		return values
+	}
+	result := make([]string, 0, len(values))
+	for i, value := range values {
+		if len(indices) > 0 && indices[0] == i {
+			indices = indices[1:]
+			continue
+		}
+		result = append(result, value)
+	}
+	return result
+}
+
 // SanitizeEnumNames fixes illegal chars in the enum names
 // and removes duplicates
 func SanitizeEnumNames(enumNames, enumValues []string) map[string]string {
@@ -652,7 +935,7 @@ func typeNamePrefix(name string) (prefix string) {
 // SchemaNameToTypeName converts a Schema name to a valid Go type name. It converts to camel case, and makes sure the name is
 // valid in Go
 func SchemaNameToTypeName(name string) string {
-	return typeNamePrefix(name) + ToCamelCase(name)
+	return typeNamePrefix(name) + normalizeName(name)
 }
 
 // According to the spec, additionalProperties may be true, false, or a
@@ -676,7 +959,7 @@ func SchemaHasAdditionalProperties(schema *openapi3.Schema) bool {
 // type name.
 func PathToTypeName(path []string) string {
 	for i, p := range path {
-		path[i] = ToCamelCase(p)
+		path[i] = normalizeName(p)
 	}
 	return strings.Join(path, "_")
 }
@@ -694,6 +977,102 @@ func StringWithTypeNameToGoComment(in, typeName string) string {
 	return stringToGoCommentWithPrefix(in, typeName)
 }
 
+// FieldComment renders the description of a struct field as a Go comment,
+// starting with the name of the field as Go convention has it, unless the
+// description already does. Blank lines are kept between paragraphs, and when
+// width isn't 0, the lines of each paragraph are joined and wrapped so that the
+// comment fits in that many columns. Markdown blocks which would be mangled by
+// wrapping, such as lists, headings, quotes, tables and code, are left as they
+// are.
+func FieldComment(description, fieldName string, width int) string {
+	description = strings.TrimRight(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(description), " \t\n")
+	if strings.TrimSpace(description) == "" {
+		return ""
+	}
+	if firstWord, _, _ := strings.Cut(description, " "); strings.TrimRight(firstWord, ".,:;") != fieldName {
+		description = fieldName + " " + description
+	}
+
+	var lines, paragraph []string
+	flush := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+		if width > 0 {
+			lines = append(lines, wrapWords(strings.Fields(strings.Join(paragraph, " ")), width-len("// "))...)
+		} else {
+			lines = append(lines, paragraph...)
+		}
+		paragraph = nil
+	}
+	inFence := false
+	for _, line := range strings.Split(description, "\n") {
+		trimmed := strings.TrimSpace(line)
+		fence := strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+		switch {
+		case inFence || fence:
+			flush()
+			lines = append(lines, line)
+			if fence {
+				inFence = !inFence
+			}
+		case trimmed == "" || isMarkdownBlockLine(line):
+			flush()
+			lines = append(lines, line)
+		default:
+			paragraph = append(paragraph, line)
+		}
+	}
+	flush()
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = "//"
+		} else {
+			lines[i] = "// " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markdownListItem matches the start of an item of a Markdown list.
+var markdownListItem = regexp.MustCompile(`^([-*+]|\d+[.)])\s`)
+
+// isMarkdownBlockLine returns whether line is part of a Markdown block whose
+// lines mustn't be joined with the others of its paragraph: a list item, a
+// heading, a quote, a table row or an indented code block.
+func isMarkdownBlockLine(line string) bool {
+	if strings.HasPrefix(line, "    ") || strings.HasPrefix(line, "\t") {
+		return true
+	}
+	trimmed := strings.TrimSpace(line)
+	return markdownListItem.MatchString(trimmed) ||
+		strings.HasPrefix(trimmed, "#") ||
+		strings.HasPrefix(trimmed, ">") ||
+		strings.HasPrefix(trimmed, "|")
+}
+
+// wrapWords joins words into lines of at most width characters, except for
+// words which are longer than that, which get lines of their own.
+func wrapWords(words []string, width int) []string {
+	var lines []string
+	var line strings.Builder
+	for _, word := range words {
+		if line.Len() > 0 && line.Len()+1+len(word) > width {
+			lines = append(lines, line.String())
+			line.Reset()
+		}
+		if line.Len() > 0 {
+			line.WriteByte(' ')
+		}
+		line.WriteString(word)
+	}
+	if line.Len() > 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
 func DeprecationComment(reason string) string {
 	var content = "Deprecated:" // The colon is required at the end even without reason
 	if reason != "" {
@@ -858,12 +1237,33 @@ func findSchemaNameByRefPath(refPath string, spec *openapi3.T) (string, error) {
 	return "", nil
 }
 
+// ParseGoImportExtension returns the import for the package of the type in a
+// schema's x-go-type extension. It's given by the x-go-type-import extension,
+// either as a path or as an object with a path and an optional name, or else
+// taken from x-go-type itself when it's qualified with the package path, like
+// github.com/shopspring/decimal.Decimal. It returns nil if there's nothing to
+// import.
 func ParseGoImportExtension(v *openapi3.SchemaRef) (*goImport, error) {
-	if v.Value.Extensions[extPropGoImport] == nil || v.Value.Extensions[extPropGoType] == nil {
+	if v.Value.Extensions[extPropGoType] == nil {
 		return nil, nil
 	}
 
 	goTypeImportExt := v.Value.Extensions[extPropGoImport]
+	if goTypeImportExt == nil {
+		typeName, err := extTypeName(v.Value.Extensions[extPropGoType])
+		if err != nil || !strings.Contains(typeName, "/") {
+			return nil, nil
+		}
+		gi, _, err := parseTypeMapping(typeName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q: %w", extPropGoType, err)
+		}
+		return gi, nil
+	}
+
+	if path, ok := goTypeImportExt.(string); ok {
+		return &goImport{Path: path}, nil
+	}
 
 	importI, ok := goTypeImportExt.(map[string]interface{})
 	if !ok {
@@ -18,6 +18,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStringOps(t *testing.T) {
@@ -28,6 +29,31 @@ func TestStringOps(t *testing.T) {
 	assert.Equal(t, "Number1234", ToCamelCase("number-1234"), "Number Camelcasing not working.")
 }
 
+func TestNameNormalizers(t *testing.T) {
+	assert.Equal(t, "V2user", ToCamelCase("v2user"))
+	assert.Equal(t, "V2User", ToCamelCaseWithDigits("v2user"))
+	assert.Equal(t, "V2UserProfile", ToCamelCaseWithDigits("v2.user_profile"))
+	assert.Equal(t, "Number1234", ToCamelCaseWithDigits("number-1234"))
+
+	assert.Equal(t, "UserID", ToCamelCaseWithInitialisms("user_id"))
+	assert.Equal(t, "HTTPURL", ToCamelCaseWithInitialisms("httpUrl"))
+	assert.Equal(t, "IDs", ToCamelCaseWithInitialisms("IDs"))
+	assert.Equal(t, "Identity", ToCamelCaseWithInitialisms("identity"))
+	assert.Equal(t, "APIV2Key", ToCamelCaseWithInitialisms("api-v2-key"))
+}
+
+func TestJSONNameCases(t *testing.T) {
+	assert.Equal(t, "first_name", ToSnakeCase("firstName"))
+	assert.Equal(t, "first_name", ToSnakeCase("first-name"))
+	assert.Equal(t, "http_status_code", ToSnakeCase("HTTPStatusCode"))
+	assert.Equal(t, "address_line2", ToSnakeCase("addressLine2"))
+
+	assert.Equal(t, "firstName", ToLowerCamelCase("first_name"))
+	assert.Equal(t, "firstName", ToLowerCamelCase("FirstName"))
+	assert.Equal(t, "httpStatusCode", ToLowerCamelCase("HTTP-status-code"))
+	assert.Equal(t, "", ToLowerCamelCase("_"))
+}
+
 func TestSortedSchemaKeys(t *testing.T) {
 	dict := map[string]*openapi3.SchemaRef{
 		"f": nil,
@@ -138,9 +164,21 @@ func TestRefPathToGoType(t *testing.T) {
 	importMapping = constructImportMapping(map[string]string{
 		"doc.json":                    "externalref0",
 		"http://deepmap.com/doc.json": "externalref1",
+		"./common.yaml":               "externalref2",
+		"/specs/shared/common.yaml":   "externalref3",
 	})
 	defer func() { importMapping = old }()
 
+	// A local schema renamed with x-go-name mustn't rename the external
+	// schemas of the same name
+	oldSpec := globalState.spec
+	globalState.spec = &openapi3.T{Components: &openapi3.Components{Schemas: openapi3.Schemas{
+		"Money": &openapi3.SchemaRef{Value: &openapi3.Schema{
+			Extensions: map[string]interface{}{extGoName: "Cash"},
+		}},
+	}}}
+	defer func() { globalState.spec = oldSpec }()
+
 	tests := []struct {
 		name   string
 		path   string
@@ -181,6 +219,44 @@ func TestRefPathToGoType(t *testing.T) {
 			path:   "http://deepmap.com/doc.json#/components/parameters/foo_bar",
 			goType: "externalRef1.FooBar",
 		},
+		{
+			name:   "local-renamed",
+			path:   "#/components/schemas/Money",
+			goType: "Cash",
+		},
+		{
+			name:   "relative-file",
+			path:   "./common.yaml#/components/schemas/Money",
+			goType: "externalRef2.Money",
+		},
+		{
+			name:   "relative-file-without-dot",
+			path:   "common.yaml#/components/schemas/Money",
+			goType: "externalRef2.Money",
+		},
+		{
+			name:   "relative-file-unclean",
+			path:   "./schemas/../common.yaml#/components/schemas/Money",
+			goType: "externalRef2.Money",
+		},
+		{
+			name:   "absolute-file",
+			path:   "/specs/shared/common.yaml#/components/schemas/Money",
+			goType: "externalRef3.Money",
+		},
+		{
+			name:   "absolute-file-unclean",
+			path:   "/specs/shared/./common.yaml#/components/parameters/money_amount",
+			goType: "externalRef3.MoneyAmount",
+		},
+		{
+			name: "unmapped-file",
+			path: "./other.yaml#/components/schemas/Money",
+		},
+		{
+			name: "url-unclean",
+			path: "http://deepmap.com/./doc.json#/foo",
+		},
 		{
 			name: "local-too-deep",
 			path: "#/components/parameters/foo/components/bar",
@@ -278,12 +354,31 @@ func TestSwaggerUriToGorillaUri(t *testing.T) { // TODO
 	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToGorillaUri("/path/{;arg*}/foo"))
 	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToGorillaUri("/path/{?arg}/foo"))
 	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToGorillaUri("/path/{?arg*}/foo"))
+
+	// Resource name patterns become regular expressions
+	assert.Equal(t, "/v1/{name:shelves/[^/]+/books/[^/]+}", SwaggerUriToGorillaUri("/v1/{name=shelves/*/books/*}"))
+	assert.Equal(t, "/v1/{name:shelves/[^/]+}/books", SwaggerUriToGorillaUri("/v1/{name=shelves/*}/books"))
+	assert.Equal(t, "/files/{path:v1\\.0/.+}", SwaggerUriToGorillaUri("/files/{path=v1.0/**}"))
+}
+
+func TestSwaggerUriToChiUriResourcePatterns(t *testing.T) {
+	assert.Equal(t, "/v1/shelves/{name_1}/books/{name_3}", SwaggerUriToChiUri("/v1/{name=shelves/*/books/*}"))
+	assert.Equal(t, "/v1/shelves/{name_1}/books", SwaggerUriToChiUri("/v1/{name=shelves/*}/books"))
+	assert.Equal(t, "/files/{kind}/*", SwaggerUriToChiUri("/files/{kind}/{path=**}"))
+}
+
+func TestResourcePatternsFromUri(t *testing.T) {
+	result := ResourcePatternsFromUri("/v1/{parent=shelves/*}/books/{book}")
+	assert.Equal(t, map[string]string{"parent": "shelves/*"}, result)
 }
 
 func TestOrderedParamsFromUri(t *testing.T) {
 	result := OrderedParamsFromUri("/path/{param1}/{.param2}/{;param3*}/foo")
 	assert.EqualValues(t, []string{"param1", "param2", "param3"}, result)
 
+	result = OrderedParamsFromUri("/v1/{name=shelves/*/books/*}/{.param2}")
+	assert.EqualValues(t, []string{"name", "param2"}, result)
+
 	result = OrderedParamsFromUri("/path/foo")
 	assert.EqualValues(t, []string{}, result)
 }
@@ -291,6 +386,9 @@ func TestOrderedParamsFromUri(t *testing.T) {
 func TestReplacePathParamsWithStr(t *testing.T) {
 	result := ReplacePathParamsWithStr("/path/{param1}/{.param2}/{;param3*}/foo")
 	assert.EqualValues(t, "/path/%s/%s/%s/foo", result)
+
+	result = ReplacePathParamsWithStr("/v1/{name=shelves/*/books/*}:publish")
+	assert.EqualValues(t, "/v1/%s:publish", result)
 }
 
 func TestStringToGoComment(t *testing.T) {
@@ -433,6 +531,23 @@ func TestSchemaNameToTypeName(t *testing.T) {
 	}
 }
 
+func TestReceiverName(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]string{
+		"Pet":                   "p",
+		"PetStore":              "ps",
+		"UUIDHolder":            "uh",
+		"GetPets200JSONBody":    "gpjb",
+		"ExampleSchema_Item":    "esi",
+		"Blob":                  "b",
+		"IfFound":               "iff", // if is a keyword
+		"ErrorResponseTemplate": "ert",
+	} {
+		assert.Equal(t, want, ReceiverName(in), in)
+	}
+}
+
 func TestRefPathToObjName(t *testing.T) {
 	t.Parallel()
 
@@ -446,3 +561,111 @@ func TestRefPathToObjName(t *testing.T) {
 		assert.Equal(t, want, RefPathToObjName(in))
 	}
 }
+
+func TestParseTypeMapping(t *testing.T) {
+	imprt, goType, err := parseTypeMapping("github.com/shopspring/decimal.Decimal")
+	require.NoError(t, err)
+	assert.Equal(t, &goImport{Path: "github.com/shopspring/decimal"}, imprt)
+	assert.Equal(t, "decimal.Decimal", goType)
+
+	imprt, goType, err = parseTypeMapping("gopkg.in/yaml.v3.Node")
+	require.NoError(t, err)
+	assert.Equal(t, &goImport{Name: "yamlv3", Path: "gopkg.in/yaml.v3"}, imprt)
+	assert.Equal(t, "yamlv3.Node", goType)
+
+	// Standard library packages are left to goimports
+	imprt, goType, err = parseTypeMapping("math/big.Float")
+	require.NoError(t, err)
+	assert.Nil(t, imprt)
+	assert.Equal(t, "big.Float", goType)
+
+	for _, mapping := range []string{"Decimal", "github.com/shopspring/decimal", "decimal.", "github.com/shopspring/decimal.decimal"} {
+		_, _, err = parseTypeMapping(mapping)
+		assert.Error(t, err, mapping)
+	}
+}
+
+func TestFieldComment(t *testing.T) {
+	testCases := []struct {
+		name        string
+		description string
+		width       int
+		expected    string
+	}{
+		{
+			name:        "empty",
+			description: " \n",
+		},
+		{
+			name:        "prefixed with the field name",
+			description: "maximum number of results to return",
+			expected:    "// Limit maximum number of results to return",
+		},
+		{
+			name:        "already starting with the field name",
+			description: "Limit, the maximum number of results to return.",
+			expected:    "// Limit, the maximum number of results to return.",
+		},
+		{
+			name:        "paragraphs",
+			description: "the page to return.\r\n\r\nPages start at 1.\n",
+			expected:    "// Limit the page to return.\n//\n// Pages start at 1.",
+		},
+		{
+			name:        "lines kept without a width",
+			description: "is the maximum\n  number of results",
+			expected:    "// Limit is the maximum\n//   number of results",
+		},
+		{
+			name:        "wrapped",
+			description: "is the maximum number\nof results to return, which the server may lower.\n\nDefaults to 20.",
+			width:       40,
+			expected: "// Limit is the maximum number of\n" +
+				"// results to return, which the server\n" +
+				"// may lower.\n" +
+				"//\n" +
+				"// Defaults to 20.",
+		},
+		{
+			name:        "long words",
+			description: "see https://example.com/a/very/long/link/to/the/docs",
+			width:       20,
+			expected:    "// Limit see\n// https://example.com/a/very/long/link/to/the/docs",
+		},
+		{
+			name: "markdown",
+			description: "is one of:\n" +
+				"- `10`, the default\n" +
+				"- `100`\n" +
+				"\n" +
+				"| value | meaning |\n" +
+				"| ----- | ------- |\n" +
+				"\n" +
+				"```\n" +
+				"limit=10 which is a long line of code\n" +
+				"```\n" +
+				"    indented code\n" +
+				"> a quote\n" +
+				"## Heading",
+			width: 20,
+			expected: "// Limit is one of:\n" +
+				"// - `10`, the default\n" +
+				"// - `100`\n" +
+				"//\n" +
+				"// | value | meaning |\n" +
+				"// | ----- | ------- |\n" +
+				"//\n" +
+				"// ```\n" +
+				"// limit=10 which is a long line of code\n" +
+				"// ```\n" +
+				"//     indented code\n" +
+				"// > a quote\n" +
+				"// ## Heading",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, FieldComment(tc.description, "Limit", tc.width))
+		})
+	}
+}
@@ -278,6 +278,13 @@ func bindAdditionalProperties(additionalProperties reflect.Value, parentStruct r
 }
 
 func marshalFormImpl(v reflect.Value, result url.Values, name string) {
+	// Dates, times and UUIDs would otherwise be marshaled field by field
+	if v.IsValid() && v.CanInterface() && v.Kind() != reflect.Ptr && v.Kind() != reflect.Interface {
+		if s, ok := marshalKnownTypes(v.Interface()); ok {
+			result[name] = append(result[name], s)
+			return
+		}
+	}
 	switch v.Kind() {
 	case reflect.Interface, reflect.Ptr:
 		marshalFormImpl(v.Elem(), result, name)
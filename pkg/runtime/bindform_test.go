@@ -5,8 +5,10 @@ import (
 	"mime/multipart"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -167,6 +169,34 @@ func TestMarshalForm(t *testing.T) {
 	}
 }
 
+func TestMarshalFormDates(t *testing.T) {
+	type testStruct struct {
+		Date      types.Date   `json:"date"`
+		OptDate   *types.Date  `json:"opt_date,omitempty"`
+		DateSlice []types.Date `json:"date_slice,omitempty"`
+		Time      time.Time    `json:"time"`
+		UUID      types.UUID   `json:"uuid"`
+	}
+	date := types.Date{Time: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)}
+	id := uuid.MustParse("760abe7f-03e4-4c07-b4e5-d2fcec2b2b16")
+
+	marshalled, err := MarshalForm(testStruct{
+		Date:      date,
+		OptDate:   &date,
+		DateSlice: []types.Date{date},
+		Time:      time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		UUID:      id,
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"date":          {"2023-01-02"},
+		"opt_date":      {"2023-01-02"},
+		"date_slice[0]": {"2023-01-02"},
+		"time":          {"2023-01-02T03:04:05Z"},
+		"uuid":          {"760abe7f-03e4-4c07-b4e5-d2fcec2b2b16"},
+	}, marshalled)
+}
+
 type fileData struct {
 	field    string
 	filename string
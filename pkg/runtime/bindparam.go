@@ -67,6 +67,10 @@ func BindStyledParameterWithLocation(style string, explode bool, paramName strin
 
 	// If the destination implements encoding.TextUnmarshaler we use it for binding
 	if tu, ok := dest.(encoding.TextUnmarshaler); ok {
+		value, err := trimStylePrefix(style, paramName, value)
+		if err != nil {
+			return err
+		}
 		if err := tu.UnmarshalText([]byte(value)); err != nil {
 			return fmt.Errorf("error unmarshalling '%s' text as %T: %s", value, dest, err)
 		}
@@ -91,7 +95,7 @@ func BindStyledParameterWithLocation(style string, explode bool, paramName strin
 		return bindSplitPartsToDestinationStruct(paramName, parts, explode, dest)
 	}
 
-	if t.Kind() == reflect.Slice {
+	if t.Kind() == reflect.Slice && !isByteSlice(t) {
 		// Chop up the parameter into parts based on its style
 		parts, err := splitStyledParameter(style, explode, false, paramName, value)
 		if err != nil {
@@ -102,9 +106,33 @@ func BindStyledParameterWithLocation(style string, explode bool, paramName strin
 	}
 
 	// Try to bind the remaining types as a base type.
+	value, err = trimStylePrefix(style, paramName, value)
+	if err != nil {
+		return err
+	}
 	return BindStringToObject(value, dest)
 }
 
+// trimStylePrefix removes the prefix which a single value has in the label
+// and matrix styles, such as .5 or ;id=5, whether or not it's exploded.
+func trimStylePrefix(style string, paramName string, value string) (string, error) {
+	switch style {
+	case "label":
+		if !strings.HasPrefix(value, ".") {
+			return "", fmt.Errorf("invalid format for label parameter '%s', should start with '.'", paramName)
+		}
+		return value[1:], nil
+	case "matrix":
+		prefix := ";" + paramName + "="
+		if !strings.HasPrefix(value, prefix) {
+			return "", fmt.Errorf("expected parameter '%s' to start with %s", paramName, prefix)
+		}
+		return strings.TrimPrefix(value, prefix), nil
+	default:
+		return value, nil
+	}
+}
+
 // This is a complex set of operations, but each given parameter style can be
 // packed together in multiple ways, using different styles of separators, and
 // different packing strategies based on the explode flag. This function takes
@@ -289,7 +317,12 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 	// inner code will bind the string's value to this interface.
 	var output interface{}
 
-	if required {
+	// Optional parameters are pointers, apart from those whose type can
+	// already be nil, such as json.RawMessage, which are set like required
+	// ones.
+	indirect := !required && v.Kind() == reflect.Ptr
+
+	if !indirect {
 		// If the parameter is required, then the generated code will pass us
 		// a pointer to it: &int, &object, and so forth. We can directly set
 		// them.
@@ -320,6 +353,10 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 	// This is the basic type of the destination object.
 	t := v.Type()
 	k := t.Kind()
+	if isByteSlice(t) {
+		// Bytes are a single base64 encoded value, rather than an array
+		k = reflect.String
+	}
 
 	switch style {
 	case "form":
@@ -387,7 +424,7 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 			}
 			// If the parameter is required, and we've successfully unmarshaled
 			// it, this assigns the new object to the pointer pointer.
-			if !required {
+			if indirect {
 				dv.Set(reflect.ValueOf(output))
 			}
 			return nil
@@ -427,7 +464,7 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 		if err != nil {
 			return err
 		}
-		if !required {
+		if indirect {
 			dv.Set(reflect.ValueOf(output))
 		}
 		return nil
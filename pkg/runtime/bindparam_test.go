@@ -498,3 +498,105 @@ func TestBindStyledParameterWithLocation(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, *expectedBig, dstBigNumber)
 }
+
+func TestLabelAndMatrixPathParamsRoundTrip(t *testing.T) {
+	type object struct {
+		Role      string `json:"role"`
+		FirstName string `json:"firstName"`
+	}
+	date := types.Date{Time: time.Date(1996, time.March, 19, 0, 0, 0, 0, time.UTC)}
+	timestamp := time.Date(1996, time.March, 19, 12, 34, 56, 0, time.UTC)
+
+	for _, style := range []string{"label", "matrix"} {
+		for _, explode := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s explode=%v", style, explode), func(t *testing.T) {
+				roundTrip := func(t *testing.T, value interface{}, dest interface{}) {
+					styled, err := StyleParamWithLocation(style, explode, "id", ParamLocationPath, value)
+					require.NoError(t, err)
+					require.NoError(t, BindStyledParameterWithLocation(style, explode, "id", ParamLocationPath, styled, dest))
+				}
+
+				var primitive int
+				roundTrip(t, 5, &primitive)
+				assert.Equal(t, 5, primitive)
+
+				var str string
+				roundTrip(t, "a b", &str)
+				assert.Equal(t, "a b", str)
+
+				var array []int32
+				roundTrip(t, []int32{3, 4, 5}, &array)
+				assert.Equal(t, []int32{3, 4, 5}, array)
+
+				var obj object
+				roundTrip(t, object{Role: "admin", FirstName: "Alex"}, &obj)
+				assert.Equal(t, object{Role: "admin", FirstName: "Alex"}, obj)
+
+				var data []byte
+				roundTrip(t, []byte{0xfb, 0xff, 0x01}, &data)
+				assert.Equal(t, []byte{0xfb, 0xff, 0x01}, data)
+
+				var d types.Date
+				roundTrip(t, date, &d)
+				assert.Equal(t, date, d)
+
+				var ts time.Time
+				roundTrip(t, timestamp, &ts)
+				assert.True(t, timestamp.Equal(ts))
+			})
+		}
+	}
+
+	var primitive int
+	err := BindStyledParameterWithLocation("label", false, "id", ParamLocationPath, "5", &primitive)
+	assert.EqualError(t, err, "invalid format for label parameter 'id', should start with '.'")
+	err = BindStyledParameterWithLocation("matrix", false, "id", ParamLocationPath, ";other=5", &primitive)
+	assert.EqualError(t, err, "expected parameter 'id' to start with ;id=")
+}
+
+func TestByteParameters(t *testing.T) {
+	data := []byte{0xfb, 0xff, 0x01}
+
+	styled, err := StyleParamWithLocation("form", true, "data", ParamLocationQuery, data)
+	require.NoError(t, err)
+	assert.Equal(t, "data=%2B%2F8B", styled)
+
+	query, err := url.ParseQuery(styled)
+	require.NoError(t, err)
+	var required []byte
+	require.NoError(t, BindQueryParameter("form", true, true, "data", query, &required))
+	assert.Equal(t, data, required)
+
+	var optional *[]byte
+	require.NoError(t, BindQueryParameter("form", false, false, "data", query, &optional))
+	require.NotNil(t, optional)
+	assert.Equal(t, data, *optional)
+
+	// The URL safe alphabet, and missing padding, are accepted
+	var header []byte
+	require.NoError(t, BindStyledParameterWithLocation("simple", false, "data", ParamLocationHeader, "-_8B", &header))
+	assert.Equal(t, data, header)
+	require.NoError(t, BindStyledParameterWithLocation("simple", false, "data", ParamLocationHeader, "aGk", &header))
+	assert.Equal(t, []byte("hi"), header)
+
+	err = BindStyledParameterWithLocation("simple", false, "data", ParamLocationHeader, "not base64!", &header)
+	assert.Error(t, err)
+}
+
+func TestRawMessageParameters(t *testing.T) {
+	data := json.RawMessage(`{"a":1}`)
+
+	styled, err := StyleParamWithLocation("form", true, "meta", ParamLocationQuery, data)
+	require.NoError(t, err)
+	assert.Equal(t, "meta=%7B%22a%22%3A1%7D", styled)
+
+	query, err := url.ParseQuery(styled)
+	require.NoError(t, err)
+	var optional json.RawMessage
+	require.NoError(t, BindQueryParameter("form", true, false, "meta", query, &optional))
+	assert.Equal(t, data, optional)
+
+	var header json.RawMessage
+	require.NoError(t, BindStyledParameterWithLocation("simple", false, "meta", ParamLocationHeader, `{"a":1}`, &header))
+	assert.Equal(t, data, header)
+}
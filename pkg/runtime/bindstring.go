@@ -15,10 +15,13 @@ package runtime
 
 import (
 	"encoding"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/types"
@@ -91,6 +94,20 @@ func BindStringToObject(src string, dst interface{}) error {
 			}
 			v.SetFloat(val)
 		}
+	case reflect.Slice:
+		if !isByteSlice(t) {
+			err = fmt.Errorf("can not bind to destination of type: %s", t.Kind())
+			break
+		}
+		if t == rawMessageType {
+			v.SetBytes([]byte(src))
+			break
+		}
+		var val []byte
+		val, err = decodeBase64(src)
+		if err == nil {
+			v.SetBytes(val)
+		}
 	case reflect.Bool:
 		var val bool
 		val, err = strconv.ParseBool(src)
@@ -172,3 +189,23 @@ func BindStringToObject(src string, dst interface{}) error {
 	}
 	return nil
 }
+
+// rawMessageType is the type of strings with format json, whose values are
+// the JSON itself in parameters, rather than base64 encoded.
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+// isByteSlice returns true if t is a slice of bytes, such as that of a string
+// with format byte, whose value is base64 encoded in parameters.
+func isByteSlice(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
+
+// decodeBase64 decodes src, which may use either the standard or the URL safe
+// alphabet, with or without padding.
+func decodeBase64(src string) ([]byte, error) {
+	src = strings.TrimRight(src, "=")
+	if strings.ContainsAny(src, "-_") {
+		return base64.RawURLEncoding.DecodeString(src)
+	}
+	return base64.RawStdEncoding.DecodeString(src)
+}
@@ -0,0 +1,215 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// csvColumn is a column of a CSV document, holding a field of the row struct.
+type csvColumn struct {
+	name  string
+	index int
+}
+
+// csvColumns returns the columns for the fields of rowType, named by their
+// csv tags, falling back to their json tags and then their names. Fields
+// tagged "-" and unexported fields are left out.
+func csvColumns(rowType reflect.Type) []csvColumn {
+	var columns []csvColumn
+	for i := 0; i < rowType.NumField(); i++ {
+		field := rowType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("csv"), ",")
+		if name == "" {
+			name, _, _ = strings.Cut(field.Tag.Get("json"), ",")
+		}
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		columns = append(columns, csvColumn{name: name, index: i})
+	}
+	return columns
+}
+
+// csvRowType returns the struct type of the rows in v, a slice of structs or
+// a pointer to one.
+func csvRowType(v reflect.Type) (reflect.Type, error) {
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("CSV rows must be a slice of structs, not %s", v)
+	}
+	return v.Elem(), nil
+}
+
+// MarshalCSV writes rows, a slice of structs, as a CSV document with a header
+// row naming the columns, one for each field, named as by its csv or json
+// tag. Values which aren't scalars, such as nested objects, are written as
+// JSON.
+func MarshalCSV(rows interface{}) ([]byte, error) {
+	v := reflect.Indirect(reflect.ValueOf(rows))
+	rowType, err := csvRowType(v.Type())
+	if err != nil {
+		return nil, err
+	}
+	columns := csvColumns(rowType)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	record := make([]string, len(columns))
+	for i, column := range columns {
+		record[i] = column.name
+	}
+	if err := writer.Write(record); err != nil {
+		return nil, err
+	}
+	for i := 0; i < v.Len(); i++ {
+		for j, column := range columns {
+			cell, err := formatCSVCell(v.Index(i).Field(column.index))
+			if err != nil {
+				return nil, fmt.Errorf("error writing column %s of row %d: %w", column.name, i+1, err)
+			}
+			record[j] = cell
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalCSV reads a CSV document, whose first row names its columns, into
+// rows, a pointer to a slice of structs, setting the field of each row whose
+// csv or json tag matches each column. Columns without a matching field are
+// ignored, and empty cells leave their fields unset.
+func UnmarshalCSV(data []byte, rows interface{}) error {
+	v := reflect.ValueOf(rows)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return errors.New("CSV rows must be unmarshaled into a pointer to a slice")
+	}
+	rowType, err := csvRowType(v.Type())
+	if err != nil {
+		return err
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return fmt.Errorf("error reading CSV: %w", err)
+	}
+	if len(records) == 0 {
+		v.Elem().Set(reflect.MakeSlice(v.Elem().Type(), 0, 0))
+		return nil
+	}
+
+	fields := map[string]int{}
+	for _, column := range csvColumns(rowType) {
+		fields[column.name] = column.index
+	}
+	header := records[0]
+
+	slice := reflect.MakeSlice(v.Elem().Type(), 0, len(records)-1)
+	for i, record := range records[1:] {
+		row := reflect.New(rowType).Elem()
+		for j, cell := range record {
+			index, found := fields[header[j]]
+			if !found || cell == "" {
+				continue
+			}
+			if err := parseCSVCell(cell, row.Field(index)); err != nil {
+				return fmt.Errorf("error reading column %s of row %d: %w", header[j], i+1, err)
+			}
+		}
+		slice = reflect.Append(slice, row)
+	}
+	v.Elem().Set(slice)
+	return nil
+}
+
+func formatCSVCell(v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		if v.IsNil() {
+			return "", nil
+		}
+	}
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if marshaler, ok := v.Interface().(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), nil
+	default:
+		out, err := json.Marshal(v.Interface())
+		return string(out), err
+	}
+}
+
+func parseCSVCell(cell string, v reflect.Value) error {
+	if v.Kind() == reflect.Pointer {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	if unmarshaler, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(cell))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(cell)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(cell)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(cell, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(cell, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(cell, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return json.Unmarshal([]byte(cell), v.Addr().Interface())
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+type csvRow struct {
+	Name    string            `json:"name"`
+	Count   int               `json:"count"`
+	Ratio   *float32          `json:"ratio,omitempty"`
+	Day     types.Date        `json:"day"`
+	At      time.Time         `json:"at" csv:"timestamp"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Ignored string            `json:"-"`
+}
+
+func TestCSV(t *testing.T) {
+	ratio := float32(0.25)
+	at := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	rows := []csvRow{
+		{Name: "plain", Count: 1, Ratio: &ratio, Day: types.Date{Time: at.Truncate(24 * time.Hour)}, At: at},
+		{Name: "with, comma and \"quotes\"", Count: -2, Labels: map[string]string{"a": "b"}, Ignored: "x"},
+	}
+
+	data, err := MarshalCSV(rows)
+	require.NoError(t, err)
+	assert.Equal(t, "name,count,ratio,day,timestamp,labels\n"+
+		"plain,1,0.25,2024-05-06,2024-05-06T07:08:09Z,\n"+
+		"\"with, comma and \"\"quotes\"\"\",-2,,0001-01-01,0001-01-01T00:00:00Z,\"{\"\"a\"\":\"\"b\"\"}\"\n", string(data))
+
+	var parsed []csvRow
+	require.NoError(t, UnmarshalCSV(data, &parsed))
+	rows[1].Ignored = ""
+	assert.Equal(t, rows, parsed)
+
+	// Columns may come in any order, and unknown ones are ignored
+	require.NoError(t, UnmarshalCSV([]byte("extra,count,name\nx,3,reordered\n"), &parsed))
+	assert.Equal(t, []csvRow{{Name: "reordered", Count: 3}}, parsed)
+
+	err = UnmarshalCSV([]byte("count\nmany\n"), &parsed)
+	assert.EqualError(t, err, `error reading column count of row 1: strconv.ParseInt: parsing "many": invalid syntax`)
+
+	_, err = MarshalCSV([]string{"a"})
+	assert.EqualError(t, err, "CSV rows must be a slice of structs, not []string")
+}
@@ -0,0 +1,206 @@
+package runtime
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ServerSentEvent is a single event in a text/event-stream response, as
+// described by https://html.spec.whatwg.org/multipage/server-sent-events.html
+type ServerSentEvent struct {
+	// ID is the event's id field, which the client reports back in the
+	// Last-Event-ID header when reconnecting.
+	ID string
+	// Event is the event's type. An empty type means "message".
+	Event string
+	// Data is the event's payload. Multi-line data is sent as several data
+	// fields and joined with newlines when read.
+	Data string
+	// Retry is the reconnection time in milliseconds, if non-zero.
+	Retry int
+}
+
+// UnmarshalData decodes the event's data as JSON into v.
+func (e ServerSentEvent) UnmarshalData(v interface{}) error {
+	return json.Unmarshal([]byte(e.Data), v)
+}
+
+// EventStreamWriter writes server-sent events to an HTTP response, flushing
+// each one to the client as soon as it's written.
+type EventStreamWriter struct {
+	w       io.Writer
+	flusher http.Flusher
+}
+
+// NewEventStreamWriter returns an EventStreamWriter for w, whose headers must
+// already have been written.
+func NewEventStreamWriter(w http.ResponseWriter) *EventStreamWriter {
+	flusher, _ := w.(http.Flusher)
+	writer := &EventStreamWriter{w: w, flusher: flusher}
+	writer.flush()
+	return writer
+}
+
+// Send writes an event and flushes it to the client. It returns an error once
+// the client has gone away, after which the stream should be abandoned. The
+// event's ID and type can't contain line breaks, which would end their fields
+// and start others, so such events are rejected without writing anything.
+func (w *EventStreamWriter) Send(event ServerSentEvent) error {
+	if strings.ContainsAny(event.ID, "\r\n") {
+		return fmt.Errorf("the id %q of a server-sent event can't contain line breaks", event.ID)
+	}
+	if strings.ContainsAny(event.Event, "\r\n") {
+		return fmt.Errorf("the type %q of a server-sent event can't contain line breaks", event.Event)
+	}
+
+	var b strings.Builder
+	if event.ID != "" {
+		writeEventField(&b, "id", event.ID)
+	}
+	if event.Event != "" {
+		writeEventField(&b, "event", event.Event)
+	}
+	if event.Retry != 0 {
+		writeEventField(&b, "retry", strconv.Itoa(event.Retry))
+	}
+	for _, line := range strings.Split(normalizeLineBreaks(event.Data), "\n") {
+		writeEventField(&b, "data", line)
+	}
+	b.WriteString("\n")
+
+	if _, err := io.WriteString(w.w, b.String()); err != nil {
+		return err
+	}
+	w.flush()
+	return nil
+}
+
+// SendJSON sends an event of the given type, whose data is v encoded as JSON.
+func (w *EventStreamWriter) SendJSON(eventType string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error marshaling event data: %w", err)
+	}
+	return w.Send(ServerSentEvent{Event: eventType, Data: string(data)})
+}
+
+// Comment writes a comment, which clients ignore. It's useful for keeping
+// idle connections open.
+func (w *EventStreamWriter) Comment(text string) error {
+	if _, err := io.WriteString(w.w, ": "+strings.ReplaceAll(normalizeLineBreaks(text), "\n", " ")+"\n\n"); err != nil {
+		return err
+	}
+	w.flush()
+	return nil
+}
+
+func (w *EventStreamWriter) flush() {
+	if w.flusher != nil {
+		w.flusher.Flush()
+	}
+}
+
+func writeEventField(b *strings.Builder, name, value string) {
+	b.WriteString(name)
+	b.WriteString(": ")
+	b.WriteString(value)
+	b.WriteString("\n")
+}
+
+// normalizeLineBreaks turns the CRLF and lone CR line breaks of s, which end
+// lines of an event stream as LF does, into LF.
+func normalizeLineBreaks(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\r", "\n")
+}
+
+// EventStreamReader reads server-sent events from a text/event-stream body.
+type EventStreamReader struct {
+	body   io.ReadCloser
+	reader *bufio.Reader
+}
+
+// NewEventStreamReader returns an EventStreamReader which reads events from
+// body. Closing the reader closes body.
+func NewEventStreamReader(body io.ReadCloser) *EventStreamReader {
+	return &EventStreamReader{body: body, reader: bufio.NewReader(body)}
+}
+
+// NewEventStreamResponseReader returns an EventStreamReader for the body of a
+// successful text/event-stream response. Otherwise, it closes the body and
+// returns an error.
+func NewEventStreamResponseReader(rsp *http.Response) (*EventStreamReader, error) {
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		_ = rsp.Body.Close()
+		return nil, fmt.Errorf("unexpected response status %s", rsp.Status)
+	}
+	mediaType, _, err := mime.ParseMediaType(rsp.Header.Get("Content-Type"))
+	if err != nil || mediaType != "text/event-stream" {
+		_ = rsp.Body.Close()
+		return nil, fmt.Errorf("unexpected response content type %q", rsp.Header.Get("Content-Type"))
+	}
+	return NewEventStreamReader(rsp.Body), nil
+}
+
+// Next returns the next event in the stream. It returns io.EOF when the
+// stream ends cleanly, and io.ErrUnexpectedEOF if it ends part way through an
+// event, for instance because the server went away. If the request's context
+// is canceled, Next returns the resulting error from reading the body.
+func (r *EventStreamReader) Next() (ServerSentEvent, error) {
+	var event ServerSentEvent
+	var data []string
+	pending := false
+	for {
+		line, err := r.reader.ReadString('\n')
+		if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+			if errors.Is(err, io.EOF) && pending {
+				return ServerSentEvent{}, io.ErrUnexpectedEOF
+			}
+			return ServerSentEvent{}, err
+		}
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+
+		if line == "" {
+			if len(data) == 0 {
+				// Events without data aren't dispatched
+				event = ServerSentEvent{}
+				pending = false
+				continue
+			}
+			event.Data = strings.Join(data, "\n")
+			return event, nil
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		pending = true
+		name, value := line, ""
+		if i := strings.Index(line, ":"); i >= 0 {
+			name, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+		switch name {
+		case "id":
+			event.ID = value
+		case "event":
+			event.Event = value
+		case "data":
+			data = append(data, value)
+		case "retry":
+			if retry, err := strconv.Atoi(value); err == nil {
+				event.Retry = retry
+			}
+		}
+	}
+}
+
+// Close closes the underlying body, ending the stream.
+func (r *EventStreamReader) Close() error {
+	return r.body.Close()
+}
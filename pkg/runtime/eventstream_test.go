@@ -0,0 +1,149 @@
+package runtime
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventStreamWriter(t *testing.T) {
+	rr := httptest.NewRecorder()
+	writer := NewEventStreamWriter(rr)
+	assert.True(t, rr.Flushed)
+
+	require.NoError(t, writer.Send(ServerSentEvent{ID: "1", Event: "update", Data: "line 1\nline 2", Retry: 500}))
+	require.NoError(t, writer.SendJSON("object", map[string]int{"count": 2}))
+	require.NoError(t, writer.Comment("keepalive"))
+
+	expected := "id: 1\nevent: update\nretry: 500\ndata: line 1\ndata: line 2\n\n" +
+		"event: object\ndata: {\"count\":2}\n\n" +
+		": keepalive\n\n"
+	assert.Equal(t, expected, rr.Body.String())
+}
+
+func TestEventStreamWriterLineBreaks(t *testing.T) {
+	rr := httptest.NewRecorder()
+	writer := NewEventStreamWriter(rr)
+
+	// Line breaks in the id or type would inject fields of their own
+	err := writer.Send(ServerSentEvent{ID: "1\ndata: injected", Data: "x"})
+	assert.EqualError(t, err, `the id "1\ndata: injected" of a server-sent event can't contain line breaks`)
+	err = writer.Send(ServerSentEvent{Event: "update\rretry: 1", Data: "x"})
+	assert.EqualError(t, err, `the type "update\rretry: 1" of a server-sent event can't contain line breaks`)
+	assert.Empty(t, rr.Body.String())
+
+	// Any line break in the data or a comment is a line break
+	require.NoError(t, writer.Send(ServerSentEvent{Data: "line 1\r\nline 2\rline 3"}))
+	require.NoError(t, writer.Comment("keep\ralive"))
+	assert.Equal(t, "data: line 1\ndata: line 2\ndata: line 3\n\n: keep alive\n\n", rr.Body.String())
+}
+
+func TestEventStreamReader(t *testing.T) {
+	t.Run("parses events", func(t *testing.T) {
+		stream := ": comment\n\n" +
+			"id: 1\r\nevent: update\r\ndata: line 1\r\ndata:line 2\r\nretry: 500\r\n\r\n" +
+			"event: ignored\n\n" +
+			"data: {\"count\":2}\n\n"
+		reader := NewEventStreamReader(io.NopCloser(strings.NewReader(stream)))
+
+		event, err := reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, ServerSentEvent{ID: "1", Event: "update", Data: "line 1\nline 2", Retry: 500}, event)
+
+		event, err = reader.Next()
+		require.NoError(t, err)
+		var data map[string]int
+		require.NoError(t, event.UnmarshalData(&data))
+		assert.Equal(t, map[string]int{"count": 2}, data)
+
+		_, err = reader.Next()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("reports truncated events", func(t *testing.T) {
+		reader := NewEventStreamReader(io.NopCloser(strings.NewReader("data: complete\n\ndata: incomplete\n")))
+
+		event, err := reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, "complete", event.Data)
+
+		_, err = reader.Next()
+		assert.Equal(t, io.ErrUnexpectedEOF, err)
+	})
+
+	t.Run("rejects other responses", func(t *testing.T) {
+		_, err := NewEventStreamResponseReader(&http.Response{
+			Status:     "404 Not Found",
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader("")),
+		})
+		assert.EqualError(t, err, "unexpected response status 404 Not Found")
+
+		_, err = NewEventStreamResponseReader(&http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader("{}")),
+		})
+		assert.EqualError(t, err, `unexpected response content type "application/json"`)
+	})
+}
+
+// TestEventStreamClientDisconnect checks that a server streaming events finds
+// out when the client goes away part way through the stream.
+func TestEventStreamClientDisconnect(t *testing.T) {
+	handlerDone := make(chan error, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		writer := NewEventStreamWriter(w)
+		for {
+			if err := writer.Send(ServerSentEvent{Data: "tick"}); err != nil {
+				handlerDone <- err
+				return
+			}
+			select {
+			case <-r.Context().Done():
+				handlerDone <- r.Context().Err()
+				return
+			case <-time.After(time.Millisecond):
+			}
+		}
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	rsp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	reader, err := NewEventStreamResponseReader(rsp)
+	require.NoError(t, err)
+
+	event, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "tick", event.Data)
+
+	// Disconnect mid-stream. Further reads fail rather than hang, and the
+	// server stops streaming.
+	cancel()
+	_, err = reader.Next()
+	for err == nil {
+		_, err = reader.Next()
+	}
+	assert.ErrorIs(t, err, context.Canceled)
+	require.NoError(t, reader.Close())
+
+	select {
+	case err := <-handlerDone:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("server kept streaming after the client disconnected")
+	}
+}
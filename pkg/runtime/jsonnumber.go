@@ -0,0 +1,23 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// DecodeJSONWithNumbers decodes the JSON in r into v, like json.Decoder, but
+// with UseNumber set, so that numbers decoded into interface{} values, such
+// as additional properties, are json.Number rather than float64, keeping
+// their precision.
+func DecodeJSONWithNumbers(r io.Reader, v interface{}) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// UnmarshalJSONWithNumbers is like json.Unmarshal, but decodes numbers into
+// interface{} values as json.Number, as DecodeJSONWithNumbers does.
+func UnmarshalJSONWithNumbers(data []byte, v interface{}) error {
+	return DecodeJSONWithNumbers(bytes.NewReader(data), v)
+}
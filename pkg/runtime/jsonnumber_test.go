@@ -0,0 +1,22 @@
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalJSONWithNumbers(t *testing.T) {
+	var v struct {
+		Amount json.Number            `json:"amount"`
+		Extra  map[string]interface{} `json:"extra"`
+	}
+	data := []byte(`{"amount": 12345678901234567890.5, "extra": {"count": 98765432109876543210}}`)
+	require.NoError(t, UnmarshalJSONWithNumbers(data, &v))
+	assert.Equal(t, json.Number("12345678901234567890.5"), v.Amount)
+	assert.Equal(t, json.Number("98765432109876543210"), v.Extra["count"])
+
+	assert.Error(t, UnmarshalJSONWithNumbers([]byte(`{"amount": "x"}`), &v))
+}
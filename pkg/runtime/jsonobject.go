@@ -0,0 +1,78 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// JSONObject builds a JSON object whose fields are written in the order in
+// which they were first set, so that generated MarshalJSON methods, which
+// combine fixed properties with additional ones, give the same output every
+// time.
+type JSONObject struct {
+	keys   []string
+	fields map[string]json.RawMessage
+}
+
+// Set sets the field named key to the JSON of value. A field which is set
+// again keeps its position, but takes the new value.
+func (o *JSONObject) Set(key string, value interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if o.fields == nil {
+		o.fields = make(map[string]json.RawMessage)
+	}
+	if _, found := o.fields[key]; !found {
+		o.keys = append(o.keys, key)
+	}
+	o.fields[key] = raw
+	return nil
+}
+
+// SetFields sets the fields of the JSON object in data, in the sorted order
+// of their names.
+func (o *JSONObject) SetFields(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for _, key := range SortedKeys(fields) {
+		if err := o.Set(key, fields[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalJSON writes the object, with its fields in order.
+func (o JSONObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(o.fields[key])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// SortedKeys returns the keys of m in sorted order.
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
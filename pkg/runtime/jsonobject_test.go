@@ -0,0 +1,27 @@
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONObject(t *testing.T) {
+	var object JSONObject
+	require.NoError(t, object.SetFields([]byte(`{"b": 2, "a": "<1>"}`)))
+	require.NoError(t, object.Set("z", []int{1, 2}))
+	require.NoError(t, object.Set("a", nil))
+
+	data, err := json.Marshal(object)
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":null,"b":2,"z":[1,2]}`, string(data))
+
+	data, err = json.Marshal(JSONObject{})
+	require.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+
+	assert.Error(t, object.Set("bad", make(chan int)))
+	assert.Error(t, object.SetFields([]byte(`[]`)))
+}
@@ -0,0 +1,97 @@
+package runtime
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NegotiateContentType returns the content type, out of offered, which the
+// Accept header accept prefers. Media ranges like text/* and */* match the
+// content types they cover, the most specific range matching a content type
+// gives its quality, and content types with the same quality are preferred in
+// the order they're offered. It returns the first offered content type if
+// accept is empty or doesn't accept any of them.
+func NegotiateContentType(accept string, offered []string) string {
+	if len(offered) == 0 {
+		return ""
+	}
+	ranges := parseAccept(accept)
+	if len(ranges) == 0 {
+		return offered[0]
+	}
+
+	best, bestQuality := offered[0], 0.0
+	for _, contentType := range offered {
+		mediaType := mediaTypeOf(contentType)
+		quality, specificity := 0.0, -1
+		for _, r := range ranges {
+			if s := r.matches(mediaType); s > specificity {
+				quality, specificity = r.quality, s
+			}
+		}
+		if quality > bestQuality {
+			best, bestQuality = contentType, quality
+		}
+	}
+	return best
+}
+
+// MatchesContentType returns true if the media type of the Content-Type header
+// contentTypeHeader is contentType's, ignoring their parameters, such as
+// charset, and case. contentType may be a media range like text/* or */*,
+// which matches the media types it covers.
+func MatchesContentType(contentTypeHeader, contentType string) bool {
+	mediaType := mediaTypeOf(contentTypeHeader)
+	return mediaType != "" && acceptRange{mediaType: mediaTypeOf(contentType)}.matches(mediaType) >= 0
+}
+
+// mediaTypeOf returns the media type of contentType, without its parameters,
+// in lower case.
+func mediaTypeOf(contentType string) string {
+	return strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+}
+
+// acceptRange is a media range in an Accept header, with its quality.
+type acceptRange struct {
+	mediaType string
+	quality   float64
+}
+
+// matches returns how specifically the range matches mediaType: 2 for the
+// same media type, 1 for a subtype wildcard like text/*, 0 for */*, or -1 if
+// it doesn't match.
+func (r acceptRange) matches(mediaType string) int {
+	switch {
+	case r.mediaType == mediaType:
+		return 2
+	case r.mediaType == "*/*":
+		return 0
+	case strings.HasSuffix(r.mediaType, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(r.mediaType, "*")):
+		return 1
+	default:
+		return -1
+	}
+}
+
+func parseAccept(accept string) []acceptRange {
+	var ranges []acceptRange
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(params[0]))
+		if mediaType == "" {
+			continue
+		}
+		r := acceptRange{mediaType: mediaType, quality: 1}
+		for _, param := range params[1:] {
+			name, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found || strings.ToLower(strings.TrimSpace(name)) != "q" {
+				continue
+			}
+			if q, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+				r.quality = q
+			}
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
@@ -0,0 +1,48 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateContentType(t *testing.T) {
+	offered := []string{"application/json", "application/xml", "application/yaml"}
+
+	tests := []struct {
+		accept   string
+		expected string
+	}{
+		{"", "application/json"},
+		{"application/xml", "application/xml"},
+		{"Application/XML; charset=utf-8", "application/xml"},
+		{"text/html, application/yaml;q=0.5", "application/yaml"},
+		{"application/json;q=0.5, application/xml", "application/xml"},
+		{"application/*;q=0.8, application/yaml", "application/yaml"},
+		{"*/*", "application/json"},
+		{"*/*;q=0.1, application/xml;q=0.2", "application/xml"},
+		// A more specific range wins, even with a lower quality
+		{"application/*, application/json;q=0", "application/xml"},
+		// Nothing acceptable falls back to the first content type
+		{"text/html", "application/json"},
+		{"application/xml;q=0", "application/json"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, NegotiateContentType(test.accept, offered), "Accept: %s", test.accept)
+	}
+
+	assert.Equal(t, "", NegotiateContentType("application/json", nil))
+}
+
+func TestMatchesContentType(t *testing.T) {
+	assert.True(t, MatchesContentType("application/json", "application/json"))
+	assert.True(t, MatchesContentType("Application/JSON; charset=utf-8", "application/json"))
+	assert.False(t, MatchesContentType("application/vnd.pets.v2+json", "application/json"))
+	assert.False(t, MatchesContentType("", "application/json"))
+
+	// Media ranges match the media types they cover
+	assert.True(t, MatchesContentType("text/csv; charset=utf-8", "text/*"))
+	assert.True(t, MatchesContentType("image/png", "*/*"))
+	assert.False(t, MatchesContentType("application/json", "text/*"))
+	assert.False(t, MatchesContentType("", "*/*"))
+}
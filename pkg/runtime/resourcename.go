@@ -0,0 +1,42 @@
+package runtime
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ResourceName checks that value, the value of the path parameter paramName,
+// matches pattern, a resource name pattern like shelves/*/books/*, in which *
+// stands for a single path segment and a final ** for any number of them, at
+// least one. It returns value with each of its segments escaped, ready to be
+// put in a path.
+func ResourceName(paramName, pattern, value string) (string, error) {
+	patternSegments := strings.Split(pattern, "/")
+	segments := strings.Split(value, "/")
+
+	matches := len(segments) == len(patternSegments) ||
+		(patternSegments[len(patternSegments)-1] == "**" && len(segments) >= len(patternSegments))
+	for i, patternSegment := range patternSegments {
+		if !matches {
+			break
+		}
+		switch patternSegment {
+		case "*", "**":
+			matches = segments[i] != ""
+		default:
+			matches = segments[i] == patternSegment
+		}
+	}
+	if !matches {
+		return "", fmt.Errorf("path parameter %s, %q, doesn't match the resource name pattern %s", paramName, value, pattern)
+	}
+
+	for i, segment := range segments {
+		if segment == "" {
+			return "", fmt.Errorf("path parameter %s, %q, has an empty segment", paramName, value)
+		}
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/"), nil
+}
@@ -0,0 +1,33 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceName(t *testing.T) {
+	name, err := ResourceName("name", "shelves/*/books/*", "shelves/1/books/war and peace")
+	require.NoError(t, err)
+	assert.Equal(t, "shelves/1/books/war%20and%20peace", name)
+
+	_, err = ResourceName("name", "shelves/*/books/*", "shelves/1")
+	assert.EqualError(t, err, `path parameter name, "shelves/1", doesn't match the resource name pattern shelves/*/books/*`)
+
+	_, err = ResourceName("name", "shelves/*/books/*", "shelves/1/magazines/2")
+	assert.Error(t, err)
+
+	_, err = ResourceName("name", "shelves/*", "shelves/")
+	assert.Error(t, err)
+
+	name, err = ResourceName("path", "files/**", "files/a/b/c")
+	require.NoError(t, err)
+	assert.Equal(t, "files/a/b/c", name)
+
+	_, err = ResourceName("path", "files/**", "files/a//c")
+	assert.EqualError(t, err, `path parameter path, "files/a//c", has an empty segment`)
+
+	_, err = ResourceName("path", "files/**", "files")
+	assert.Error(t, err)
+}
@@ -0,0 +1,173 @@
+package runtime
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 100 * time.Millisecond
+	defaultRetryMaxBackoff     = 10 * time.Second
+)
+
+// defaultRetryStatusCodes are the status codes which are retried when a
+// RetryPolicy doesn't specify any.
+var defaultRetryStatusCodes = []int{
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// idempotentMethods are the methods which are retried unless
+// RetryPolicy.RetryNonIdempotent is set.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:    true,
+	http.MethodPut:    true,
+	http.MethodDelete: true,
+	http.MethodHead:   true,
+}
+
+// RequestDoer performs HTTP requests. It matches the HttpRequestDoer interface
+// of generated clients, and is implemented by http.Client.
+type RequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RetryPolicy describes when and how often RetryDoer retries requests. The
+// zero value retries idempotent requests up to twice on network errors and
+// 502, 503 and 504 responses.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times a request is sent, including
+	// the first. Defaults to 3.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, which doubles with
+	// each subsequent retry. Defaults to 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries, except when the server asks
+	// for a longer one with a Retry-After header. Defaults to 10s.
+	MaxBackoff time.Duration
+	// RetryStatusCodes are the response status codes which are retried.
+	// Defaults to 502, 503 and 504.
+	RetryStatusCodes []int
+	// RetryNonIdempotent allows retrying methods other than GET, PUT, DELETE
+	// and HEAD, such as POST.
+	RetryNonIdempotent bool
+}
+
+// RetryDoer is a RequestDoer which retries failed requests with exponential
+// backoff, according to its policy.
+type RetryDoer struct {
+	doer   RequestDoer
+	policy RetryPolicy
+}
+
+// NewRetryDoer returns a RetryDoer which sends requests with doer, filling in
+// defaults for any unset fields of policy.
+func NewRetryDoer(doer RequestDoer, policy RetryPolicy) *RetryDoer {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaultRetryMaxAttempts
+	}
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = defaultRetryInitialBackoff
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = defaultRetryMaxBackoff
+	}
+	if len(policy.RetryStatusCodes) == 0 {
+		policy.RetryStatusCodes = defaultRetryStatusCodes
+	}
+	return &RetryDoer{doer: doer, policy: policy}
+}
+
+// Do sends the request, retrying it while it fails with a network error or a
+// retryable status code and attempts remain. Requests whose body can't be
+// replayed, because http.Request.GetBody is unset, are never retried. Waiting
+// between attempts stops as soon as the request's context is done.
+func (d *RetryDoer) Do(req *http.Request) (*http.Response, error) {
+	canRetry := d.policy.RetryNonIdempotent || idempotentMethods[req.Method]
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		canRetry = false
+	}
+
+	backoff := d.policy.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		rsp, err := d.doer.Do(req)
+		if !canRetry || attempt >= d.policy.MaxAttempts || req.Context().Err() != nil {
+			return rsp, err
+		}
+		if err == nil && !d.isRetryableStatus(rsp.StatusCode) {
+			return rsp, nil
+		}
+
+		delay := backoff
+		if err == nil {
+			if retryAfter, ok := parseRetryAfter(rsp.Header.Get("Retry-After")); ok {
+				delay = retryAfter
+			}
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, rsp.Body)
+			_ = rsp.Body.Close()
+		}
+
+		if err := sleepContext(req, delay); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		backoff *= 2
+		if backoff > d.policy.MaxBackoff {
+			backoff = d.policy.MaxBackoff
+		}
+	}
+}
+
+func (d *RetryDoer) isRetryableStatus(statusCode int) bool {
+	for _, code := range d.policy.RetryStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// sleepContext waits for delay, returning early with the context's error if
+// the request's context is done first.
+func sleepContext(req *http.Request, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
@@ -0,0 +1,152 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDoer returns the given responses or errors in turn, recording the
+// bodies of the requests it receives.
+type fakeDoer struct {
+	results []interface{}
+	bodies  []string
+}
+
+func (f *fakeDoer) Do(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		b, _ := io.ReadAll(req.Body)
+		body = string(b)
+	}
+	f.bodies = append(f.bodies, body)
+
+	result := f.results[0]
+	f.results = f.results[1:]
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	if rsp, ok := result.(*http.Response); ok {
+		return rsp, nil
+	}
+	rsp := &http.Response{
+		StatusCode: result.(int),
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	return rsp, nil
+}
+
+var fastRetries = RetryPolicy{
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     time.Millisecond,
+}
+
+func TestRetryDoer(t *testing.T) {
+	t.Run("retries retryable status codes and network errors", func(t *testing.T) {
+		doer := &fakeDoer{results: []interface{}{http.StatusBadGateway, errors.New("connection reset"), http.StatusOK}}
+		req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		require.NoError(t, err)
+
+		rsp, err := NewRetryDoer(doer, fastRetries).Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rsp.StatusCode)
+		assert.Len(t, doer.bodies, 3)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		doer := &fakeDoer{results: []interface{}{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}}
+		req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		require.NoError(t, err)
+
+		policy := fastRetries
+		policy.MaxAttempts = 2
+		rsp, err := NewRetryDoer(doer, policy).Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, rsp.StatusCode)
+		assert.Len(t, doer.bodies, 2)
+	})
+
+	t.Run("doesn't retry other status codes", func(t *testing.T) {
+		doer := &fakeDoer{results: []interface{}{http.StatusInternalServerError}}
+		req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		require.NoError(t, err)
+
+		rsp, err := NewRetryDoer(doer, fastRetries).Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rsp.StatusCode)
+	})
+
+	t.Run("only retries non-idempotent methods when allowed", func(t *testing.T) {
+		doer := &fakeDoer{results: []interface{}{http.StatusBadGateway}}
+		req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("body"))
+		require.NoError(t, err)
+
+		rsp, err := NewRetryDoer(doer, fastRetries).Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadGateway, rsp.StatusCode)
+
+		doer = &fakeDoer{results: []interface{}{http.StatusBadGateway, http.StatusOK}}
+		req, err = http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("body"))
+		require.NoError(t, err)
+
+		policy := fastRetries
+		policy.RetryNonIdempotent = true
+		rsp, err = NewRetryDoer(doer, policy).Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rsp.StatusCode)
+		// The body is replayed on retry
+		assert.Equal(t, []string{"body", "body"}, doer.bodies)
+	})
+
+	t.Run("honors Retry-After", func(t *testing.T) {
+		throttled := &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{"Retry-After": []string{"0"}},
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+		doer := &fakeDoer{results: []interface{}{throttled, http.StatusOK}}
+		req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		require.NoError(t, err)
+
+		// The backoff would time the test out if Retry-After were ignored
+		rsp, err := NewRetryDoer(doer, RetryPolicy{InitialBackoff: time.Hour}).Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	})
+
+	t.Run("stops waiting when the context is done", func(t *testing.T) {
+		doer := &fakeDoer{results: []interface{}{http.StatusBadGateway, http.StatusOK}}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", nil)
+		require.NoError(t, err)
+
+		_, err = NewRetryDoer(doer, RetryPolicy{InitialBackoff: time.Minute}).Do(req)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Len(t, doer.bodies, 1)
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	delay, ok := parseRetryAfter("3")
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	delay, ok = parseRetryAfter(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+}
@@ -0,0 +1,77 @@
+package runtime
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// ServerVariable describes a variable in a server URL, such as {region} in
+// https://{region}.example.com.
+type ServerVariable struct {
+	// Default is used when no value is given for the variable.
+	Default string
+	// Enum, if not empty, lists the values which the variable may take.
+	Enum []string
+}
+
+// ServerURL substitutes vars into serverURL, which may contain variables
+// like {region}, described by variables. Variables which aren't in vars take
+// their default values. It fails if vars has a variable which serverURL
+// doesn't, or a value which isn't in its variable's enum.
+func ServerURL(serverURL string, vars map[string]string, variables map[string]ServerVariable) (string, error) {
+	for name := range vars {
+		if _, found := variables[name]; !found {
+			return "", fmt.Errorf("unknown server variable %s", name)
+		}
+	}
+
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		variable := variables[name]
+		value, found := vars[name]
+		if !found {
+			value = variable.Default
+		}
+		if len(variable.Enum) > 0 && !contains(variable.Enum, value) {
+			return "", fmt.Errorf("invalid value %q for server variable %s, must be one of %s",
+				value, name, strings.Join(variable.Enum, ", "))
+		}
+		serverURL = strings.ReplaceAll(serverURL, "{"+name+"}", value)
+	}
+	return serverURL, nil
+}
+
+// ResolveServerURL resolves the URL of an operation's own server, which may
+// be relative, against the client's server. The result always has a trailing
+// slash, like the client's server, so that operation paths are appended to it.
+func ResolveServerURL(server, operationServer string) (string, error) {
+	base, err := url.Parse(server)
+	if err != nil {
+		return "", fmt.Errorf("error parsing server URL: %w", err)
+	}
+	ref, err := url.Parse(operationServer)
+	if err != nil {
+		return "", fmt.Errorf("error parsing operation server URL: %w", err)
+	}
+	resolved := base.ResolveReference(ref).String()
+	if !strings.HasSuffix(resolved, "/") {
+		resolved += "/"
+	}
+	return resolved, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerURL(t *testing.T) {
+	variables := map[string]ServerVariable{
+		"region":  {Default: "eu", Enum: []string{"eu", "us"}},
+		"version": {Default: "v1"},
+	}
+	serverURL := "https://{region}.example.com/{version}"
+
+	u, err := ServerURL(serverURL, nil, variables)
+	require.NoError(t, err)
+	assert.Equal(t, "https://eu.example.com/v1", u)
+
+	u, err = ServerURL(serverURL, map[string]string{"region": "us", "version": "v2"}, variables)
+	require.NoError(t, err)
+	assert.Equal(t, "https://us.example.com/v2", u)
+
+	_, err = ServerURL(serverURL, map[string]string{"region": "ap"}, variables)
+	assert.EqualError(t, err, `invalid value "ap" for server variable region, must be one of eu, us`)
+
+	_, err = ServerURL(serverURL, map[string]string{"zone": "a"}, variables)
+	assert.EqualError(t, err, "unknown server variable zone")
+}
+
+func TestResolveServerURL(t *testing.T) {
+	u, err := ResolveServerURL("https://api.example.com/v1/", "https://uploads.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "https://uploads.example.com/", u)
+
+	u, err = ResolveServerURL("https://api.example.com/v1/", "/v2")
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/v2/", u)
+
+	u, err = ResolveServerURL("https://api.example.com/v1/", "beta")
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/v1/beta/", u)
+}
@@ -16,6 +16,7 @@ package runtime
 import (
 	"bytes"
 	"encoding"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -86,6 +87,15 @@ func StyleParamWithLocation(style string, explode bool, paramName string, paramL
 		}
 	}
 
+	// Bytes, from strings with format byte, are base64 encoded, while JSON,
+	// from strings with format json, is sent as it is
+	if t == rawMessageType {
+		return stylePrimitive(style, explode, paramName, paramLocation, string(v.Bytes()))
+	}
+	if isByteSlice(t) {
+		return stylePrimitive(style, explode, paramName, paramLocation, base64.StdEncoding.EncodeToString(v.Bytes()))
+	}
+
 	switch t.Kind() {
 	case reflect.Slice:
 		n := v.Len()
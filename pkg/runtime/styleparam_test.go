@@ -689,4 +689,18 @@ func TestStyleParam(t *testing.T) {
 	assert.NoError(t, err)
 	assert.EqualValues(t, "972beb41-e5ea-4b31-a79a-96f4999d8769", result)
 
+	// Dates, alone or in arrays, are serialized without a time component
+	dates := []types.Date{dateVal, dateVal}
+
+	result, err = StyleParamWithLocation("form", true, "d", ParamLocationQuery, dateVal)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "d=1996-03-19", result)
+
+	result, err = StyleParamWithLocation("form", true, "d", ParamLocationQuery, dates)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "d=1996-03-19&d=1996-03-19", result)
+
+	result, err = StyleParamWithLocation("form", false, "d", ParamLocationQuery, dates)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "d=1996-03-19,1996-03-19", result)
 }
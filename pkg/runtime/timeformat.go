@@ -0,0 +1,209 @@
+package runtime
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+// ParamOptions are options for styling and binding parameters.
+type ParamOptions struct {
+	// TimeFormat is the layout, as taken by time.Format, of times in the
+	// parameter, in the arrays of them, and in the fields of objects, rather
+	// than RFC 3339.
+	TimeFormat string
+}
+
+// StyleParamWithOptions is like StyleParamWithLocation, but formats times in
+// value as options say.
+func StyleParamWithOptions(style string, explode bool, paramName string, paramLocation ParamLocation, value interface{}, options ParamOptions) (string, error) {
+	if options.TimeFormat != "" && value != nil {
+		v := reflect.ValueOf(value)
+		if shadowType, ok := timeShadowType(v.Type()); ok {
+			value = formatTimeShadow(v, shadowType, options.TimeFormat).Interface()
+		}
+	}
+	return StyleParamWithLocation(style, explode, paramName, paramLocation, value)
+}
+
+// BindStyledParameterWithOptions is like BindStyledParameterWithLocation, but
+// parses times into dest as options say.
+func BindStyledParameterWithOptions(style string, explode bool, paramName string, paramLocation ParamLocation, value string, dest interface{}, options ParamOptions) error {
+	return bindWithTimeFormat(dest, options, func(dest interface{}) error {
+		return BindStyledParameterWithLocation(style, explode, paramName, paramLocation, value, dest)
+	})
+}
+
+// BindQueryParameterWithOptions is like BindQueryParameter, but parses times
+// into dest as options say.
+func BindQueryParameterWithOptions(style string, explode bool, required bool, paramName string, queryParams url.Values, dest interface{}, options ParamOptions) error {
+	return bindWithTimeFormat(dest, options, func(dest interface{}) error {
+		return BindQueryParameter(style, explode, required, paramName, queryParams, dest)
+	})
+}
+
+// bindWithTimeFormat binds a parameter into dest, a pointer, with bind. When
+// options have a TimeFormat and dest holds times, they're bound as strings,
+// and then parsed with it.
+func bindWithTimeFormat(dest interface{}, options ParamOptions, bind func(dest interface{}) error) error {
+	v := reflect.ValueOf(dest)
+	if options.TimeFormat == "" || v.Kind() != reflect.Pointer || v.IsNil() {
+		return bind(dest)
+	}
+	shadowType, ok := timeShadowType(v.Type().Elem())
+	if !ok {
+		return bind(dest)
+	}
+	shadow := reflect.New(shadowType)
+	if err := bind(shadow.Interface()); err != nil {
+		return err
+	}
+	return parseTimeShadow(shadow.Elem(), v.Elem(), options.TimeFormat)
+}
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	binderType          = reflect.TypeOf((*Binder)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// timeShadowType returns t with the time it holds, through pointers, slices
+// and the exported fields of structs, replaced by a string, or false if it
+// doesn't hold a time. Structs which bind or unmarshal themselves are left as
+// they are, as are the fields through which a struct holds itself, whose times
+// aren't reformatted.
+func timeShadowType(t reflect.Type) (reflect.Type, bool) {
+	return timeShadowTypeVisiting(t, map[reflect.Type]bool{})
+}
+
+// timeShadowTypeVisiting is timeShadowType within the structs in visiting.
+func timeShadowTypeVisiting(t reflect.Type, visiting map[reflect.Type]bool) (reflect.Type, bool) {
+	switch t.Kind() {
+	case reflect.Pointer:
+		elem, ok := timeShadowTypeVisiting(t.Elem(), visiting)
+		if !ok {
+			return nil, false
+		}
+		return reflect.PointerTo(elem), true
+	case reflect.Slice:
+		elem, ok := timeShadowTypeVisiting(t.Elem(), visiting)
+		if !ok {
+			return nil, false
+		}
+		return reflect.SliceOf(elem), true
+	case reflect.Struct:
+		if t.ConvertibleTo(timeType) {
+			return reflect.TypeOf(""), true
+		}
+		ptr := reflect.PointerTo(t)
+		if visiting[t] || ptr.Implements(binderType) || ptr.Implements(jsonUnmarshalerType) || ptr.Implements(textUnmarshalerType) {
+			return nil, false
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		fields := make([]reflect.StructField, t.NumField())
+		var holdsTime bool
+		for i := range fields {
+			fields[i] = t.Field(i)
+			if !fields[i].IsExported() || fields[i].Anonymous {
+				return nil, false
+			}
+			if shadow, ok := timeShadowTypeVisiting(fields[i].Type, visiting); ok {
+				fields[i].Type = shadow
+				holdsTime = true
+			}
+		}
+		if !holdsTime {
+			return nil, false
+		}
+		return reflect.StructOf(fields), true
+	default:
+		return nil, false
+	}
+}
+
+// formatTimeShadow returns v as a value of shadowType, from timeShadowType,
+// formatting its times with layout.
+func formatTimeShadow(v reflect.Value, shadowType reflect.Type, layout string) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return reflect.Zero(shadowType)
+		}
+		shadow := reflect.New(shadowType.Elem())
+		shadow.Elem().Set(formatTimeShadow(v.Elem(), shadowType.Elem(), layout))
+		return shadow
+	case reflect.Slice:
+		if v.IsNil() {
+			return reflect.Zero(shadowType)
+		}
+		shadow := reflect.MakeSlice(shadowType, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			shadow.Index(i).Set(formatTimeShadow(v.Index(i), shadowType.Elem(), layout))
+		}
+		return shadow
+	default:
+		if shadowType.Kind() == reflect.Struct {
+			shadow := reflect.New(shadowType).Elem()
+			for i := 0; i < v.NumField(); i++ {
+				if field := v.Field(i); field.Type() == shadowType.Field(i).Type {
+					shadow.Field(i).Set(field)
+				} else {
+					shadow.Field(i).Set(formatTimeShadow(field, shadowType.Field(i).Type, layout))
+				}
+			}
+			return shadow
+		}
+		return reflect.ValueOf(v.Convert(timeType).Interface().(time.Time).Format(layout))
+	}
+}
+
+// parseTimeShadow sets dest from shadow, a value of the type timeShadowType
+// returns for it, parsing its times with layout.
+func parseTimeShadow(shadow, dest reflect.Value, layout string) error {
+	switch dest.Kind() {
+	case reflect.Pointer:
+		if shadow.IsNil() {
+			dest.Set(reflect.Zero(dest.Type()))
+			return nil
+		}
+		elem := reflect.New(dest.Type().Elem())
+		if err := parseTimeShadow(shadow.Elem(), elem.Elem(), layout); err != nil {
+			return err
+		}
+		dest.Set(elem)
+	case reflect.Slice:
+		if shadow.IsNil() {
+			dest.Set(reflect.Zero(dest.Type()))
+			return nil
+		}
+		slice := reflect.MakeSlice(dest.Type(), shadow.Len(), shadow.Len())
+		for i := 0; i < shadow.Len(); i++ {
+			if err := parseTimeShadow(shadow.Index(i), slice.Index(i), layout); err != nil {
+				return err
+			}
+		}
+		dest.Set(slice)
+	default:
+		if shadow.Kind() == reflect.Struct {
+			for i := 0; i < dest.NumField(); i++ {
+				if field := dest.Field(i); field.Type() == shadow.Field(i).Type() {
+					field.Set(shadow.Field(i))
+				} else if err := parseTimeShadow(shadow.Field(i), field, layout); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		parsed, err := time.Parse(layout, shadow.String())
+		if err != nil {
+			return fmt.Errorf("error parsing '%s' as a time with layout %s: %w", shadow.String(), layout, err)
+		}
+		dest.Set(reflect.ValueOf(parsed).Convert(dest.Type()))
+	}
+	return nil
+}
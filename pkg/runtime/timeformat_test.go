@@ -0,0 +1,123 @@
+package runtime
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamTimeFormat(t *testing.T) {
+	const layout = "2006-01-02 15:04:05"
+	options := ParamOptions{TimeFormat: layout}
+	at := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	later := at.Add(time.Hour)
+
+	t.Run("style", func(t *testing.T) {
+		styled, err := StyleParamWithOptions("form", true, "at", ParamLocationQuery, &at, options)
+		require.NoError(t, err)
+		assert.Equal(t, "at=2024-05-06+07%3A08%3A09", styled)
+
+		styled, err = StyleParamWithOptions("form", false, "at", ParamLocationQuery, []time.Time{at, later}, options)
+		require.NoError(t, err)
+		assert.Equal(t, "at=2024-05-06+07%3A08%3A09,2024-05-06+08%3A08%3A09", styled)
+
+		styled, err = StyleParamWithOptions("simple", false, "at", ParamLocationPath, at, options)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-05-06%2007:08:09", styled)
+
+		// Without a TimeFormat, times are RFC 3339
+		styled, err = StyleParamWithOptions("simple", false, "at", ParamLocationPath, at, ParamOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-05-06T07:08:09Z", styled)
+
+		// Other values are unaffected
+		styled, err = StyleParamWithOptions("simple", false, "n", ParamLocationPath, 5, options)
+		require.NoError(t, err)
+		assert.Equal(t, "5", styled)
+	})
+
+	t.Run("bind styled", func(t *testing.T) {
+		var dest time.Time
+		require.NoError(t, BindStyledParameterWithOptions("simple", false, "at", ParamLocationPath, "2024-05-06%2007:08:09", &dest, options))
+		assert.Equal(t, at, dest)
+
+		var times []time.Time
+		require.NoError(t, BindStyledParameterWithOptions("label", false, "at", ParamLocationPath, ".2024-05-06 07:08:09,2024-05-06 08:08:09", &times, options))
+		assert.Equal(t, []time.Time{at, later}, times)
+
+		err := BindStyledParameterWithOptions("simple", false, "at", ParamLocationPath, "2024-05-06T07:08:09Z", &dest, options)
+		assert.EqualError(t, err, `error parsing '2024-05-06T07:08:09Z' as a time with layout 2006-01-02 15:04:05: parsing time "2024-05-06T07:08:09Z" as "2006-01-02 15:04:05": cannot parse "T07:08:09Z" as " "`)
+	})
+
+	t.Run("bind query", func(t *testing.T) {
+		query := url.Values{"at": {"2024-05-06 07:08:09"}, "times": {"2024-05-06 07:08:09", "2024-05-06 08:08:09"}}
+
+		var required time.Time
+		require.NoError(t, BindQueryParameterWithOptions("form", true, true, "at", query, &required, options))
+		assert.Equal(t, at, required)
+
+		var optional *time.Time
+		require.NoError(t, BindQueryParameterWithOptions("form", true, false, "at", query, &optional, options))
+		require.NotNil(t, optional)
+		assert.Equal(t, at, *optional)
+
+		var times *[]time.Time
+		require.NoError(t, BindQueryParameterWithOptions("form", true, false, "times", query, &times, options))
+		require.NotNil(t, times)
+		assert.Equal(t, []time.Time{at, later}, *times)
+
+		var missing *time.Time
+		require.NoError(t, BindQueryParameterWithOptions("form", true, false, "missing", query, &missing, options))
+		assert.Nil(t, missing)
+	})
+
+	t.Run("objects", func(t *testing.T) {
+		type window struct {
+			From  time.Time  `json:"from"`
+			Until *time.Time `json:"until,omitempty"`
+			Name  string     `json:"name"`
+		}
+		styled, err := StyleParamWithOptions("deepObject", true, "window", ParamLocationQuery, window{From: at, Until: &later, Name: "x"}, options)
+		require.NoError(t, err)
+		assert.Equal(t, "window[from]=2024-05-06 07:08:09&window[name]=x&window[until]=2024-05-06 08:08:09", styled)
+
+		query, err := url.ParseQuery(styled)
+		require.NoError(t, err)
+		var dest window
+		require.NoError(t, BindQueryParameterWithOptions("deepObject", true, true, "window", query, &dest, options))
+		assert.Equal(t, window{From: at, Until: &later, Name: "x"}, dest)
+
+		// Objects which bind themselves are left to do so
+		_, ok := timeShadowType(reflect.TypeOf(types.Date{}))
+		assert.False(t, ok)
+	})
+
+	t.Run("self-referential objects", func(t *testing.T) {
+		type event struct {
+			At       time.Time `json:"at"`
+			Previous *event    `json:"previous,omitempty"`
+			Related  []event   `json:"related,omitempty"`
+		}
+		// The fields holding events are left as they are
+		shadowType, ok := timeShadowType(reflect.TypeOf(event{}))
+		require.True(t, ok)
+		assert.Equal(t, reflect.TypeOf(""), shadowType.Field(0).Type)
+		assert.Equal(t, reflect.TypeOf(&event{}), shadowType.Field(1).Type)
+		assert.Equal(t, reflect.TypeOf([]event{}), shadowType.Field(2).Type)
+
+		styled, err := StyleParamWithOptions("deepObject", true, "event", ParamLocationQuery, event{At: at}, options)
+		require.NoError(t, err)
+		assert.Equal(t, "event[at]=2024-05-06 07:08:09", styled)
+
+		query, err := url.ParseQuery(styled)
+		require.NoError(t, err)
+		var dest event
+		require.NoError(t, BindQueryParameterWithOptions("deepObject", true, true, "event", query, &dest, options))
+		assert.Equal(t, event{At: at}, dest)
+	})
+}
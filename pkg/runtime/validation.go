@@ -0,0 +1,65 @@
+package runtime
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+)
+
+// jsonKey holds the JSON encoding of an item in FindDuplicateItem, so that it
+// can't be mistaken for an item which is a string.
+type jsonKey string
+
+// FindDuplicateItem returns the index of the first of items which is equal to
+// an earlier one, or -1 if they're all different, for checking uniqueItems.
+// Items of basic types, such as strings and numbers, are compared directly.
+// Others, such as structs, slices and pointers, aren't necessarily comparable,
+// so they're compared by their JSON encoding.
+func FindDuplicateItem[T any](items []T) (int, error) {
+	seen := make(map[interface{}]bool, len(items))
+	for i, item := range items {
+		var key interface{} = item
+		if !isBasicType(reflect.TypeOf(key)) {
+			b, err := json.Marshal(item)
+			if err != nil {
+				return -1, err
+			}
+			key = jsonKey(b)
+		}
+		if seen[key] {
+			return i, nil
+		}
+		seen[key] = true
+	}
+	return -1, nil
+}
+
+func isBasicType(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// multipleOfEpsilon is how far the quotient of a value and a divisor can be
+// from a whole number for IsMultipleOf to still consider it one.
+const multipleOfEpsilon = 1e-9
+
+// IsMultipleOf returns true if value is a multiple of divisor, for checking
+// multipleOf. It allows for floating point rounding errors, so that 0.3 is a
+// multiple of 0.1.
+func IsMultipleOf(value, divisor float64) bool {
+	if divisor == 0 {
+		return false
+	}
+	quotient := value / divisor
+	return math.Abs(quotient-math.Round(quotient)) < multipleOfEpsilon
+}
@@ -0,0 +1,50 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindDuplicateItem(t *testing.T) {
+	i, err := FindDuplicateItem([]string{"a", "b", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, -1, i)
+
+	i, err = FindDuplicateItem([]int{1, 2, 1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, 2, i)
+
+	// Items which aren't comparable are compared by value
+	type item struct {
+		Name string
+		Tags []string
+	}
+	i, err = FindDuplicateItem([]item{{Name: "x", Tags: []string{"a"}}, {Name: "x", Tags: []string{"b"}}})
+	require.NoError(t, err)
+	assert.Equal(t, -1, i)
+
+	first, second := "same", "same"
+	i, err = FindDuplicateItem([]*string{&first, nil, &second})
+	require.NoError(t, err)
+	assert.Equal(t, 2, i)
+
+	i, err = FindDuplicateItem([]interface{}{"1", 1, map[string]interface{}{"a": 1}, map[string]interface{}{"a": 1}})
+	require.NoError(t, err)
+	assert.Equal(t, 3, i)
+}
+
+func TestIsMultipleOf(t *testing.T) {
+	assert.True(t, IsMultipleOf(10, 5))
+	assert.True(t, IsMultipleOf(-10, 5))
+	assert.True(t, IsMultipleOf(0, 5))
+	assert.False(t, IsMultipleOf(11, 5))
+
+	// Rounding errors are allowed for
+	assert.True(t, IsMultipleOf(0.3, 0.1))
+	assert.True(t, IsMultipleOf(19.99, 0.01))
+	assert.False(t, IsMultipleOf(0.35, 0.1))
+
+	assert.False(t, IsMultipleOf(1, 0))
+}
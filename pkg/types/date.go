@@ -33,6 +33,19 @@ func (d Date) String() string {
 	return d.Time.Format(DateFormat)
 }
 
+// MarshalText formats the date without a time, rather than using the
+// MarshalText method of the embedded time.Time.
+func (d Date) MarshalText() ([]byte, error) {
+	return d.AppendText(nil)
+}
+
+// AppendText appends the date without a time to b. It hides the AppendText
+// method of the embedded time.Time, which newer versions of Go prefer to
+// MarshalText.
+func (d Date) AppendText(b []byte) ([]byte, error) {
+	return d.Time.AppendFormat(b, DateFormat), nil
+}
+
 func (d *Date) UnmarshalText(data []byte) error {
 	parsed, err := time.Parse(DateFormat, string(data))
 	if err != nil {
@@ -63,3 +63,15 @@ func TestDate_UnmarshalText(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, testDate, date.Time)
 }
+
+func TestDate_MarshalText(t *testing.T) {
+	d := Date{Time: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)}
+	text, err := d.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-01-02", string(text))
+
+	// Map keys are marshaled as text
+	jsonBytes, err := json.Marshal(map[Date]int{d: 1})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"2023-01-02":1}`, string(jsonBytes))
+}
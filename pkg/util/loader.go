@@ -10,6 +10,7 @@ func LoadSwagger(filePath string) (swagger *openapi3.T, err error) {
 
 	loader := openapi3.NewLoader()
 	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = readFromURINormalized
 
 	u, err := url.Parse(filePath)
 	if err == nil && u.Scheme != "" && u.Host != "" {
@@ -18,3 +19,13 @@ func LoadSwagger(filePath string) (swagger *openapi3.T, err error) {
 		return loader.LoadFromFile(filePath)
 	}
 }
+
+// readFromURINormalized reads a spec, or a spec referenced from another, and
+// rewrites OpenAPI 3.1 type arrays into a form which kin-openapi understands.
+func readFromURINormalized(loader *openapi3.Loader, location *url.URL) ([]byte, error) {
+	data, err := openapi3.DefaultReadFromURI(loader, location)
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeTypeArrays(data)
+}
@@ -0,0 +1,361 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// MergeSwaggers loads the specs in filePaths and merges them into one, so
+// that code can be generated for all of them together. The first spec's info,
+// servers and security requirements are kept, while the paths, components
+// and tags of all of them are combined.
+//
+// References to other documents are replaced by references to components of
+// the merged spec, which the referenced components are copied into, apart
+// from those to documents in importMapping, which are left for the generated
+// code to import. Schemas which are whole documents are copied into schema
+// components named after their files, without the extension.
+//
+// The parameters, summary, description and servers of paths in several specs
+// are combined too.
+//
+// It fails if two specs define the same operation, operationId or path and
+// method, different components with the same name, or different parameters
+// or servers for the same path.
+func MergeSwaggers(filePaths []string, importMapping map[string]string) (*openapi3.T, error) {
+	var merged *openapi3.T
+	m := merger{
+		operationSources:   map[string]string{},
+		operationIDSources: map[string]string{},
+		componentSources:   map[string]string{},
+		pathSources:        map[string]string{},
+	}
+
+	for _, filePath := range filePaths {
+		swagger, err := LoadSwagger(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("error loading swagger spec in %s: %w", filePath, err)
+		}
+		if err := internalizeRefs(swagger, importMapping); err != nil {
+			return nil, fmt.Errorf("error resolving references in %s: %w", filePath, err)
+		}
+		if merged == nil {
+			merged = swagger
+			if merged.Paths == nil {
+				merged.Paths = openapi3.Paths{}
+			}
+			if merged.Components == nil {
+				merged.Components = &openapi3.Components{}
+			}
+		}
+		if err := m.merge(merged, swagger, filePath); err != nil {
+			return nil, err
+		}
+	}
+	return merged, nil
+}
+
+// merger keeps track of which spec each operation and component in the
+// merged spec came from, to report collisions.
+type merger struct {
+	operationSources   map[string]string
+	operationIDSources map[string]string
+	componentSources   map[string]string
+	pathSources        map[string]string
+}
+
+// merge adds the paths, components and tags of swagger, loaded from
+// filePath, into merged.
+func (m *merger) merge(merged, swagger *openapi3.T, filePath string) error {
+	for _, path := range sortedPaths(swagger.Paths) {
+		pathItem := swagger.Paths[path]
+		mergedItem, found := merged.Paths[path]
+		if !found {
+			mergedItem = pathItem
+			merged.Paths[path] = pathItem
+		}
+		if mergedItem == pathItem {
+			m.pathSources[path] = filePath
+		} else {
+			if err := m.mergePathItem(mergedItem, pathItem, path, filePath); err != nil {
+				return err
+			}
+		}
+		for method, op := range pathItem.Operations() {
+			key := method + " " + path
+			if source, found := m.operationSources[key]; found {
+				return fmt.Errorf("operation %s is defined in both %s and %s", key, source, filePath)
+			}
+			m.operationSources[key] = filePath
+			if op.OperationID != "" {
+				if source, found := m.operationIDSources[op.OperationID]; found {
+					return fmt.Errorf("operationId %s is defined in both %s and %s", op.OperationID, source, filePath)
+				}
+				m.operationIDSources[op.OperationID] = filePath
+			}
+			mergedItem.SetOperation(method, op)
+		}
+	}
+
+	if swagger.Components != nil {
+		components := reflect.ValueOf(swagger.Components).Elem()
+		mergedComponents := reflect.ValueOf(merged.Components).Elem()
+		for i := 0; i < components.NumField(); i++ {
+			kind := jsonName(components.Type().Field(i))
+			if kind == "-" {
+				continue
+			}
+			from, to := components.Field(i), mergedComponents.Field(i)
+			if to.IsNil() {
+				to.Set(reflect.MakeMap(to.Type()))
+			}
+			names := from.MapKeys()
+			sort.Slice(names, func(i, j int) bool { return names[i].String() < names[j].String() })
+			for _, name := range names {
+				key := kind + "/" + name.String()
+				if existing := to.MapIndex(name); existing.IsValid() && existing.Pointer() != from.MapIndex(name).Pointer() {
+					same, err := sameJSON(existing.Interface(), from.MapIndex(name).Interface())
+					if err != nil {
+						return err
+					}
+					if !same {
+						return fmt.Errorf("component %s is defined differently in %s and %s", key, m.componentSources[key], filePath)
+					}
+					continue
+				}
+				to.SetMapIndex(name, from.MapIndex(name))
+				if _, found := m.componentSources[key]; !found {
+					m.componentSources[key] = filePath
+				}
+			}
+		}
+	}
+
+	if merged != swagger {
+		for _, tag := range swagger.Tags {
+			if merged.Tags.Get(tag.Name) == nil {
+				merged.Tags = append(merged.Tags, tag)
+			}
+		}
+	}
+	return nil
+}
+
+// mergePathItem adds the parameters, summary, description and servers which
+// pathItem, loaded from filePath, declares for all of the operations of path
+// into mergedItem. Parameters are matched by their name and location, and
+// have to be the same in both specs, as do the servers.
+func (m *merger) mergePathItem(mergedItem, pathItem *openapi3.PathItem, path, filePath string) error {
+	for _, param := range pathItem.Parameters {
+		if param.Value == nil {
+			continue
+		}
+		existing := mergedItem.Parameters.GetByInAndName(param.Value.In, param.Value.Name)
+		if existing == nil {
+			mergedItem.Parameters = append(mergedItem.Parameters, param)
+			continue
+		}
+		same, err := sameJSON(existing, param.Value)
+		if err != nil {
+			return err
+		}
+		if !same {
+			return fmt.Errorf("%s parameter %s of path %s is defined differently in %s and %s",
+				param.Value.In, param.Value.Name, path, m.pathSources[path], filePath)
+		}
+	}
+
+	if len(pathItem.Servers) != 0 {
+		if len(mergedItem.Servers) == 0 {
+			mergedItem.Servers = pathItem.Servers
+		} else if same, err := sameJSON(mergedItem.Servers, pathItem.Servers); err != nil {
+			return err
+		} else if !same {
+			return fmt.Errorf("servers of path %s are defined differently in %s and %s", path, m.pathSources[path], filePath)
+		}
+	}
+
+	if mergedItem.Summary == "" {
+		mergedItem.Summary = pathItem.Summary
+	}
+	if mergedItem.Description == "" {
+		mergedItem.Description = pathItem.Description
+	}
+	return nil
+}
+
+// internalizeRefs replaces the references in swagger to other documents,
+// apart from those in importMapping, with references to its own components,
+// copying the referenced components into it. References to components within
+// another document are treated in the same way, since they're relative to
+// that document rather than swagger.
+func internalizeRefs(swagger *openapi3.T, importMapping map[string]string) error {
+	r := refInternalizer{
+		swagger:       swagger,
+		importMapping: importMapping,
+		visited:       map[visitedRef]bool{},
+	}
+	if swagger.Components == nil {
+		swagger.Components = &openapi3.Components{}
+	}
+	return r.walk(reflect.ValueOf(swagger), false)
+}
+
+type visitedRef struct {
+	pointer  uintptr
+	external bool
+}
+
+type refInternalizer struct {
+	swagger       *openapi3.T
+	importMapping map[string]string
+	visited       map[visitedRef]bool
+}
+
+// walk looks for references throughout v, which is within another document
+// if external is set.
+func (r *refInternalizer) walk(v reflect.Value, external bool) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		key := visitedRef{pointer: v.Pointer(), external: external}
+		if r.visited[key] {
+			return nil
+		}
+		r.visited[key] = true
+		return r.walk(v.Elem(), external)
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return r.walk(v.Elem(), external)
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			if err := r.walk(v.MapIndex(key), external); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if err := r.walk(v.Index(i), external); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		ref, value := v.FieldByName("Ref"), v.FieldByName("Value")
+		if ref.IsValid() && value.IsValid() && ref.Kind() == reflect.String && value.Kind() == reflect.Pointer {
+			return r.internalize(v, ref, value, external)
+		}
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() || field.Name == "Extensions" {
+				continue
+			}
+			if err := r.walk(v.Field(i), external); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// internalize handles refStruct, one of the openapi3 reference types like
+// SchemaRef, which holds ref and value.
+func (r *refInternalizer) internalize(refStruct, ref, value reflect.Value, external bool) error {
+	document, fragment, _ := strings.Cut(ref.String(), "#")
+	if document != "" {
+		if _, found := r.importMapping[document]; found {
+			return nil
+		}
+		external = true
+	}
+	if err := r.walk(value, external); err != nil {
+		return err
+	}
+	if ref.String() == "" || !external || value.IsNil() {
+		return nil
+	}
+
+	// References to anything other than a component are inlined, as are
+	// components which are themselves references to another document. A
+	// schema which is a whole document becomes a component named after the
+	// document's file, so that it still gets a type of its own.
+	parts := strings.Split(fragment, "/")
+	if (fragment == "" || fragment == "/") && refStruct.Type() == reflect.TypeOf(openapi3.SchemaRef{}) {
+		base := path.Base(document)
+		parts = []string{"", "components", "schemas", strings.TrimSuffix(base, path.Ext(base))}
+	}
+	components := reflect.ValueOf(r.swagger.Components).Elem()
+	var componentMap reflect.Value
+	if len(parts) == 4 && parts[0] == "" && parts[1] == "components" {
+		for i := 0; i < components.NumField(); i++ {
+			if jsonName(components.Type().Field(i)) == parts[2] {
+				componentMap = components.Field(i)
+			}
+		}
+	}
+	if !componentMap.IsValid() || componentMap.Type().Elem() != refStruct.Addr().Type() {
+		ref.SetString("")
+		return nil
+	}
+	if componentMap.IsNil() {
+		componentMap.Set(reflect.MakeMap(componentMap.Type()))
+	}
+
+	name := reflect.ValueOf(parts[3])
+	if existing := componentMap.MapIndex(name); existing.IsValid() {
+		if existing.Pointer() == refStruct.Addr().Pointer() {
+			ref.SetString("")
+			return nil
+		}
+		if existing.Elem().FieldByName("Value").Pointer() != value.Pointer() {
+			same, err := sameJSON(existing.Elem().FieldByName("Value").Interface(), value.Interface())
+			if err != nil {
+				return err
+			}
+			if !same {
+				return fmt.Errorf("component %s/%s referred to by %s is defined differently in the spec", parts[2], parts[3], ref.String())
+			}
+		}
+	} else {
+		component := reflect.New(refStruct.Type())
+		component.Elem().FieldByName("Value").Set(value)
+		componentMap.SetMapIndex(name, component)
+	}
+	ref.SetString("#" + strings.Join(parts, "/"))
+	return nil
+}
+
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	return name
+}
+
+func sameJSON(a, b interface{}) (bool, error) {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false, fmt.Errorf("error marshaling component: %w", err)
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false, fmt.Errorf("error marshaling component: %w", err)
+	}
+	return string(aJSON) == string(bJSON), nil
+}
+
+func sortedPaths(paths openapi3.Paths) []string {
+	keys := make([]string, 0, len(paths))
+	for key := range paths {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,215 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const petsSpec = `
+openapi: 3.0.1
+info:
+  title: Pets
+  version: 1.0.0
+tags:
+  - name: pets
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      tags: [pets]
+      responses:
+        '200':
+          description: The pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+        default:
+          description: An error
+          content:
+            application/json:
+              schema:
+                $ref: 'common/errors.yaml#/components/schemas/Error'
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+`
+
+const ownersSpec = `
+openapi: 3.0.1
+info:
+  title: Owners
+  version: 1.0.0
+tags:
+  - name: owners
+paths:
+  /owners:
+    get:
+      operationId: listOwners
+      tags: [owners]
+      responses:
+        '200':
+          description: The owners
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Owner'
+        default:
+          description: An error
+          content:
+            application/json:
+              schema:
+                $ref: 'common/errors.yaml#/components/schemas/Error'
+components:
+  schemas:
+    Owner:
+      type: object
+      properties:
+        pets:
+          type: array
+          items:
+            $ref: 'pets.yaml#/components/schemas/Pet'
+`
+
+const errorsSpec = `
+openapi: 3.0.1
+info:
+  title: Errors
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Error:
+      type: object
+      required: [code]
+      properties:
+        code:
+          $ref: '#/components/schemas/Code'
+        message:
+          type: string
+    Code:
+      type: integer
+`
+
+// writeSpecs writes the given specs, keyed by path, into a temporary
+// directory, returning the directory.
+func writeSpecs(t *testing.T, specs map[string]string) string {
+	dir := t.TempDir()
+	for name, spec := range specs {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(spec), 0644))
+	}
+	return dir
+}
+
+func TestMergeSwaggers(t *testing.T) {
+	dir := writeSpecs(t, map[string]string{
+		"pets.yaml":          petsSpec,
+		"owners.yaml":        ownersSpec,
+		"common/errors.yaml": errorsSpec,
+	})
+	paths := []string{filepath.Join(dir, "pets.yaml"), filepath.Join(dir, "owners.yaml")}
+
+	t.Run("merges specs and their references", func(t *testing.T) {
+		swagger, err := MergeSwaggers(paths, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Pets", swagger.Info.Title)
+		assert.Equal(t, "listPets", swagger.Paths["/pets"].Get.OperationID)
+		assert.Equal(t, "listOwners", swagger.Paths["/owners"].Get.OperationID)
+		assert.NotNil(t, swagger.Tags.Get("pets"))
+		assert.NotNil(t, swagger.Tags.Get("owners"))
+
+		for _, name := range []string{"Pet", "Owner", "Error", "Code"} {
+			assert.Contains(t, swagger.Components.Schemas, name)
+		}
+		errorSchema := swagger.Paths["/owners"].Get.Responses["default"].Value.Content["application/json"].Schema
+		assert.Equal(t, "#/components/schemas/Error", errorSchema.Ref)
+		assert.Equal(t, "#/components/schemas/Code", errorSchema.Value.Properties["code"].Ref)
+		assert.Equal(t, "#/components/schemas/Pet", swagger.Components.Schemas["Owner"].Value.Properties["pets"].Value.Items.Ref)
+	})
+
+	t.Run("keeps references to mapped documents", func(t *testing.T) {
+		swagger, err := MergeSwaggers(paths, map[string]string{"common/errors.yaml": "github.com/acme/errors"})
+		require.NoError(t, err)
+
+		assert.NotContains(t, swagger.Components.Schemas, "Error")
+		errorSchema := swagger.Paths["/pets"].Get.Responses["default"].Value.Content["application/json"].Schema
+		assert.Equal(t, "common/errors.yaml#/components/schemas/Error", errorSchema.Ref)
+	})
+
+	t.Run("rejects colliding operationIds", func(t *testing.T) {
+		dir := writeSpecs(t, map[string]string{
+			"pets.yaml":          petsSpec,
+			"other.yaml":         "openapi: 3.0.1\ninfo: {title: Other, version: 1.0.0}\npaths:\n  /others:\n    get:\n      operationId: listPets\n      responses:\n        '204':\n          description: OK\n",
+			"common/errors.yaml": errorsSpec,
+		})
+		pets, other := filepath.Join(dir, "pets.yaml"), filepath.Join(dir, "other.yaml")
+		_, err := MergeSwaggers([]string{pets, other}, nil)
+		assert.EqualError(t, err, "operationId listPets is defined in both "+pets+" and "+other)
+	})
+
+	t.Run("merges the parameters of paths", func(t *testing.T) {
+		const getThing = "openapi: 3.0.1\ninfo: {title: Get, version: 1.0.0}\npaths:\n  /things/{id}:\n    summary: A thing\n    parameters:\n      - {name: id, in: path, required: true, schema: {type: string}}\n    get:\n      operationId: getThing\n      responses:\n        '204':\n          description: OK\n"
+		const deleteThing = "openapi: 3.0.1\ninfo: {title: Delete, version: 1.0.0}\npaths:\n  /things/{id}:\n    description: Things by id\n    parameters:\n      - {name: id, in: path, required: true, schema: {type: string}}\n      - {name: X-Trace, in: header, schema: {type: string}}\n    delete:\n      operationId: deleteThing\n      responses:\n        '204':\n          description: OK\n"
+		dir := writeSpecs(t, map[string]string{"get.yaml": getThing, "delete.yaml": deleteThing})
+		swagger, err := MergeSwaggers([]string{filepath.Join(dir, "get.yaml"), filepath.Join(dir, "delete.yaml")}, nil)
+		require.NoError(t, err)
+
+		pathItem := swagger.Paths["/things/{id}"]
+		assert.Equal(t, "getThing", pathItem.Get.OperationID)
+		assert.Equal(t, "deleteThing", pathItem.Delete.OperationID)
+		assert.Equal(t, "A thing", pathItem.Summary)
+		assert.Equal(t, "Things by id", pathItem.Description)
+		require.Len(t, pathItem.Parameters, 2)
+		assert.NotNil(t, pathItem.Parameters.GetByInAndName("path", "id"))
+		assert.NotNil(t, pathItem.Parameters.GetByInAndName("header", "X-Trace"))
+
+		const conflicting = "openapi: 3.0.1\ninfo: {title: Put, version: 1.0.0}\npaths:\n  /things/{id}:\n    parameters:\n      - {name: id, in: path, required: true, schema: {type: integer}}\n    put:\n      operationId: putThing\n      responses:\n        '204':\n          description: OK\n"
+		dir = writeSpecs(t, map[string]string{"get.yaml": getThing, "put.yaml": conflicting})
+		get, put := filepath.Join(dir, "get.yaml"), filepath.Join(dir, "put.yaml")
+		_, err = MergeSwaggers([]string{get, put}, nil)
+		assert.EqualError(t, err, "path parameter id of path /things/{id} is defined differently in "+get+" and "+put)
+	})
+
+	t.Run("names schemas which are whole documents after their files", func(t *testing.T) {
+		const tagged = "openapi: 3.0.1\ninfo: {title: Tagged, version: 1.0.0}\npaths:\n  /tags:\n    get:\n      operationId: listTags\n      responses:\n        '200':\n          description: The tags\n          content:\n            application/json:\n              schema:\n                type: array\n                items:\n                  $ref: 'schemas/tag.yaml'\n"
+		dir := writeSpecs(t, map[string]string{
+			"pets.yaml":          petsSpec,
+			"tagged.yaml":        tagged,
+			"common/errors.yaml": errorsSpec,
+			"schemas/tag.yaml":   "type: object\nproperties:\n  label:\n    type: string\n",
+		})
+		swagger, err := MergeSwaggers([]string{filepath.Join(dir, "pets.yaml"), filepath.Join(dir, "tagged.yaml")}, nil)
+		require.NoError(t, err)
+
+		require.Contains(t, swagger.Components.Schemas, "tag")
+		assert.Contains(t, swagger.Components.Schemas["tag"].Value.Properties, "label")
+		items := swagger.Paths["/tags"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Items
+		assert.Equal(t, "#/components/schemas/tag", items.Ref)
+	})
+
+	t.Run("rejects colliding schemas", func(t *testing.T) {
+		dir := writeSpecs(t, map[string]string{
+			"pets.yaml":          petsSpec,
+			"other.yaml":         "openapi: 3.0.1\ninfo: {title: Other, version: 1.0.0}\npaths: {}\ncomponents:\n  schemas:\n    Pet:\n      type: string\n",
+			"common/errors.yaml": errorsSpec,
+		})
+		pets, other := filepath.Join(dir, "pets.yaml"), filepath.Join(dir, "other.yaml")
+		_, err := MergeSwaggers([]string{pets, other}, nil)
+		assert.EqualError(t, err, "component schemas/Pet is defined differently in "+pets+" and "+other)
+	})
+}
@@ -0,0 +1,264 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// schemaTypes are the type names allowed by JSON Schema, which OpenAPI 3.1
+// permits in a "type" array.
+var schemaTypes = map[string]bool{
+	"array":   true,
+	"boolean": true,
+	"integer": true,
+	"null":    true,
+	"number":  true,
+	"object":  true,
+	"string":  true,
+}
+
+// NormalizeTypeArrays rewrites the OpenAPI 3.1 form of a nullable type, such as
+// `type: [string, "null"]`, into its OpenAPI 3.0 equivalent,
+// `type: string, nullable: true`, so that the spec can be parsed by
+// kin-openapi. Likewise, a boolean `items`, which JSON Schema allows after
+// `prefixItems`, is dropped, and `items: false` becomes a `maxItems` of the
+// number of prefixItems. Only schemas are rewritten, so examples, defaults and
+// extensions, which may hold anything, are left as they are. Data which
+// contains neither is returned unchanged, otherwise the rewritten spec is
+// returned as JSON.
+//
+// kin-openapi can't parse either form, so this has to be done to the data of
+// a spec, and of the files it refers to, before it's loaded, as LoadSwagger
+// does.
+func NormalizeTypeArrays(data []byte) ([]byte, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		// Leave reporting of malformed documents to the loader.
+		return data, nil
+	}
+	doc = convertYAMLMaps(doc)
+
+	var n typeArrayNormalizer
+	n.file(doc)
+	if n.err != nil {
+		return nil, n.err
+	}
+	if !n.changed {
+		return data, nil
+	}
+	return json.Marshal(doc)
+}
+
+// typeArrayNormalizer walks the schemas of a document, rewriting their type
+// arrays and boolean items in place. It records whether anything was
+// rewritten, and the first error.
+type typeArrayNormalizer struct {
+	changed bool
+	err     error
+}
+
+// httpMethods are the keys of the operations of a path item.
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// schemaKeywords are keywords of which a map holding any is taken for a schema
+// when it's the root of a referenced file.
+var schemaKeywords = []string{"$ref", "type", "properties", "items", "prefixItems", "allOf", "anyOf", "oneOf", "additionalProperties"}
+
+// file walks the root of a spec, or of a file referred to by one, which may
+// be an OpenAPI document, a schema, or a map of either, such as of schemas.
+func (n *typeArrayNormalizer) file(node interface{}) {
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, key := range []string{"openapi", "paths", "components", "webhooks"} {
+		if _, found := m[key]; found {
+			n.document(m)
+			return
+		}
+	}
+	for _, key := range schemaKeywords {
+		if _, found := m[key]; found {
+			n.schema(m)
+			return
+		}
+	}
+	for _, value := range m {
+		n.file(value)
+	}
+}
+
+func (n *typeArrayNormalizer) document(doc map[string]interface{}) {
+	n.each(doc["paths"], n.pathItem)
+	n.each(doc["webhooks"], n.pathItem)
+	components, _ := doc["components"].(map[string]interface{})
+	n.each(components["schemas"], n.schema)
+	n.each(components["parameters"], n.parameter)
+	n.each(components["headers"], n.parameter)
+	n.each(components["responses"], n.response)
+	n.each(components["requestBodies"], n.content)
+	n.each(components["callbacks"], n.callback)
+	n.each(components["pathItems"], n.pathItem)
+}
+
+func (n *typeArrayNormalizer) pathItem(item map[string]interface{}) {
+	n.all(item["parameters"], n.parameter)
+	for _, method := range httpMethods {
+		op, ok := item[method].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		n.all(op["parameters"], n.parameter)
+		if body, ok := op["requestBody"].(map[string]interface{}); ok {
+			n.content(body)
+		}
+		n.each(op["responses"], n.response)
+		n.each(op["callbacks"], n.callback)
+	}
+}
+
+func (n *typeArrayNormalizer) callback(callback map[string]interface{}) {
+	n.each(callback, n.pathItem)
+}
+
+// parameter walks a parameter or a header.
+func (n *typeArrayNormalizer) parameter(param map[string]interface{}) {
+	n.one(param["schema"], n.schema)
+	n.content(param)
+}
+
+func (n *typeArrayNormalizer) response(response map[string]interface{}) {
+	n.each(response["headers"], n.parameter)
+	n.content(response)
+}
+
+// content walks the media types of the content of a request body, response or
+// parameter.
+func (n *typeArrayNormalizer) content(holder map[string]interface{}) {
+	n.each(holder["content"], func(mediaType map[string]interface{}) {
+		n.one(mediaType["schema"], n.schema)
+		n.each(mediaType["encoding"], func(encoding map[string]interface{}) {
+			n.each(encoding["headers"], n.parameter)
+		})
+	})
+}
+
+func (n *typeArrayNormalizer) schema(schema map[string]interface{}) {
+	if n.err != nil {
+		return
+	}
+	if types, ok := schema["type"].([]interface{}); ok && isTypeArray(types) {
+		var nonNull []string
+		nullable := false
+		for _, t := range types {
+			if t.(string) == "null" {
+				nullable = true
+			} else {
+				nonNull = append(nonNull, t.(string))
+			}
+		}
+		switch len(nonNull) {
+		case 0:
+			delete(schema, "type")
+		case 1:
+			schema["type"] = nonNull[0]
+		default:
+			n.err = fmt.Errorf("type arrays with more than one non-null type are not supported: %v", types)
+			return
+		}
+		if nullable {
+			schema["nullable"] = true
+		}
+		n.changed = true
+	}
+	if normalizeBooleanItems(schema) {
+		n.changed = true
+	}
+
+	for _, key := range []string{"properties", "patternProperties", "$defs", "dependentSchemas"} {
+		n.each(schema[key], n.schema)
+	}
+	for _, key := range []string{"allOf", "anyOf", "oneOf", "prefixItems"} {
+		n.all(schema[key], n.schema)
+	}
+	for _, key := range []string{"items", "additionalProperties", "not", "contains", "if", "then", "else", "propertyNames", "unevaluatedItems", "unevaluatedProperties"} {
+		n.one(schema[key], n.schema)
+	}
+}
+
+// one calls walk with node, if it's a map.
+func (n *typeArrayNormalizer) one(node interface{}, walk func(map[string]interface{})) {
+	if m, ok := node.(map[string]interface{}); ok {
+		walk(m)
+	}
+}
+
+// each calls walk with each value of node, if it's a map, which is a map too.
+func (n *typeArrayNormalizer) each(node interface{}, walk func(map[string]interface{})) {
+	m, _ := node.(map[string]interface{})
+	for _, value := range m {
+		n.one(value, walk)
+	}
+}
+
+// all calls walk with each element of node, if it's a list, which is a map.
+func (n *typeArrayNormalizer) all(node interface{}, walk func(map[string]interface{})) {
+	list, _ := node.([]interface{})
+	for _, value := range list {
+		n.one(value, walk)
+	}
+}
+
+// normalizeBooleanItems rewrites a boolean items in schema, returning true if
+// there was one. `items: true` allows any further items, as no items does,
+// while `items: false` allows none past the prefixItems.
+func normalizeBooleanItems(schema map[string]interface{}) bool {
+	items, ok := schema["items"].(bool)
+	if !ok {
+		return false
+	}
+	delete(schema, "items")
+	if !items {
+		prefixItems, _ := schema["prefixItems"].([]interface{})
+		maxItems := len(prefixItems)
+		if current, ok := schema["maxItems"].(int); !ok || current > maxItems {
+			schema["maxItems"] = maxItems
+		}
+	}
+	return true
+}
+
+// isTypeArray returns true if every element of types is a JSON Schema type name
+func isTypeArray(types []interface{}) bool {
+	if len(types) == 0 {
+		return false
+	}
+	for _, t := range types {
+		s, ok := t.(string)
+		if !ok || !schemaTypes[s] {
+			return false
+		}
+	}
+	return true
+}
+
+// convertYAMLMaps converts the map[interface{}]interface{} values produced by
+// the YAML decoder into map[string]interface{} so they can be encoded as JSON.
+func convertYAMLMaps(node interface{}) interface{} {
+	switch v := node.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = convertYAMLMaps(value)
+		}
+		return m
+	case []interface{}:
+		for i, value := range v {
+			v[i] = convertYAMLMaps(value)
+		}
+		return v
+	}
+	return node
+}
@@ -0,0 +1,88 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTypeArrays(t *testing.T) {
+	t.Run("leaves specs without type arrays untouched", func(t *testing.T) {
+		data := []byte("openapi: 3.0.0\ncomponents:\n  schemas:\n    Name:\n      type: string\n")
+		out, err := NormalizeTypeArrays(data)
+		require.NoError(t, err)
+		assert.Equal(t, data, out)
+	})
+
+	t.Run("collapses null into nullable", func(t *testing.T) {
+		data := []byte("openapi: 3.1.0\ncomponents:\n  schemas:\n    Name:\n      type: [string, \"null\"]\n")
+		out, err := NormalizeTypeArrays(data)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"openapi":"3.1.0","components":{"schemas":{"Name":{"type":"string","nullable":true}}}}`, string(out))
+	})
+
+	t.Run("rewrites boolean items", func(t *testing.T) {
+		data := []byte("openapi: 3.1.0\ncomponents:\n  schemas:\n    Point:\n      type: array\n      prefixItems: [{type: number}, {type: number}]\n      items: false\n    Any:\n      type: array\n      items: true\n")
+		out, err := NormalizeTypeArrays(data)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"openapi":"3.1.0","components":{"schemas":{"Point":{"type":"array","prefixItems":[{"type":"number"},{"type":"number"}],"maxItems":2},"Any":{"type":"array"}}}}`, string(out))
+	})
+
+	t.Run("leaves examples and extensions untouched", func(t *testing.T) {
+		data := []byte(`openapi: 3.1.0
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: filter
+          in: query
+          schema:
+            type: [string, "null"]
+          example: {type: [string, "null"]}
+      responses:
+        "200":
+          description: Pets
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  example:
+                    type: [integer, "null"]
+                example: {items: true}
+              examples:
+                pets:
+                  value: {type: [string, "null"], items: false}
+components:
+  schemas:
+    Name:
+      type: string
+      x-shape: {type: [string, "null"]}
+`)
+		out, err := NormalizeTypeArrays(data)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"openapi":"3.1.0","paths":{"/pets":{"get":{
+			"parameters":[{"name":"filter","in":"query","schema":{"type":"string","nullable":true},"example":{"type":["string","null"]}}],
+			"responses":{"200":{"description":"Pets","content":{"application/json":{
+				"schema":{"type":"object","properties":{"example":{"type":"integer","nullable":true}},"example":{"items":true}},
+				"examples":{"pets":{"value":{"type":["string","null"],"items":false}}}}}}}}}},
+			"components":{"schemas":{"Name":{"type":"string","x-shape":{"type":["string","null"]}}}}}`, string(out))
+	})
+
+	t.Run("rewrites referenced files of schemas", func(t *testing.T) {
+		out, err := NormalizeTypeArrays([]byte("type: [string, \"null\"]\n"))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":"string","nullable":true}`, string(out))
+
+		out, err = NormalizeTypeArrays([]byte("Name:\n  type: [string, \"null\"]\n"))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"Name":{"type":"string","nullable":true}}`, string(out))
+	})
+
+	t.Run("rejects multiple non-null types", func(t *testing.T) {
+		data := []byte("openapi: 3.1.0\ncomponents:\n  schemas:\n    Name:\n      type: [string, integer]\n")
+		_, err := NormalizeTypeArrays(data)
+		assert.Error(t, err)
+	})
+}